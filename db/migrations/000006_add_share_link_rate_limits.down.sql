@@ -0,0 +1,3 @@
+ALTER TABLE share_links
+    DROP COLUMN IF EXISTS rate_limit_window_seconds,
+    DROP COLUMN IF EXISTS rate_limit;
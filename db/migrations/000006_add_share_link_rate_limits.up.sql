@@ -0,0 +1,4 @@
+-- NULL means the token uses the server wide default rate limit
+ALTER TABLE share_links
+    ADD COLUMN rate_limit INTEGER CHECK (rate_limit > 0),
+    ADD COLUMN rate_limit_window_seconds INTEGER CHECK (rate_limit_window_seconds > 0);
@@ -0,0 +1,3 @@
+ALTER TABLE share_links
+    DROP COLUMN IF EXISTS suspended_reason,
+    DROP COLUMN IF EXISTS suspended_at;
@@ -0,0 +1,3 @@
+ALTER TABLE share_links
+    ADD COLUMN suspended_at TIMESTAMPTZ,
+    ADD COLUMN suspended_reason TEXT;
@@ -0,0 +1,2 @@
+-- bumped on every write so clients can do optimistic concurrency with etags
+ALTER TABLE collections ADD COLUMN revision BIGINT NOT NULL DEFAULT 1;
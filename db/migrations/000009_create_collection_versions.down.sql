@@ -0,0 +1,2 @@
+ALTER TABLE collections DROP COLUMN IF EXISTS version_retention;
+DROP TABLE IF EXISTS collection_versions;
@@ -0,0 +1,14 @@
+-- snapshot of a collection taken right before each write, keyed by the revision it had at the time
+CREATE TABLE collection_versions(
+    id SERIAL PRIMARY KEY,
+    collection_id INTEGER NOT NULL REFERENCES collections(id) ON DELETE CASCADE,
+    revision BIGINT NOT NULL,
+    name TEXT NOT NULL,
+    data JSONB NOT NULL,
+    superseded_by INTEGER REFERENCES users(id) ON DELETE SET NULL, -- the user whose write replaced this version
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    UNIQUE(collection_id, revision)
+);
+
+-- NULL uses the server wide default
+ALTER TABLE collections ADD COLUMN version_retention INTEGER CHECK (version_retention > 0);
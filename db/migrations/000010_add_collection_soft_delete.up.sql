@@ -0,0 +1,4 @@
+-- soft deleted collections are hidden from every read and purged after the trash retention period
+ALTER TABLE collections ADD COLUMN deleted_at TIMESTAMPTZ;
+
+CREATE INDEX idx_collections_deleted_at ON collections(deleted_at) WHERE deleted_at IS NOT NULL;
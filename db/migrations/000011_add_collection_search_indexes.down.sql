@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_collections_data;
+DROP INDEX IF EXISTS idx_collections_name_fts;
@@ -0,0 +1,5 @@
+-- expression index so name search does not need a stored tsvector column, queries must use the same expression
+CREATE INDEX idx_collections_name_fts ON collections USING GIN (to_tsvector('simple', name));
+
+-- jsonb_path_ops supports @> containment and @@ jsonpath predicates
+CREATE INDEX idx_collections_data ON collections USING GIN (data jsonb_path_ops);
@@ -0,0 +1,11 @@
+-- JSON Schemas an organization registers for the "type" field of collection data
+CREATE TABLE collection_schemas(
+    id SERIAL PRIMARY KEY,
+    organization_id INTEGER NOT NULL REFERENCES organizations(id) ON DELETE CASCADE,
+    type TEXT NOT NULL,
+    schema JSONB NOT NULL,
+    created_by INTEGER REFERENCES users(id) ON DELETE SET NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    UNIQUE(organization_id, type)
+);
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_collections_labels;
+ALTER TABLE collections DROP COLUMN IF EXISTS labels;
@@ -0,0 +1,4 @@
+-- key/value labels for grouping collections, jsonb_ops so selectors can use both @> and the ?& / ?| key checks
+ALTER TABLE collections ADD COLUMN labels JSONB NOT NULL DEFAULT '{}';
+
+CREATE INDEX idx_collections_labels ON collections USING GIN (labels);
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS collection_transfers;
+DROP TYPE IF EXISTS transfer_status;
@@ -0,0 +1,19 @@
+CREATE TYPE transfer_status AS ENUM ('pending', 'accepted', 'declined');
+
+-- ownership changes wait here until someone on the receiving side accepts them
+CREATE TABLE collection_transfers(
+    id SERIAL PRIMARY KEY,
+    uid UUID NOT NULL DEFAULT gen_random_uuid() UNIQUE,
+    collection_id INTEGER NOT NULL REFERENCES collections(id) ON DELETE CASCADE,
+    to_user_id INTEGER REFERENCES users(id) ON DELETE CASCADE,
+    to_organization_id INTEGER REFERENCES organizations(id) ON DELETE CASCADE,
+    status transfer_status NOT NULL DEFAULT 'pending',
+    requested_by INTEGER REFERENCES users(id) ON DELETE SET NULL,
+    resolved_by INTEGER REFERENCES users(id) ON DELETE SET NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    resolved_at TIMESTAMPTZ,
+    CHECK ((to_user_id IS NULL) <> (to_organization_id IS NULL))
+);
+
+-- a collection can only have one open transfer at a time
+CREATE UNIQUE INDEX idx_collection_transfers_pending ON collection_transfers(collection_id) WHERE status = 'pending';
@@ -0,0 +1,11 @@
+-- append only record of sensitive changes, kept after the collection or actor is gone
+CREATE TABLE audit_events(
+    id BIGSERIAL PRIMARY KEY,
+    actor_id INTEGER REFERENCES users(id) ON DELETE SET NULL,
+    action TEXT NOT NULL,
+    collection_uid UUID,
+    details JSONB NOT NULL DEFAULT '{}',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX idx_audit_events_collection_uid ON audit_events(collection_uid);
@@ -0,0 +1,2 @@
+-- set on copies to the uid of the collection they were made from, not a foreign key so it outlives the source
+ALTER TABLE collections ADD COLUMN source_collection_uid UUID;
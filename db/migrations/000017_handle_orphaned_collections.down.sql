@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_collections_quarantined_at;
+ALTER TABLE collections DROP CONSTRAINT IF EXISTS collections_has_owner;
+ALTER TABLE collections DROP COLUMN IF EXISTS quarantined_at;
+ALTER TABLE organization_members DROP COLUMN IF EXISTS role;
+DROP TYPE IF EXISTS organization_role;
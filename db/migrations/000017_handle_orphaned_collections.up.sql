@@ -0,0 +1,16 @@
+-- admins are who orphaned collections get reassigned to
+CREATE TYPE organization_role AS ENUM ('member', 'admin');
+ALTER TABLE organization_members ADD COLUMN role organization_role NOT NULL DEFAULT 'member';
+
+-- quarantined collections have lost both their owner and organization and are only visible to admins
+ALTER TABLE collections ADD COLUMN quarantined_at TIMESTAMPTZ;
+
+UPDATE collections SET quarantined_at = now()
+WHERE owner_id IS NULL AND organization_id IS NULL;
+
+-- the ON DELETE SET NULL foreign keys now fail instead of silently orphaning a collection, so users and
+-- organizations have to be deleted through the admin service which applies the orphan policy first
+ALTER TABLE collections ADD CONSTRAINT collections_has_owner
+    CHECK (owner_id IS NOT NULL OR organization_id IS NOT NULL OR quarantined_at IS NOT NULL);
+
+CREATE INDEX idx_collections_quarantined_at ON collections(quarantined_at) WHERE quarantined_at IS NOT NULL;
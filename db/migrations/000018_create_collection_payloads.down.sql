@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS collection_payload_chunks;
+DROP TABLE IF EXISTS collection_payloads;
+DROP TABLE IF EXISTS blob_chunks;
@@ -0,0 +1,25 @@
+-- content addressed chunks, identical chunks across payloads are stored once
+CREATE TABLE blob_chunks(
+    hash BYTEA PRIMARY KEY, -- sha256 of data
+    data BYTEA NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+-- a large payload attached to a collection next to its inline data
+CREATE TABLE collection_payloads(
+    collection_id INTEGER PRIMARY KEY REFERENCES collections(id) ON DELETE CASCADE,
+    content_type TEXT NOT NULL,
+    size BIGINT NOT NULL,
+    sha256 TEXT NOT NULL,
+    chunk_size INTEGER NOT NULL, -- every chunk but the last is exactly this long, which is what makes range reads cheap
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE TABLE collection_payload_chunks(
+    collection_id INTEGER NOT NULL REFERENCES collection_payloads(collection_id) ON DELETE CASCADE,
+    seq INTEGER NOT NULL,
+    chunk_hash BYTEA NOT NULL REFERENCES blob_chunks(hash),
+    PRIMARY KEY(collection_id, seq)
+);
+
+CREATE INDEX idx_collection_payload_chunks_chunk_hash ON collection_payload_chunks(chunk_hash);
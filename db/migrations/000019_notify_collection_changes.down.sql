@@ -0,0 +1,4 @@
+DROP TRIGGER IF EXISTS organization_members_notify_change ON organization_members;
+DROP FUNCTION IF EXISTS notify_organization_member_change();
+DROP TRIGGER IF EXISTS collections_notify_change ON collections;
+DROP FUNCTION IF EXISTS notify_collection_change();
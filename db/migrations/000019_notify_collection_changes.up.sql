@@ -0,0 +1,73 @@
+-- change notifications for WatchCollection and WatchOrganizationCollections, NOTIFY is delivered on
+-- commit so every replica listening on the channel sees the same committed changes
+CREATE FUNCTION notify_collection_change() RETURNS trigger AS $$
+DECLARE
+    op TEXT;
+    payload JSONB;
+BEGIN
+    IF TG_OP = 'INSERT' THEN
+        op := 'created';
+    ELSIF TG_OP = 'DELETE' THEN
+        -- collections in the trash were reported as deleted when they were moved there
+        IF OLD.deleted_at IS NOT NULL THEN
+            RETURN NULL;
+        END IF;
+        op := 'deleted';
+    ELSIF NEW.deleted_at IS NOT NULL AND OLD.deleted_at IS NULL THEN
+        op := 'deleted';
+    ELSIF NEW.deleted_at IS NULL AND OLD.deleted_at IS NOT NULL THEN
+        op := 'created';
+    ELSIF NEW.deleted_at IS NOT NULL THEN
+        RETURN NULL;
+    ELSIF NEW.access_level IS DISTINCT FROM OLD.access_level
+        OR NEW.owner_id IS DISTINCT FROM OLD.owner_id
+        OR NEW.organization_id IS DISTINCT FROM OLD.organization_id THEN
+        op := 'access_changed';
+    ELSE
+        op := 'updated';
+    END IF;
+
+    payload := jsonb_build_object('kind', 'collection', 'op', op);
+    IF TG_OP = 'DELETE' THEN
+        payload := payload || jsonb_build_object('collection_id', OLD.id, 'collection_uid', OLD.uid);
+    ELSE
+        payload := payload || jsonb_build_object(
+            'collection_id', NEW.id,
+            'collection_uid', NEW.uid,
+            'owner_id', NEW.owner_id,
+            'organization_id', NEW.organization_id,
+            'access_level', NEW.access_level);
+    END IF;
+    IF TG_OP <> 'INSERT' THEN
+        payload := payload || jsonb_build_object(
+            'old_owner_id', OLD.owner_id,
+            'old_organization_id', OLD.organization_id,
+            'old_access_level', OLD.access_level);
+    END IF;
+
+    PERFORM pg_notify('collection_changes', payload::text);
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER collections_notify_change
+    AFTER INSERT OR UPDATE OR DELETE ON collections
+    FOR EACH ROW EXECUTE FUNCTION notify_collection_change();
+
+-- membership changes make watchers re-check their access
+CREATE FUNCTION notify_organization_member_change() RETURNS trigger AS $$
+BEGIN
+    IF TG_OP = 'DELETE' THEN
+        PERFORM pg_notify('collection_changes', jsonb_build_object(
+            'kind', 'membership', 'organization_id', OLD.organization_id, 'user_id', OLD.user_id)::text);
+    ELSE
+        PERFORM pg_notify('collection_changes', jsonb_build_object(
+            'kind', 'membership', 'organization_id', NEW.organization_id, 'user_id', NEW.user_id)::text);
+    END IF;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER organization_members_notify_change
+    AFTER INSERT OR UPDATE OR DELETE ON organization_members
+    FOR EACH ROW EXECUTE FUNCTION notify_organization_member_change();
@@ -0,0 +1,3 @@
+DROP TRIGGER IF EXISTS share_links_notify_suspend ON share_links;
+DROP TRIGGER IF EXISTS share_links_notify_revoke ON share_links;
+DROP FUNCTION IF EXISTS notify_share_link_change();
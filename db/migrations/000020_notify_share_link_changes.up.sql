@@ -0,0 +1,23 @@
+-- lets WatchSharedCollection end as soon as its token is revoked or suspended, access count updates
+-- happen on every read so only suspension changes are published
+CREATE FUNCTION notify_share_link_change() RETURNS trigger AS $$
+BEGIN
+    IF TG_OP = 'DELETE' THEN
+        PERFORM pg_notify('collection_changes', jsonb_build_object(
+            'kind', 'share_link', 'op', 'revoked', 'share_link_id', OLD.id, 'collection_id', OLD.collection_id)::text);
+    ELSE
+        PERFORM pg_notify('collection_changes', jsonb_build_object(
+            'kind', 'share_link', 'op', 'updated', 'share_link_id', NEW.id, 'collection_id', NEW.collection_id)::text);
+    END IF;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER share_links_notify_revoke
+    AFTER DELETE ON share_links
+    FOR EACH ROW EXECUTE FUNCTION notify_share_link_change();
+
+CREATE TRIGGER share_links_notify_suspend
+    AFTER UPDATE OF suspended_at ON share_links
+    FOR EACH ROW WHEN (OLD.suspended_at IS DISTINCT FROM NEW.suspended_at)
+    EXECUTE FUNCTION notify_share_link_change();
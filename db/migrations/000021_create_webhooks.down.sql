@@ -0,0 +1,8 @@
+DROP TRIGGER IF EXISTS share_links_record_event ON share_links;
+DROP FUNCTION IF EXISTS record_share_link_event();
+DROP TRIGGER IF EXISTS collections_record_event ON collections;
+DROP FUNCTION IF EXISTS record_collection_event();
+DROP TABLE IF EXISTS webhook_deliveries;
+DROP TYPE IF EXISTS webhook_delivery_status;
+DROP TABLE IF EXISTS webhook_subscriptions;
+DROP TABLE IF EXISTS outbox_events;
@@ -0,0 +1,139 @@
+-- domain events written by triggers in the same transaction as the change, the webhook dispatcher
+-- fans them out to subscriptions and marks them dispatched
+CREATE TABLE outbox_events(
+    id BIGSERIAL PRIMARY KEY,
+    uid UUID NOT NULL DEFAULT gen_random_uuid() UNIQUE,
+    event_type TEXT NOT NULL,
+    organization_id INTEGER REFERENCES organizations(id) ON DELETE CASCADE,
+    payload JSONB NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    dispatched_at TIMESTAMPTZ
+);
+
+CREATE INDEX idx_outbox_events_undispatched ON outbox_events(id) WHERE dispatched_at IS NULL;
+
+CREATE TABLE webhook_subscriptions(
+    id SERIAL PRIMARY KEY,
+    uid UUID NOT NULL DEFAULT gen_random_uuid() UNIQUE,
+    organization_id INTEGER NOT NULL REFERENCES organizations(id) ON DELETE CASCADE,
+    url TEXT NOT NULL,
+    secret TEXT NOT NULL, -- HMAC key for the signature header
+    event_types TEXT[] NOT NULL DEFAULT '{}', -- empty means every event
+    created_by INTEGER REFERENCES users(id) ON DELETE SET NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX idx_webhook_subscriptions_organization_id ON webhook_subscriptions(organization_id);
+
+-- dead deliveries ran out of attempts and stay as the dead letter list until retried or deleted
+CREATE TYPE webhook_delivery_status AS ENUM ('pending', 'delivered', 'dead');
+
+CREATE TABLE webhook_deliveries(
+    id SERIAL PRIMARY KEY,
+    subscription_id INTEGER NOT NULL REFERENCES webhook_subscriptions(id) ON DELETE CASCADE,
+    event_id BIGINT NOT NULL REFERENCES outbox_events(id) ON DELETE CASCADE,
+    status webhook_delivery_status NOT NULL DEFAULT 'pending',
+    attempts INTEGER NOT NULL DEFAULT 0,
+    next_attempt_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    last_error TEXT,
+    delivered_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    UNIQUE(subscription_id, event_id)
+);
+
+CREATE INDEX idx_webhook_deliveries_due ON webhook_deliveries(next_attempt_at) WHERE status = 'pending';
+CREATE INDEX idx_webhook_deliveries_subscription_id ON webhook_deliveries(subscription_id, id);
+
+CREATE FUNCTION record_collection_event() RETURNS trigger AS $$
+DECLARE
+    event_name TEXT;
+    c collections;
+    org_id INTEGER;
+BEGIN
+    IF TG_OP = 'INSERT' THEN
+        event_name := 'collection.created';
+        c := NEW;
+    ELSIF TG_OP = 'DELETE' THEN
+        -- collections in the trash were reported as deleted when they were moved there
+        IF OLD.deleted_at IS NOT NULL THEN
+            RETURN NULL;
+        END IF;
+        event_name := 'collection.deleted';
+        c := OLD;
+    ELSIF NEW.deleted_at IS NOT NULL AND OLD.deleted_at IS NULL THEN
+        event_name := 'collection.deleted';
+        c := NEW;
+    ELSIF NEW.deleted_at IS NULL AND OLD.deleted_at IS NOT NULL THEN
+        event_name := 'collection.created';
+        c := NEW;
+    ELSIF NEW.deleted_at IS NOT NULL THEN
+        RETURN NULL;
+    ELSIF NEW.access_level IS DISTINCT FROM OLD.access_level
+        OR NEW.owner_id IS DISTINCT FROM OLD.owner_id
+        OR NEW.organization_id IS DISTINCT FROM OLD.organization_id THEN
+        event_name := 'collection.access_changed';
+        c := NEW;
+    ELSE
+        event_name := 'collection.updated';
+        c := NEW;
+    END IF;
+
+    -- a collection leaving an organization is reported to the organization it left
+    org_id := c.organization_id;
+    IF org_id IS NULL AND TG_OP = 'UPDATE' THEN
+        org_id := OLD.organization_id;
+    END IF;
+    -- webhooks are registered per organization, nobody could receive events for private collections
+    IF org_id IS NULL THEN
+        RETURN NULL;
+    END IF;
+
+    INSERT INTO outbox_events (event_type, organization_id, payload)
+    VALUES (event_name, org_id, jsonb_build_object(
+        'collection_uid', c.uid,
+        'name', c.name,
+        'access_level', c.access_level,
+        'revision', c.revision,
+        'labels', c.labels));
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER collections_record_event
+    AFTER INSERT OR UPDATE OR DELETE ON collections
+    FOR EACH ROW EXECUTE FUNCTION record_collection_event();
+
+CREATE FUNCTION record_share_link_event() RETURNS trigger AS $$
+DECLARE
+    link share_links;
+    event_name TEXT;
+    collection_uid UUID;
+    org_id INTEGER;
+BEGIN
+    IF TG_OP = 'INSERT' THEN
+        link := NEW;
+        event_name := 'share_link.created';
+    ELSE
+        link := OLD;
+        event_name := 'share_link.revoked';
+    END IF;
+
+    -- links removed along with their collection find nothing here and are covered by collection.deleted
+    SELECT uid, organization_id INTO collection_uid, org_id FROM collections WHERE id = link.collection_id;
+    IF org_id IS NULL THEN
+        RETURN NULL;
+    END IF;
+
+    -- the token itself is a credential and never leaves the database
+    INSERT INTO outbox_events (event_type, organization_id, payload)
+    VALUES (event_name, org_id, jsonb_build_object(
+        'collection_uid', collection_uid,
+        'share_link_id', link.id,
+        'created_at', link.created_at));
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER share_links_record_event
+    AFTER INSERT OR DELETE ON share_links
+    FOR EACH ROW EXECUTE FUNCTION record_share_link_event();
@@ -0,0 +1,21 @@
+DROP TRIGGER IF EXISTS share_links_notify_suspend ON share_links;
+CREATE TRIGGER share_links_notify_suspend
+    AFTER UPDATE OF suspended_at ON share_links
+    FOR EACH ROW WHEN (OLD.suspended_at IS DISTINCT FROM NEW.suspended_at)
+    EXECUTE FUNCTION notify_share_link_change();
+
+CREATE OR REPLACE FUNCTION notify_share_link_change() RETURNS trigger AS $$
+BEGIN
+    IF TG_OP = 'DELETE' THEN
+        PERFORM pg_notify('collection_changes', jsonb_build_object(
+            'kind', 'share_link', 'op', 'revoked', 'share_link_id', OLD.id, 'collection_id', OLD.collection_id)::text);
+    ELSE
+        PERFORM pg_notify('collection_changes', jsonb_build_object(
+            'kind', 'share_link', 'op', 'updated', 'share_link_id', NEW.id, 'collection_id', NEW.collection_id)::text);
+    END IF;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+ALTER TABLE share_links
+    DROP COLUMN IF EXISTS cache_max_age_seconds;
@@ -0,0 +1,27 @@
+-- NULL means the token uses the server wide default max-age
+ALTER TABLE share_links
+    ADD COLUMN cache_max_age_seconds INTEGER CHECK (cache_max_age_seconds > 0);
+
+-- token_hash lets the cache purger find the surrogate key of a revoked link without the token itself
+CREATE OR REPLACE FUNCTION notify_share_link_change() RETURNS trigger AS $$
+BEGIN
+    IF TG_OP = 'DELETE' THEN
+        PERFORM pg_notify('collection_changes', jsonb_build_object(
+            'kind', 'share_link', 'op', 'revoked', 'share_link_id', OLD.id, 'collection_id', OLD.collection_id,
+            'token_hash', encode(sha256(convert_to(OLD.token, 'UTF8')), 'hex'))::text);
+    ELSE
+        PERFORM pg_notify('collection_changes', jsonb_build_object(
+            'kind', 'share_link', 'op', 'updated', 'share_link_id', NEW.id, 'collection_id', NEW.collection_id,
+            'token_hash', encode(sha256(convert_to(NEW.token, 'UTF8')), 'hex'))::text);
+    END IF;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+-- a new max-age only reaches caches once the old response is purged
+DROP TRIGGER share_links_notify_suspend ON share_links;
+CREATE TRIGGER share_links_notify_suspend
+    AFTER UPDATE OF suspended_at, cache_max_age_seconds ON share_links
+    FOR EACH ROW WHEN (OLD.suspended_at IS DISTINCT FROM NEW.suspended_at
+        OR OLD.cache_max_age_seconds IS DISTINCT FROM NEW.cache_max_age_seconds)
+    EXECUTE FUNCTION notify_share_link_change();
@@ -0,0 +1,2 @@
+ALTER TABLE share_links
+    DROP COLUMN IF EXISTS expires_at;
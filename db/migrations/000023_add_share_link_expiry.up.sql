@@ -0,0 +1,3 @@
+-- NULL means the token never expires
+ALTER TABLE share_links
+    ADD COLUMN expires_at TIMESTAMPTZ;
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS payload_upload_chunks;
+DROP TABLE IF EXISTS payload_uploads;
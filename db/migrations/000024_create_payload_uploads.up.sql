@@ -0,0 +1,15 @@
+-- an upload in progress, its chunks are stored as they arrive and only referenced by the payload once it finishes
+CREATE TABLE payload_uploads(
+    id SERIAL PRIMARY KEY,
+    collection_id INTEGER NOT NULL REFERENCES collections(id) ON DELETE CASCADE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+-- keeps the chunks an upload has stored from being removed as unreferenced until it finishes
+CREATE TABLE payload_upload_chunks(
+    upload_id INTEGER NOT NULL REFERENCES payload_uploads(id) ON DELETE CASCADE,
+    chunk_hash BYTEA NOT NULL REFERENCES blob_chunks(hash),
+    PRIMARY KEY(upload_id, chunk_hash)
+);
+
+CREATE INDEX idx_payload_upload_chunks_chunk_hash ON payload_upload_chunks(chunk_hash);
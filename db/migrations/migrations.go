@@ -0,0 +1,37 @@
+// Package migrations embeds the schema migrations so the server knows which version it was built against.
+package migrations
+
+import (
+	"embed"
+	"errors"
+	"strconv"
+	"strings"
+)
+
+//go:embed *.up.sql
+var files embed.FS
+
+// Latest returns the version of the newest migration.
+func Latest() (uint, error) {
+	entries, err := files.ReadDir(".")
+	if err != nil {
+		return 0, err
+	}
+
+	var latest uint
+	for _, entry := range entries {
+		prefix, _, ok := strings.Cut(entry.Name(), "_")
+		if !ok {
+			continue
+		}
+		version, err := strconv.ParseUint(prefix, 10, 64)
+		if err != nil {
+			continue
+		}
+		latest = max(latest, uint(version))
+	}
+	if latest == 0 {
+		return 0, errors.New("no migrations embedded")
+	}
+	return latest, nil
+}
@@ -0,0 +1,3 @@
+-- name: CreateAuditEvent :exec
+INSERT INTO audit_events (actor_id, action, collection_uid, details)
+VALUES ($1, $2, $3, $4);
@@ -0,0 +1,21 @@
+-- name: UpsertCollectionSchema :one
+INSERT INTO collection_schemas (organization_id, type, schema, created_by)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (organization_id, type) DO UPDATE
+SET schema = EXCLUDED.schema, updated_at = now()
+RETURNING id, organization_id, type, schema, created_by, created_at, updated_at;
+
+-- name: GetCollectionSchema :one
+SELECT id, organization_id, type, schema, created_by, created_at, updated_at
+FROM collection_schemas
+WHERE organization_id = $1 AND type = $2;
+
+-- name: ListCollectionSchemas :many
+SELECT id, organization_id, type, schema, created_by, created_at, updated_at
+FROM collection_schemas
+WHERE organization_id = $1
+ORDER BY type;
+
+-- name: DeleteCollectionSchema :execrows
+DELETE FROM collection_schemas
+WHERE organization_id = $1 AND type = $2;
@@ -0,0 +1,35 @@
+-- name: CreateCollectionTransfer :one
+INSERT INTO collection_transfers (collection_id, to_user_id, to_organization_id, requested_by)
+VALUES ($1, $2, $3, $4)
+RETURNING id, uid, collection_id, to_user_id, to_organization_id, status, requested_by, resolved_by, created_at, resolved_at;
+
+-- name: GetCollectionTransferByUIDForUpdate :one
+SELECT id, uid, collection_id, to_user_id, to_organization_id, status, requested_by, resolved_by, created_at, resolved_at
+FROM collection_transfers
+WHERE uid = $1
+FOR UPDATE;
+
+-- name: ResolveCollectionTransfer :one
+UPDATE collection_transfers
+SET status = $2, resolved_by = $3, resolved_at = now()
+WHERE id = $1 AND status = 'pending'
+RETURNING id, uid, collection_id, to_user_id, to_organization_id, status, requested_by, resolved_by, created_at, resolved_at;
+
+-- name: ListPendingTransfersForUser :many
+-- Transfers the user can accept, either addressed to them or to an organization they administer.
+SELECT t.id, t.uid, t.collection_id, t.to_user_id, t.to_organization_id, t.status, t.requested_by, t.resolved_by, t.created_at, t.resolved_at
+FROM collection_transfers t
+WHERE t.status = 'pending'
+  AND (
+    t.to_user_id = sqlc.arg('user_id')
+    OR t.to_organization_id IN (
+        SELECT om.organization_id FROM organization_members om WHERE om.user_id = sqlc.arg('user_id') AND om.role = 'admin'
+    )
+  )
+ORDER BY t.id DESC;
+
+-- name: TransferCollectionOwnership :one
+UPDATE collections
+SET owner_id = $2, organization_id = $3, access_level = $4, updated_at = now(), revision = revision + 1
+WHERE id = $1
+RETURNING id, uid, name, data, access_level, owner_id, organization_id, created_at, updated_at, revision, version_retention, deleted_at, labels, source_collection_uid, quarantined_at;
@@ -0,0 +1,25 @@
+-- name: CreateCollectionVersion :exec
+INSERT INTO collection_versions (collection_id, revision, name, data, superseded_by)
+VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (collection_id, revision) DO NOTHING;
+
+-- name: ListCollectionVersions :many
+SELECT id, collection_id, revision, name, data, superseded_by, created_at
+FROM collection_versions
+WHERE collection_id = $1 AND (sqlc.narg('before_revision')::bigint IS NULL OR revision < sqlc.narg('before_revision'))
+ORDER BY revision DESC
+LIMIT $2;
+
+-- name: GetCollectionVersion :one
+SELECT id, collection_id, revision, name, data, superseded_by, created_at
+FROM collection_versions
+WHERE collection_id = $1 AND revision = $2;
+
+-- name: PruneCollectionVersions :exec
+DELETE FROM collection_versions
+WHERE collection_versions.collection_id = $1 AND collection_versions.revision NOT IN (
+    SELECT cv.revision FROM collection_versions cv
+    WHERE cv.collection_id = $1
+    ORDER BY cv.revision DESC
+    LIMIT $2
+);
@@ -1,33 +1,110 @@
 -- name: CreateCollection :one
-INSERT INTO collections (name, data, access_level, owner_id, organization_id)
-VALUES ($1, $2, $3, $4, $5)
-RETURNING id, uid, name, data, access_level, owner_id, organization_id, created_at, updated_at;
+INSERT INTO collections (name, data, access_level, owner_id, organization_id, labels, source_collection_uid)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+RETURNING id, uid, name, data, access_level, owner_id, organization_id, created_at, updated_at, revision, version_retention, deleted_at, labels, source_collection_uid, quarantined_at;
 
 -- name: GetCollectionByUID :one
-SELECT id, uid, name, data, access_level, owner_id, organization_id, created_at, updated_at
+SELECT id, uid, name, data, access_level, owner_id, organization_id, created_at, updated_at, revision, version_retention, deleted_at, labels, source_collection_uid, quarantined_at
 FROM collections
-WHERE uid = $1;
+WHERE uid = $1 AND deleted_at IS NULL;
 
 -- name: GetCollectionByID :one
-SELECT id, uid, name, data, access_level, owner_id, organization_id, created_at, updated_at
+SELECT id, uid, name, data, access_level, owner_id, organization_id, created_at, updated_at, revision, version_retention, deleted_at, labels, source_collection_uid, quarantined_at
 FROM collections
-WHERE id = $1;
+WHERE id = $1 AND deleted_at IS NULL;
+
+-- name: GetCollectionByIDForUpdate :one
+SELECT id, uid, name, data, access_level, owner_id, organization_id, created_at, updated_at, revision, version_retention, deleted_at, labels, source_collection_uid, quarantined_at
+FROM collections
+WHERE id = $1 AND deleted_at IS NULL
+FOR UPDATE;
 
 -- name: UpdateCollection :one
 UPDATE collections
-SET name = $2, data = $3, access_level = $4, organization_id = $5, updated_at = now()
-WHERE id = $1
-RETURNING id, uid, name, data, access_level, owner_id, organization_id, created_at, updated_at;
+SET name = $2, data = $3, access_level = $4, organization_id = $5, version_retention = $6, labels = $7, updated_at = now(), revision = revision + 1
+WHERE id = $1 AND (sqlc.narg('expected_revision')::bigint IS NULL OR revision = sqlc.narg('expected_revision'))
+RETURNING id, uid, name, data, access_level, owner_id, organization_id, created_at, updated_at, revision, version_retention, deleted_at, labels, source_collection_uid, quarantined_at;
 
--- name: DeleteCollection :exec
-DELETE FROM collections
-WHERE id = $1;
+-- name: SoftDeleteCollection :execrows
+UPDATE collections
+SET deleted_at = now()
+WHERE id = $1 AND deleted_at IS NULL AND (sqlc.narg('expected_revision')::bigint IS NULL OR revision = sqlc.narg('expected_revision'));
 
 -- name: CheckUserOwnsCollection :one
 SELECT id FROM collections
-WHERE id = $1 AND owner_id = $2;
+WHERE id = $1 AND owner_id = $2 AND deleted_at IS NULL;
 
 -- name: CheckOrgOwnsCollection :one
 SELECT c.id FROM collections c
 JOIN organization_members om ON om.organization_id = c.organization_id
-WHERE c.id = $1 AND om.user_id = $2 AND c.access_level = 'organization';
+WHERE c.id = $1 AND om.user_id = $2 AND c.access_level = 'organization' AND c.deleted_at IS NULL;
+
+-- name: GetDeletedCollectionByUID :one
+SELECT id, uid, name, data, access_level, owner_id, organization_id, created_at, updated_at, revision, version_retention, deleted_at, labels, source_collection_uid, quarantined_at
+FROM collections
+WHERE uid = $1 AND deleted_at IS NOT NULL;
+
+-- name: UndeleteCollection :one
+UPDATE collections
+SET deleted_at = NULL
+WHERE id = $1 AND deleted_at IS NOT NULL
+RETURNING id, uid, name, data, access_level, owner_id, organization_id, created_at, updated_at, revision, version_retention, deleted_at, labels, source_collection_uid, quarantined_at;
+
+-- name: ListDeletedCollectionsForUser :many
+SELECT id, uid, name, data, access_level, owner_id, organization_id, created_at, updated_at, revision, version_retention, deleted_at, labels, source_collection_uid, quarantined_at
+FROM collections c
+WHERE c.deleted_at IS NOT NULL
+  AND (
+    c.owner_id = sqlc.arg('user_id')
+    OR (c.access_level = 'organization' AND c.organization_id IN (
+        SELECT om.organization_id FROM organization_members om WHERE om.user_id = sqlc.arg('user_id')
+    ))
+  )
+  AND (sqlc.narg('label_equals')::jsonb IS NULL OR c.labels @> sqlc.narg('label_equals'))
+  AND (sqlc.narg('label_not_equals')::jsonb IS NULL OR NOT EXISTS (
+    SELECT 1 FROM jsonb_array_elements(sqlc.narg('label_not_equals')) ne WHERE c.labels @> ne.value
+  ))
+  AND (sqlc.narg('label_exists')::text[] IS NULL OR c.labels ?& sqlc.narg('label_exists'))
+  AND (sqlc.narg('label_missing')::text[] IS NULL OR NOT c.labels ?| sqlc.narg('label_missing'))
+  AND (sqlc.narg('before_id')::int IS NULL OR c.id < sqlc.narg('before_id'))
+ORDER BY c.id DESC
+LIMIT sqlc.arg('page_size');
+
+-- name: PurgeDeletedCollections :execrows
+DELETE FROM collections
+WHERE deleted_at IS NOT NULL AND deleted_at < $1;
+
+-- name: SearchCollectionsForUser :many
+SELECT id, uid, name, data, access_level, owner_id, organization_id, created_at, updated_at, revision, version_retention, deleted_at, labels, source_collection_uid, quarantined_at
+FROM collections c
+WHERE c.deleted_at IS NULL
+  AND (
+    c.owner_id = sqlc.arg('user_id')
+    OR (c.access_level = 'organization' AND c.organization_id IN (
+        SELECT om.organization_id FROM organization_members om WHERE om.user_id = sqlc.arg('user_id')
+    ))
+  )
+  AND (sqlc.narg('query')::text IS NULL OR to_tsvector('simple', c.name) @@ websearch_to_tsquery('simple', sqlc.narg('query')))
+  AND (sqlc.narg('data_contains')::jsonb IS NULL OR c.data @> sqlc.narg('data_contains'))
+  AND (sqlc.narg('data_path')::text IS NULL OR c.data @@ sqlc.narg('data_path')::text::jsonpath)
+  AND (sqlc.narg('label_equals')::jsonb IS NULL OR c.labels @> sqlc.narg('label_equals'))
+  AND (sqlc.narg('label_not_equals')::jsonb IS NULL OR NOT EXISTS (
+    SELECT 1 FROM jsonb_array_elements(sqlc.narg('label_not_equals')) ne WHERE c.labels @> ne.value
+  ))
+  AND (sqlc.narg('label_exists')::text[] IS NULL OR c.labels ?& sqlc.narg('label_exists'))
+  AND (sqlc.narg('label_missing')::text[] IS NULL OR NOT c.labels ?| sqlc.narg('label_missing'))
+  AND (sqlc.narg('before_id')::int IS NULL OR c.id < sqlc.narg('before_id'))
+ORDER BY c.id DESC
+LIMIT sqlc.arg('page_size');
+
+-- name: GetCollectionUIDByID :one
+-- Includes collections in the trash, for showing references to them.
+SELECT uid FROM collections
+WHERE id = $1;
+
+-- name: TouchCollection :one
+-- Bumps the revision for changes stored outside the collections row, such as the payload.
+UPDATE collections
+SET updated_at = now(), revision = revision + 1
+WHERE id = $1 AND (sqlc.narg('expected_revision')::bigint IS NULL OR revision = sqlc.narg('expected_revision'))
+RETURNING id, uid, name, data, access_level, owner_id, organization_id, created_at, updated_at, revision, version_retention, deleted_at, labels, source_collection_uid, quarantined_at;
@@ -13,5 +13,18 @@ VALUES ($1)
 RETURNING id, uid, name, created_at, updated_at;
 
 -- name: AddOrganizationMember :exec
-INSERT INTO organization_members (user_id, organization_id)
-VALUES ($1, $2);
+INSERT INTO organization_members (user_id, organization_id, role)
+VALUES ($1, $2, $3);
+
+-- name: GetOrganizationByID :one
+SELECT id, uid, name, created_at, updated_at
+FROM organizations
+WHERE id = $1;
+
+-- name: DeleteOrganization :execrows
+DELETE FROM organizations
+WHERE id = $1;
+
+-- name: GetOrganizationMemberRole :one
+SELECT role FROM organization_members
+WHERE user_id = $1 AND organization_id = $2;
@@ -0,0 +1,60 @@
+-- name: ReleaseUserFromOrganizationCollections :exec
+-- Collections the user shared with an organization stay with the organization. Private and shared ones
+-- that only carry an organization_id are orphans, the organization could never see them.
+UPDATE collections
+SET owner_id = NULL, updated_at = now(), revision = revision + 1
+WHERE owner_id = $1 AND organization_id IS NOT NULL AND access_level = 'organization';
+
+-- name: ReleaseOrganizationFromOwnedCollections :exec
+-- Collections in the organization that also have an owner go back to being the owner's.
+UPDATE collections
+SET organization_id = NULL,
+    access_level = CASE WHEN access_level = 'organization' THEN 'private'::access_level ELSE access_level END,
+    updated_at = now(), revision = revision + 1
+WHERE organization_id = $1 AND owner_id IS NOT NULL;
+
+-- name: ListOrphanedCollectionsForUser :many
+-- Everything the user still owns once organization collections are released.
+SELECT id, organization_id FROM collections
+WHERE owner_id = $1;
+
+-- name: ListOrphanedCollectionIDsForOrganization :many
+SELECT id FROM collections
+WHERE organization_id = $1 AND owner_id IS NULL;
+
+-- name: ReassignCollections :execrows
+UPDATE collections
+SET owner_id = sqlc.arg('owner_id'), organization_id = NULL, quarantined_at = NULL,
+    access_level = CASE WHEN access_level = 'organization' THEN 'private'::access_level ELSE access_level END,
+    updated_at = now(), revision = revision + 1
+WHERE id = ANY(sqlc.arg('ids')::int[]);
+
+-- name: QuarantineCollections :execrows
+UPDATE collections
+SET owner_id = NULL, organization_id = NULL, quarantined_at = now(), updated_at = now(), revision = revision + 1
+WHERE id = ANY(sqlc.arg('ids')::int[]);
+
+-- name: DeleteCollectionsByID :execrows
+DELETE FROM collections
+WHERE id = ANY(sqlc.arg('ids')::int[]);
+
+-- name: GetOrganizationAdmin :one
+SELECT user_id FROM organization_members
+WHERE organization_id = sqlc.arg('organization_id') AND role = 'admin' AND user_id <> sqlc.arg('exclude_user_id')
+ORDER BY id
+LIMIT 1;
+
+-- name: ListQuarantinedCollections :many
+SELECT id, uid, name, data, access_level, owner_id, organization_id, created_at, updated_at, revision, version_retention, deleted_at, labels, source_collection_uid, quarantined_at
+FROM collections
+WHERE quarantined_at IS NOT NULL AND (sqlc.narg('before_id')::int IS NULL OR id < sqlc.narg('before_id'))
+ORDER BY id DESC
+LIMIT sqlc.arg('page_size');
+
+-- name: ReassignQuarantinedCollection :one
+UPDATE collections
+SET owner_id = $2, quarantined_at = NULL,
+    access_level = CASE WHEN access_level = 'organization' THEN 'private'::access_level ELSE access_level END,
+    updated_at = now(), revision = revision + 1
+WHERE uid = $1 AND quarantined_at IS NOT NULL
+RETURNING id, uid, name, data, access_level, owner_id, organization_id, created_at, updated_at, revision, version_retention, deleted_at, labels, source_collection_uid, quarantined_at;
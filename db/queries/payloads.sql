@@ -0,0 +1,65 @@
+-- name: CreatePayloadUpload :one
+INSERT INTO payload_uploads (collection_id)
+VALUES ($1)
+RETURNING id;
+
+-- name: StageBlobChunk :exec
+-- Stores a chunk and marks it as the upload's in one statement, so it is never unreferenced and unmarked.
+WITH chunk AS (
+    INSERT INTO blob_chunks (hash, data)
+    VALUES (sqlc.arg('hash'), sqlc.arg('data'))
+    ON CONFLICT (hash) DO UPDATE SET created_at = blob_chunks.created_at
+)
+INSERT INTO payload_upload_chunks (upload_id, chunk_hash)
+VALUES (sqlc.arg('upload_id'), sqlc.arg('hash'))
+ON CONFLICT DO NOTHING;
+
+-- name: DeletePayloadUpload :execrows
+DELETE FROM payload_uploads
+WHERE id = $1;
+
+-- name: DeleteStalePayloadUploads :execrows
+-- Uploads started before the cutoff belong to a server that stopped before finishing or cleaning them up.
+DELETE FROM payload_uploads
+WHERE created_at < $1;
+
+-- name: GetCollectionPayload :one
+SELECT collection_id, content_type, size, sha256, chunk_size, updated_at
+FROM collection_payloads
+WHERE collection_id = $1;
+
+-- name: ListCollectionPayloadChunkHashes :many
+SELECT chunk_hash FROM collection_payload_chunks
+WHERE collection_id = $1
+ORDER BY seq;
+
+-- name: DeleteCollectionPayload :exec
+DELETE FROM collection_payloads
+WHERE collection_id = $1;
+
+-- name: CreateCollectionPayload :one
+INSERT INTO collection_payloads (collection_id, content_type, size, sha256, chunk_size)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING collection_id, content_type, size, sha256, chunk_size, updated_at;
+
+-- name: AddCollectionPayloadChunks :exec
+INSERT INTO collection_payload_chunks (collection_id, seq, chunk_hash)
+SELECT sqlc.arg('collection_id')::int, (t.ord - 1)::int, t.hash
+FROM unnest(sqlc.arg('hashes')::bytea[]) WITH ORDINALITY AS t(hash, ord);
+
+-- name: GetCollectionPayloadChunk :one
+SELECT b.data FROM collection_payload_chunks c
+JOIN blob_chunks b ON b.hash = c.chunk_hash
+WHERE c.collection_id = $1 AND c.seq = $2;
+
+-- name: DeleteUnreferencedBlobChunks :execrows
+-- Without hashes every unreferenced chunk is removed. Chunks an upload in flight has stored are marked in
+-- payload_upload_chunks and left alone, the upload is about to reference them.
+DELETE FROM blob_chunks
+WHERE hash IN (
+    SELECT b.hash FROM blob_chunks b
+    WHERE (sqlc.narg('hashes')::bytea[] IS NULL OR b.hash = ANY(sqlc.narg('hashes')::bytea[]))
+      AND NOT EXISTS (SELECT 1 FROM collection_payload_chunks c WHERE c.chunk_hash = b.hash)
+      AND NOT EXISTS (SELECT 1 FROM payload_upload_chunks u WHERE u.chunk_hash = b.hash)
+    FOR UPDATE SKIP LOCKED
+);
@@ -0,0 +1,18 @@
+-- Usage excludes collections in the trash, storage is measured as the size of the stored JSON text plus
+-- the size of any payload.
+
+-- name: GetUserUsage :one
+SELECT
+    (SELECT count(*) FROM collections c WHERE c.owner_id = sqlc.arg('user_id')::int AND c.deleted_at IS NULL)::bigint AS collections,
+    (SELECT coalesce(sum(octet_length(c.data::text)), 0) + coalesce(sum(p.size), 0) FROM collections c LEFT JOIN collection_payloads p ON p.collection_id = c.id WHERE c.owner_id = sqlc.arg('user_id')::int AND c.deleted_at IS NULL)::bigint AS storage_bytes,
+    (SELECT count(*) FROM share_links sl WHERE sl.created_by = sqlc.arg('user_id')::int)::bigint AS share_tokens;
+
+-- name: GetOrganizationUsage :one
+SELECT
+    (SELECT count(*) FROM collections c WHERE c.organization_id = sqlc.arg('organization_id')::int AND c.deleted_at IS NULL)::bigint AS collections,
+    (SELECT coalesce(sum(octet_length(c.data::text)), 0) + coalesce(sum(p.size), 0) FROM collections c LEFT JOIN collection_payloads p ON p.collection_id = c.id WHERE c.organization_id = sqlc.arg('organization_id')::int AND c.deleted_at IS NULL)::bigint AS storage_bytes,
+    (SELECT count(*) FROM share_links sl JOIN collections c ON c.id = sl.collection_id WHERE c.organization_id = sqlc.arg('organization_id')::int)::bigint AS share_tokens;
+
+-- name: GetDataSize :one
+-- Measures data the way usage does, as the text of the jsonb value rather than the JSON it was written as.
+SELECT octet_length(sqlc.arg('data')::jsonb::text)::bigint;
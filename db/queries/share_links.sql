@@ -1,24 +1,58 @@
 -- name: CreateShareLink :one
-INSERT INTO share_links (token, collection_id, created_by)
-VALUES ($1, $2, $3)
-RETURNING id, token, collection_id, access_count, created_by, created_at;
+INSERT INTO share_links (token, collection_id, created_by, rate_limit, rate_limit_window_seconds, cache_max_age_seconds, expires_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+RETURNING id, token, collection_id, access_count, created_by, created_at, rate_limit, rate_limit_window_seconds, suspended_at, suspended_reason, cache_max_age_seconds, expires_at;
 
 -- name: GetShareLinkByToken :one
-SELECT id, token, collection_id, access_count, created_by, created_at
+SELECT id, token, collection_id, access_count, created_by, created_at, rate_limit, rate_limit_window_seconds, suspended_at, suspended_reason, cache_max_age_seconds, expires_at
 FROM share_links
 WHERE token = $1;
 
 -- name: IncrementAccessCount :one
+-- A link only serves a shared collection that is not in the trash, hits on anything else are not counted.
+-- GetSharedCollectionRevision applies the same rule.
 UPDATE share_links
 SET access_count = access_count + 1
-WHERE token = $1
-RETURNING id, token, collection_id, access_count, created_by, created_at;
+WHERE token = $1 AND suspended_at IS NULL AND (expires_at IS NULL OR expires_at > now())
+  AND collection_id IN (SELECT id FROM collections WHERE deleted_at IS NULL AND access_level = 'shared')
+RETURNING id, token, collection_id, access_count, created_by, created_at, rate_limit, rate_limit_window_seconds, suspended_at, suspended_reason, cache_max_age_seconds, expires_at;
+
+-- name: GetSharedCollectionRevision :one
+-- Revalidating a cached share link only needs to know the token still works and which revision it shows.
+SELECT c.uid AS collection_uid, c.revision, sl.cache_max_age_seconds
+FROM share_links sl
+JOIN collections c ON c.id = sl.collection_id
+WHERE sl.token = $1 AND sl.suspended_at IS NULL AND (sl.expires_at IS NULL OR sl.expires_at > now())
+  AND c.deleted_at IS NULL AND c.access_level = 'shared';
 
 -- name: DeleteShareLinkByToken :exec
 DELETE FROM share_links
 WHERE token = $1;
 
 -- name: GetShareLinksByCollectionID :many
-SELECT id, token, collection_id, access_count, created_by, created_at
+SELECT id, token, collection_id, access_count, created_by, created_at, rate_limit, rate_limit_window_seconds, suspended_at, suspended_reason, cache_max_age_seconds, expires_at
 FROM share_links
 WHERE collection_id = $1;
+
+-- name: UpdateShareLinkOverrides :one
+UPDATE share_links
+SET rate_limit = $2, rate_limit_window_seconds = $3, cache_max_age_seconds = $4
+WHERE token = $1
+RETURNING id, token, collection_id, access_count, created_by, created_at, rate_limit, rate_limit_window_seconds, suspended_at, suspended_reason, cache_max_age_seconds, expires_at;
+
+-- name: GetShareLinkRateLimit :one
+SELECT rate_limit, rate_limit_window_seconds
+FROM share_links
+WHERE token = $1;
+
+-- name: SuspendShareLink :one
+UPDATE share_links
+SET suspended_at = now(), suspended_reason = $2
+WHERE token = $1
+RETURNING id, token, collection_id, access_count, created_by, created_at, rate_limit, rate_limit_window_seconds, suspended_at, suspended_reason, cache_max_age_seconds, expires_at;
+
+-- name: ResumeShareLink :one
+UPDATE share_links
+SET suspended_at = NULL, suspended_reason = NULL
+WHERE token = $1
+RETURNING id, token, collection_id, access_count, created_by, created_at, rate_limit, rate_limit_window_seconds, suspended_at, suspended_reason, cache_max_age_seconds, expires_at;
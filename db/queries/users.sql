@@ -21,4 +21,8 @@ RETURNING id, uid, email, created_at, updated_at;
 -- name: GetUserByUID :one
 SELECT id, uid, email, created_at, updated_at
 FROM users
-WHERE uid = $1;
\ No newline at end of file
+WHERE uid = $1;
+
+-- name: DeleteUser :execrows
+DELETE FROM users
+WHERE id = $1;
@@ -0,0 +1,98 @@
+-- name: CreateWebhookSubscription :one
+INSERT INTO webhook_subscriptions (organization_id, url, secret, event_types, created_by)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING id, uid, organization_id, url, secret, event_types, created_by, created_at;
+
+-- name: GetWebhookSubscriptionByUID :one
+SELECT id, uid, organization_id, url, secret, event_types, created_by, created_at
+FROM webhook_subscriptions
+WHERE uid = $1;
+
+-- name: ListWebhookSubscriptions :many
+SELECT id, uid, organization_id, url, secret, event_types, created_by, created_at
+FROM webhook_subscriptions
+WHERE organization_id = $1
+ORDER BY id;
+
+-- name: DeleteWebhookSubscription :exec
+DELETE FROM webhook_subscriptions
+WHERE id = $1;
+
+-- name: FanOutOutboxEvents :execrows
+-- Creates a delivery for every subscription interested in the oldest undispatched events and marks them
+-- dispatched. SKIP LOCKED lets every replica run the dispatcher.
+WITH events AS (
+    SELECT id, event_type, organization_id FROM outbox_events
+    WHERE dispatched_at IS NULL
+    ORDER BY id
+    LIMIT sqlc.arg('batch_size')
+    FOR UPDATE SKIP LOCKED
+), deliveries AS (
+    INSERT INTO webhook_deliveries (subscription_id, event_id)
+    SELECT s.id, e.id FROM events e
+    JOIN webhook_subscriptions s ON s.organization_id = e.organization_id
+    WHERE cardinality(s.event_types) = 0 OR e.event_type = ANY(s.event_types)
+    ON CONFLICT (subscription_id, event_id) DO NOTHING
+)
+UPDATE outbox_events SET dispatched_at = now()
+WHERE id IN (SELECT id FROM events);
+
+-- name: ClaimDueWebhookDeliveries :many
+-- Pushes next_attempt_at out by the lease so no other dispatcher picks the delivery up while it is in flight.
+WITH claimed AS (
+    UPDATE webhook_deliveries d
+    SET next_attempt_at = now() + make_interval(secs => sqlc.arg('lease_seconds')::int)
+    WHERE d.id IN (
+        SELECT id FROM webhook_deliveries
+        WHERE status = 'pending' AND next_attempt_at <= now()
+        ORDER BY next_attempt_at
+        LIMIT sqlc.arg('batch_size')
+        FOR UPDATE SKIP LOCKED
+    )
+    RETURNING d.id, d.subscription_id, d.event_id, d.attempts
+)
+SELECT c.id, c.attempts, s.url, s.secret, e.uid AS event_uid, e.event_type, e.payload, e.created_at AS event_created_at,
+    o.uid AS organization_uid
+FROM claimed c
+JOIN webhook_subscriptions s ON s.id = c.subscription_id
+JOIN outbox_events e ON e.id = c.event_id
+JOIN organizations o ON o.id = e.organization_id;
+
+-- name: MarkWebhookDelivered :exec
+UPDATE webhook_deliveries
+SET status = 'delivered', attempts = attempts + 1, delivered_at = now(), last_error = NULL
+WHERE id = $1;
+
+-- name: MarkWebhookDeliveryFailed :exec
+-- Schedules another attempt, or moves the delivery to the dead letter list once max_attempts is reached.
+UPDATE webhook_deliveries
+SET attempts = attempts + 1,
+    last_error = sqlc.arg('last_error'),
+    next_attempt_at = sqlc.arg('next_attempt_at'),
+    status = CASE WHEN attempts + 1 >= sqlc.arg('max_attempts')::int THEN 'dead'::webhook_delivery_status ELSE 'pending'::webhook_delivery_status END
+WHERE id = sqlc.arg('id');
+
+-- name: ListWebhookDeliveries :many
+SELECT d.id, d.status, d.attempts, d.next_attempt_at, d.last_error, d.delivered_at, d.created_at,
+    e.uid AS event_uid, e.event_type
+FROM webhook_deliveries d
+JOIN outbox_events e ON e.id = d.event_id
+WHERE d.subscription_id = sqlc.arg('subscription_id')
+  AND (sqlc.narg('status')::webhook_delivery_status IS NULL OR d.status = sqlc.narg('status'))
+  AND (sqlc.narg('before_id')::int IS NULL OR d.id < sqlc.narg('before_id'))
+ORDER BY d.id DESC
+LIMIT sqlc.arg('page_size');
+
+-- name: RetryWebhookDelivery :execrows
+UPDATE webhook_deliveries
+SET status = 'pending', attempts = 0, next_attempt_at = now(), last_error = NULL
+WHERE id = $1 AND subscription_id = $2 AND status = 'dead';
+
+-- name: DeleteDeliveredOutboxEvents :execrows
+-- Events are kept while any delivery is pending or dead so the dead letter list can be retried.
+DELETE FROM outbox_events e
+WHERE e.dispatched_at < sqlc.arg('cutoff')
+  AND NOT EXISTS (
+    SELECT 1 FROM webhook_deliveries d
+    WHERE d.event_id = e.id AND d.status <> 'delivered'
+  );
@@ -7,9 +7,11 @@
 package censysv1
 
 import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
 	structpb "google.golang.org/protobuf/types/known/structpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
@@ -24,6 +26,55 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type OrganizationRole int32
+
+const (
+	OrganizationRole_ORGANIZATION_ROLE_UNSPECIFIED OrganizationRole = 0 // treated as member
+	OrganizationRole_ORGANIZATION_ROLE_MEMBER      OrganizationRole = 1
+	OrganizationRole_ORGANIZATION_ROLE_ADMIN       OrganizationRole = 2
+)
+
+// Enum value maps for OrganizationRole.
+var (
+	OrganizationRole_name = map[int32]string{
+		0: "ORGANIZATION_ROLE_UNSPECIFIED",
+		1: "ORGANIZATION_ROLE_MEMBER",
+		2: "ORGANIZATION_ROLE_ADMIN",
+	}
+	OrganizationRole_value = map[string]int32{
+		"ORGANIZATION_ROLE_UNSPECIFIED": 0,
+		"ORGANIZATION_ROLE_MEMBER":      1,
+		"ORGANIZATION_ROLE_ADMIN":       2,
+	}
+)
+
+func (x OrganizationRole) Enum() *OrganizationRole {
+	p := new(OrganizationRole)
+	*p = x
+	return p
+}
+
+func (x OrganizationRole) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (OrganizationRole) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_service_proto_enumTypes[0].Descriptor()
+}
+
+func (OrganizationRole) Type() protoreflect.EnumType {
+	return &file_proto_service_proto_enumTypes[0]
+}
+
+func (x OrganizationRole) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use OrganizationRole.Descriptor instead.
+func (OrganizationRole) EnumDescriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{0}
+}
+
 type AccessLevel int32
 
 const (
@@ -60,11 +111,11 @@ func (x AccessLevel) String() string {
 }
 
 func (AccessLevel) Descriptor() protoreflect.EnumDescriptor {
-	return file_proto_service_proto_enumTypes[0].Descriptor()
+	return file_proto_service_proto_enumTypes[1].Descriptor()
 }
 
 func (AccessLevel) Type() protoreflect.EnumType {
-	return &file_proto_service_proto_enumTypes[0]
+	return &file_proto_service_proto_enumTypes[1]
 }
 
 func (x AccessLevel) Number() protoreflect.EnumNumber {
@@ -73,7 +124,318 @@ func (x AccessLevel) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use AccessLevel.Descriptor instead.
 func (AccessLevel) EnumDescriptor() ([]byte, []int) {
-	return file_proto_service_proto_rawDescGZIP(), []int{0}
+	return file_proto_service_proto_rawDescGZIP(), []int{1}
+}
+
+type PatchType int32
+
+const (
+	PatchType_PATCH_TYPE_UNSPECIFIED PatchType = 0
+	PatchType_PATCH_TYPE_JSON_PATCH  PatchType = 1 // RFC 6902
+	PatchType_PATCH_TYPE_MERGE_PATCH PatchType = 2 // RFC 7396
+)
+
+// Enum value maps for PatchType.
+var (
+	PatchType_name = map[int32]string{
+		0: "PATCH_TYPE_UNSPECIFIED",
+		1: "PATCH_TYPE_JSON_PATCH",
+		2: "PATCH_TYPE_MERGE_PATCH",
+	}
+	PatchType_value = map[string]int32{
+		"PATCH_TYPE_UNSPECIFIED": 0,
+		"PATCH_TYPE_JSON_PATCH":  1,
+		"PATCH_TYPE_MERGE_PATCH": 2,
+	}
+)
+
+func (x PatchType) Enum() *PatchType {
+	p := new(PatchType)
+	*p = x
+	return p
+}
+
+func (x PatchType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PatchType) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_service_proto_enumTypes[2].Descriptor()
+}
+
+func (PatchType) Type() protoreflect.EnumType {
+	return &file_proto_service_proto_enumTypes[2]
+}
+
+func (x PatchType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PatchType.Descriptor instead.
+func (PatchType) EnumDescriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{2}
+}
+
+type CollectionEventType int32
+
+const (
+	CollectionEventType_COLLECTION_EVENT_TYPE_UNSPECIFIED CollectionEventType = 0
+	CollectionEventType_COLLECTION_EVENT_TYPE_CREATED     CollectionEventType = 1 // also sent when a collection is restored from the trash
+	CollectionEventType_COLLECTION_EVENT_TYPE_UPDATED     CollectionEventType = 2
+	CollectionEventType_COLLECTION_EVENT_TYPE_DELETED     CollectionEventType = 3
+	// the access level, owner or organization changed
+	CollectionEventType_COLLECTION_EVENT_TYPE_ACCESS_CHANGED CollectionEventType = 4
+)
+
+// Enum value maps for CollectionEventType.
+var (
+	CollectionEventType_name = map[int32]string{
+		0: "COLLECTION_EVENT_TYPE_UNSPECIFIED",
+		1: "COLLECTION_EVENT_TYPE_CREATED",
+		2: "COLLECTION_EVENT_TYPE_UPDATED",
+		3: "COLLECTION_EVENT_TYPE_DELETED",
+		4: "COLLECTION_EVENT_TYPE_ACCESS_CHANGED",
+	}
+	CollectionEventType_value = map[string]int32{
+		"COLLECTION_EVENT_TYPE_UNSPECIFIED":    0,
+		"COLLECTION_EVENT_TYPE_CREATED":        1,
+		"COLLECTION_EVENT_TYPE_UPDATED":        2,
+		"COLLECTION_EVENT_TYPE_DELETED":        3,
+		"COLLECTION_EVENT_TYPE_ACCESS_CHANGED": 4,
+	}
+)
+
+func (x CollectionEventType) Enum() *CollectionEventType {
+	p := new(CollectionEventType)
+	*p = x
+	return p
+}
+
+func (x CollectionEventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CollectionEventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_service_proto_enumTypes[3].Descriptor()
+}
+
+func (CollectionEventType) Type() protoreflect.EnumType {
+	return &file_proto_service_proto_enumTypes[3]
+}
+
+func (x CollectionEventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CollectionEventType.Descriptor instead.
+func (CollectionEventType) EnumDescriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{3}
+}
+
+type WebhookDeliveryStatus int32
+
+const (
+	WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_UNSPECIFIED WebhookDeliveryStatus = 0
+	WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_PENDING     WebhookDeliveryStatus = 1
+	WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_DELIVERED   WebhookDeliveryStatus = 2
+	// out of attempts, the dead letter list
+	WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_DEAD WebhookDeliveryStatus = 3
+)
+
+// Enum value maps for WebhookDeliveryStatus.
+var (
+	WebhookDeliveryStatus_name = map[int32]string{
+		0: "WEBHOOK_DELIVERY_STATUS_UNSPECIFIED",
+		1: "WEBHOOK_DELIVERY_STATUS_PENDING",
+		2: "WEBHOOK_DELIVERY_STATUS_DELIVERED",
+		3: "WEBHOOK_DELIVERY_STATUS_DEAD",
+	}
+	WebhookDeliveryStatus_value = map[string]int32{
+		"WEBHOOK_DELIVERY_STATUS_UNSPECIFIED": 0,
+		"WEBHOOK_DELIVERY_STATUS_PENDING":     1,
+		"WEBHOOK_DELIVERY_STATUS_DELIVERED":   2,
+		"WEBHOOK_DELIVERY_STATUS_DEAD":        3,
+	}
+)
+
+func (x WebhookDeliveryStatus) Enum() *WebhookDeliveryStatus {
+	p := new(WebhookDeliveryStatus)
+	*p = x
+	return p
+}
+
+func (x WebhookDeliveryStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (WebhookDeliveryStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_service_proto_enumTypes[4].Descriptor()
+}
+
+func (WebhookDeliveryStatus) Type() protoreflect.EnumType {
+	return &file_proto_service_proto_enumTypes[4]
+}
+
+func (x WebhookDeliveryStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use WebhookDeliveryStatus.Descriptor instead.
+func (WebhookDeliveryStatus) EnumDescriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{4}
+}
+
+type ImportMode int32
+
+const (
+	ImportMode_IMPORT_MODE_UNSPECIFIED ImportMode = 0 // best effort
+	// every record is created on its own and failures are reported per record
+	ImportMode_IMPORT_MODE_BEST_EFFORT ImportMode = 1
+	// all records are created in one transaction, the first failure aborts the import
+	ImportMode_IMPORT_MODE_TRANSACTIONAL ImportMode = 2
+)
+
+// Enum value maps for ImportMode.
+var (
+	ImportMode_name = map[int32]string{
+		0: "IMPORT_MODE_UNSPECIFIED",
+		1: "IMPORT_MODE_BEST_EFFORT",
+		2: "IMPORT_MODE_TRANSACTIONAL",
+	}
+	ImportMode_value = map[string]int32{
+		"IMPORT_MODE_UNSPECIFIED":   0,
+		"IMPORT_MODE_BEST_EFFORT":   1,
+		"IMPORT_MODE_TRANSACTIONAL": 2,
+	}
+)
+
+func (x ImportMode) Enum() *ImportMode {
+	p := new(ImportMode)
+	*p = x
+	return p
+}
+
+func (x ImportMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ImportMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_service_proto_enumTypes[5].Descriptor()
+}
+
+func (ImportMode) Type() protoreflect.EnumType {
+	return &file_proto_service_proto_enumTypes[5]
+}
+
+func (x ImportMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ImportMode.Descriptor instead.
+func (ImportMode) EnumDescriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{5}
+}
+
+type ExportFormat int32
+
+const (
+	ExportFormat_EXPORT_FORMAT_UNSPECIFIED ExportFormat = 0 // protobuf
+	ExportFormat_EXPORT_FORMAT_PROTO       ExportFormat = 1
+	// each message carries one JSON line that ImportCollections accepts back
+	ExportFormat_EXPORT_FORMAT_NDJSON ExportFormat = 2
+)
+
+// Enum value maps for ExportFormat.
+var (
+	ExportFormat_name = map[int32]string{
+		0: "EXPORT_FORMAT_UNSPECIFIED",
+		1: "EXPORT_FORMAT_PROTO",
+		2: "EXPORT_FORMAT_NDJSON",
+	}
+	ExportFormat_value = map[string]int32{
+		"EXPORT_FORMAT_UNSPECIFIED": 0,
+		"EXPORT_FORMAT_PROTO":       1,
+		"EXPORT_FORMAT_NDJSON":      2,
+	}
+)
+
+func (x ExportFormat) Enum() *ExportFormat {
+	p := new(ExportFormat)
+	*p = x
+	return p
+}
+
+func (x ExportFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ExportFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_service_proto_enumTypes[6].Descriptor()
+}
+
+func (ExportFormat) Type() protoreflect.EnumType {
+	return &file_proto_service_proto_enumTypes[6]
+}
+
+func (x ExportFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ExportFormat.Descriptor instead.
+func (ExportFormat) EnumDescriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{6}
+}
+
+type TransferStatus int32
+
+const (
+	TransferStatus_TRANSFER_STATUS_UNSPECIFIED TransferStatus = 0
+	TransferStatus_TRANSFER_STATUS_PENDING     TransferStatus = 1
+	TransferStatus_TRANSFER_STATUS_ACCEPTED    TransferStatus = 2
+	TransferStatus_TRANSFER_STATUS_DECLINED    TransferStatus = 3
+)
+
+// Enum value maps for TransferStatus.
+var (
+	TransferStatus_name = map[int32]string{
+		0: "TRANSFER_STATUS_UNSPECIFIED",
+		1: "TRANSFER_STATUS_PENDING",
+		2: "TRANSFER_STATUS_ACCEPTED",
+		3: "TRANSFER_STATUS_DECLINED",
+	}
+	TransferStatus_value = map[string]int32{
+		"TRANSFER_STATUS_UNSPECIFIED": 0,
+		"TRANSFER_STATUS_PENDING":     1,
+		"TRANSFER_STATUS_ACCEPTED":    2,
+		"TRANSFER_STATUS_DECLINED":    3,
+	}
+)
+
+func (x TransferStatus) Enum() *TransferStatus {
+	p := new(TransferStatus)
+	*p = x
+	return p
+}
+
+func (x TransferStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TransferStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_service_proto_enumTypes[7].Descriptor()
+}
+
+func (TransferStatus) Type() protoreflect.EnumType {
+	return &file_proto_service_proto_enumTypes[7]
+}
+
+func (x TransferStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TransferStatus.Descriptor instead.
+func (TransferStatus) EnumDescriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{7}
 }
 
 type User struct {
@@ -272,6 +634,7 @@ type AddOrganizationMemberRequest struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
 	UserUid         string                 `protobuf:"bytes,1,opt,name=user_uid,json=userUid,proto3" json:"user_uid,omitempty"`
 	OrganizationUid string                 `protobuf:"bytes,2,opt,name=organization_uid,json=organizationUid,proto3" json:"organization_uid,omitempty"`
+	Role            OrganizationRole       `protobuf:"varint,3,opt,name=role,proto3,enum=censys.v1.OrganizationRole" json:"role,omitempty"`
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
@@ -320,10 +683,18 @@ func (x *AddOrganizationMemberRequest) GetOrganizationUid() string {
 	return ""
 }
 
+func (x *AddOrganizationMemberRequest) GetRole() OrganizationRole {
+	if x != nil {
+		return x.Role
+	}
+	return OrganizationRole_ORGANIZATION_ROLE_UNSPECIFIED
+}
+
 type OrganizationMembership struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
 	Organization  *Organization          `protobuf:"bytes,2,opt,name=organization,proto3" json:"organization,omitempty"`
+	Role          OrganizationRole       `protobuf:"varint,3,opt,name=role,proto3,enum=censys.v1.OrganizationRole" json:"role,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -372,27 +743,39 @@ func (x *OrganizationMembership) GetOrganization() *Organization {
 	return nil
 }
 
-type LoginRequest struct {
+func (x *OrganizationMembership) GetRole() OrganizationRole {
+	if x != nil {
+		return x.Role
+	}
+	return OrganizationRole_ORGANIZATION_ROLE_UNSPECIFIED
+}
+
+// Deleting a user or organization first hands over what it owned. Organization collections of a deleted
+// user stay with the organization and owned collections of a deleted organization stay with their owner.
+// Collections left with neither are handled by the server's orphan policy and counted here.
+type OrphanedCollections struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	Reassigned    int32                  `protobuf:"varint,1,opt,name=reassigned,proto3" json:"reassigned,omitempty"`
+	Quarantined   int32                  `protobuf:"varint,2,opt,name=quarantined,proto3" json:"quarantined,omitempty"`
+	Deleted       int32                  `protobuf:"varint,3,opt,name=deleted,proto3" json:"deleted,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *LoginRequest) Reset() {
-	*x = LoginRequest{}
+func (x *OrphanedCollections) Reset() {
+	*x = OrphanedCollections{}
 	mi := &file_proto_service_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LoginRequest) String() string {
+func (x *OrphanedCollections) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LoginRequest) ProtoMessage() {}
+func (*OrphanedCollections) ProtoMessage() {}
 
-func (x *LoginRequest) ProtoReflect() protoreflect.Message {
+func (x *OrphanedCollections) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_service_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -404,39 +787,53 @@ func (x *LoginRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LoginRequest.ProtoReflect.Descriptor instead.
-func (*LoginRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use OrphanedCollections.ProtoReflect.Descriptor instead.
+func (*OrphanedCollections) Descriptor() ([]byte, []int) {
 	return file_proto_service_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *LoginRequest) GetEmail() string {
+func (x *OrphanedCollections) GetReassigned() int32 {
 	if x != nil {
-		return x.Email
+		return x.Reassigned
 	}
-	return ""
+	return 0
 }
 
-type LoginResponse struct {
+func (x *OrphanedCollections) GetQuarantined() int32 {
+	if x != nil {
+		return x.Quarantined
+	}
+	return 0
+}
+
+func (x *OrphanedCollections) GetDeleted() int32 {
+	if x != nil {
+		return x.Deleted
+	}
+	return 0
+}
+
+type DeleteUserRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	UserUid       string                 `protobuf:"bytes,1,opt,name=user_uid,json=userUid,proto3" json:"user_uid,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *LoginResponse) Reset() {
-	*x = LoginResponse{}
+func (x *DeleteUserRequest) Reset() {
+	*x = DeleteUserRequest{}
 	mi := &file_proto_service_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LoginResponse) String() string {
+func (x *DeleteUserRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LoginResponse) ProtoMessage() {}
+func (*DeleteUserRequest) ProtoMessage() {}
 
-func (x *LoginResponse) ProtoReflect() protoreflect.Message {
+func (x *DeleteUserRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_service_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -448,46 +845,39 @@ func (x *LoginResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LoginResponse.ProtoReflect.Descriptor instead.
-func (*LoginResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteUserRequest.ProtoReflect.Descriptor instead.
+func (*DeleteUserRequest) Descriptor() ([]byte, []int) {
 	return file_proto_service_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *LoginResponse) GetToken() string {
+func (x *DeleteUserRequest) GetUserUid() string {
 	if x != nil {
-		return x.Token
+		return x.UserUid
 	}
 	return ""
 }
 
-type Collection struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Uid            string                 `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
-	Name           string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Data           *structpb.Struct       `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
-	AccessLevel    AccessLevel            `protobuf:"varint,4,opt,name=access_level,json=accessLevel,proto3,enum=censys.v1.AccessLevel" json:"access_level,omitempty"`
-	OwnerId        string                 `protobuf:"bytes,5,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
-	OrganizationId string                 `protobuf:"bytes,6,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
-	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt      *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+type DeleteOrganizationRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationUid string                 `protobuf:"bytes,1,opt,name=organization_uid,json=organizationUid,proto3" json:"organization_uid,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *Collection) Reset() {
-	*x = Collection{}
+func (x *DeleteOrganizationRequest) Reset() {
+	*x = DeleteOrganizationRequest{}
 	mi := &file_proto_service_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Collection) String() string {
+func (x *DeleteOrganizationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Collection) ProtoMessage() {}
+func (*DeleteOrganizationRequest) ProtoMessage() {}
 
-func (x *Collection) ProtoReflect() protoreflect.Message {
+func (x *DeleteOrganizationRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_service_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -499,92 +889,241 @@ func (x *Collection) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Collection.ProtoReflect.Descriptor instead.
-func (*Collection) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteOrganizationRequest.ProtoReflect.Descriptor instead.
+func (*DeleteOrganizationRequest) Descriptor() ([]byte, []int) {
 	return file_proto_service_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *Collection) GetUid() string {
+func (x *DeleteOrganizationRequest) GetOrganizationUid() string {
 	if x != nil {
-		return x.Uid
+		return x.OrganizationUid
 	}
 	return ""
 }
 
-func (x *Collection) GetName() string {
+type ListQuarantinedCollectionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PageSize      int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListQuarantinedCollectionsRequest) Reset() {
+	*x = ListQuarantinedCollectionsRequest{}
+	mi := &file_proto_service_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListQuarantinedCollectionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListQuarantinedCollectionsRequest) ProtoMessage() {}
+
+func (x *ListQuarantinedCollectionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[9]
 	if x != nil {
-		return x.Name
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListQuarantinedCollectionsRequest.ProtoReflect.Descriptor instead.
+func (*ListQuarantinedCollectionsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ListQuarantinedCollectionsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListQuarantinedCollectionsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
 	}
 	return ""
 }
 
-func (x *Collection) GetData() *structpb.Struct {
+type ListQuarantinedCollectionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Collections   []*Collection          `protobuf:"bytes,1,rep,name=collections,proto3" json:"collections,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListQuarantinedCollectionsResponse) Reset() {
+	*x = ListQuarantinedCollectionsResponse{}
+	mi := &file_proto_service_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListQuarantinedCollectionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListQuarantinedCollectionsResponse) ProtoMessage() {}
+
+func (x *ListQuarantinedCollectionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[10]
 	if x != nil {
-		return x.Data
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListQuarantinedCollectionsResponse.ProtoReflect.Descriptor instead.
+func (*ListQuarantinedCollectionsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ListQuarantinedCollectionsResponse) GetCollections() []*Collection {
+	if x != nil {
+		return x.Collections
 	}
 	return nil
 }
 
-func (x *Collection) GetAccessLevel() AccessLevel {
+func (x *ListQuarantinedCollectionsResponse) GetNextPageToken() string {
 	if x != nil {
-		return x.AccessLevel
+		return x.NextPageToken
 	}
-	return AccessLevel_ACCESS_LEVEL_UNSPECIFIED
+	return ""
 }
 
-func (x *Collection) GetOwnerId() string {
+// Gives a quarantined collection to a user as a private collection.
+type ReassignCollectionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CollectionUid string                 `protobuf:"bytes,1,opt,name=collection_uid,json=collectionUid,proto3" json:"collection_uid,omitempty"`
+	UserUid       string                 `protobuf:"bytes,2,opt,name=user_uid,json=userUid,proto3" json:"user_uid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReassignCollectionRequest) Reset() {
+	*x = ReassignCollectionRequest{}
+	mi := &file_proto_service_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReassignCollectionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReassignCollectionRequest) ProtoMessage() {}
+
+func (x *ReassignCollectionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[11]
 	if x != nil {
-		return x.OwnerId
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReassignCollectionRequest.ProtoReflect.Descriptor instead.
+func (*ReassignCollectionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ReassignCollectionRequest) GetCollectionUid() string {
+	if x != nil {
+		return x.CollectionUid
 	}
 	return ""
 }
 
-func (x *Collection) GetOrganizationId() string {
+func (x *ReassignCollectionRequest) GetUserUid() string {
 	if x != nil {
-		return x.OrganizationId
+		return x.UserUid
 	}
 	return ""
 }
 
-func (x *Collection) GetCreatedAt() *timestamppb.Timestamp {
+type LoginRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LoginRequest) Reset() {
+	*x = LoginRequest{}
+	mi := &file_proto_service_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LoginRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoginRequest) ProtoMessage() {}
+
+func (x *LoginRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[12]
 	if x != nil {
-		return x.CreatedAt
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *Collection) GetUpdatedAt() *timestamppb.Timestamp {
+// Deprecated: Use LoginRequest.ProtoReflect.Descriptor instead.
+func (*LoginRequest) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *LoginRequest) GetEmail() string {
 	if x != nil {
-		return x.UpdatedAt
+		return x.Email
 	}
-	return nil
+	return ""
 }
 
-type CreateCollectionRequest struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	Name            string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Data            *structpb.Struct       `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
-	AccessLevel     AccessLevel            `protobuf:"varint,3,opt,name=access_level,json=accessLevel,proto3,enum=censys.v1.AccessLevel" json:"access_level,omitempty"`
-	OrganizationUid string                 `protobuf:"bytes,4,opt,name=organization_uid,json=organizationUid,proto3" json:"organization_uid,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+type LoginResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateCollectionRequest) Reset() {
-	*x = CreateCollectionRequest{}
-	mi := &file_proto_service_proto_msgTypes[9]
+func (x *LoginResponse) Reset() {
+	*x = LoginResponse{}
+	mi := &file_proto_service_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateCollectionRequest) String() string {
+func (x *LoginResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateCollectionRequest) ProtoMessage() {}
+func (*LoginResponse) ProtoMessage() {}
 
-func (x *CreateCollectionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_service_proto_msgTypes[9]
+func (x *LoginResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -595,61 +1134,3607 @@ func (x *CreateCollectionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateCollectionRequest.ProtoReflect.Descriptor instead.
-func (*CreateCollectionRequest) Descriptor() ([]byte, []int) {
-	return file_proto_service_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use LoginResponse.ProtoReflect.Descriptor instead.
+func (*LoginResponse) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *CreateCollectionRequest) GetName() string {
+func (x *LoginResponse) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type Collection struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Uid            string                 `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	Name           string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Data           *structpb.Struct       `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	AccessLevel    AccessLevel            `protobuf:"varint,4,opt,name=access_level,json=accessLevel,proto3,enum=censys.v1.AccessLevel" json:"access_level,omitempty"`
+	OwnerId        string                 `protobuf:"bytes,5,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	OrganizationId string                 `protobuf:"bytes,6,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt      *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// revision is bumped on every write, etag is derived from it and can be sent back on writes
+	Revision int64  `protobuf:"varint,9,opt,name=revision,proto3" json:"revision,omitempty"`
+	Etag     string `protobuf:"bytes,10,opt,name=etag,proto3" json:"etag,omitempty"`
+	// how many previous versions are kept, zero means the server default
+	VersionRetention int32 `protobuf:"varint,11,opt,name=version_retention,json=versionRetention,proto3" json:"version_retention,omitempty"`
+	// only set for collections in the trash
+	DeletedAt *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+	Labels    map[string]string      `protobuf:"bytes,13,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// set on copies made with CopyCollection
+	SourceCollectionUid string `protobuf:"bytes,14,opt,name=source_collection_uid,json=sourceCollectionUid,proto3" json:"source_collection_uid,omitempty"`
+	// set when the collection lost its owner and organization and waits for an admin to reassign it
+	QuarantinedAt *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=quarantined_at,json=quarantinedAt,proto3" json:"quarantined_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Collection) Reset() {
+	*x = Collection{}
+	mi := &file_proto_service_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Collection) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Collection) ProtoMessage() {}
+
+func (x *Collection) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Collection.ProtoReflect.Descriptor instead.
+func (*Collection) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *Collection) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+func (x *Collection) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *CreateCollectionRequest) GetData() *structpb.Struct {
+func (x *Collection) GetData() *structpb.Struct {
 	if x != nil {
 		return x.Data
 	}
 	return nil
 }
 
-func (x *CreateCollectionRequest) GetAccessLevel() AccessLevel {
+func (x *Collection) GetAccessLevel() AccessLevel {
 	if x != nil {
 		return x.AccessLevel
 	}
 	return AccessLevel_ACCESS_LEVEL_UNSPECIFIED
 }
 
-func (x *CreateCollectionRequest) GetOrganizationUid() string {
+func (x *Collection) GetOwnerId() string {
 	if x != nil {
-		return x.OrganizationUid
+		return x.OwnerId
 	}
 	return ""
 }
 
-type GetCollectionRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Uid           string                 `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *GetCollectionRequest) Reset() {
-	*x = GetCollectionRequest{}
-	mi := &file_proto_service_proto_msgTypes[10]
+func (x *Collection) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *Collection) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Collection) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Collection) GetRevision() int64 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+func (x *Collection) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
+func (x *Collection) GetVersionRetention() int32 {
+	if x != nil {
+		return x.VersionRetention
+	}
+	return 0
+}
+
+func (x *Collection) GetDeletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return nil
+}
+
+func (x *Collection) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *Collection) GetSourceCollectionUid() string {
+	if x != nil {
+		return x.SourceCollectionUid
+	}
+	return ""
+}
+
+func (x *Collection) GetQuarantinedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.QuarantinedAt
+	}
+	return nil
+}
+
+type CreateCollectionRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Name            string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Data            *structpb.Struct       `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	AccessLevel     AccessLevel            `protobuf:"varint,3,opt,name=access_level,json=accessLevel,proto3,enum=censys.v1.AccessLevel" json:"access_level,omitempty"`
+	OrganizationUid string                 `protobuf:"bytes,4,opt,name=organization_uid,json=organizationUid,proto3" json:"organization_uid,omitempty"`
+	Labels          map[string]string      `protobuf:"bytes,5,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CreateCollectionRequest) Reset() {
+	*x = CreateCollectionRequest{}
+	mi := &file_proto_service_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCollectionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCollectionRequest) ProtoMessage() {}
+
+func (x *CreateCollectionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCollectionRequest.ProtoReflect.Descriptor instead.
+func (*CreateCollectionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *CreateCollectionRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateCollectionRequest) GetData() *structpb.Struct {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *CreateCollectionRequest) GetAccessLevel() AccessLevel {
+	if x != nil {
+		return x.AccessLevel
+	}
+	return AccessLevel_ACCESS_LEVEL_UNSPECIFIED
+}
+
+func (x *CreateCollectionRequest) GetOrganizationUid() string {
+	if x != nil {
+		return x.OrganizationUid
+	}
+	return ""
+}
+
+func (x *CreateCollectionRequest) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+type GetCollectionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uid           string                 `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCollectionRequest) Reset() {
+	*x = GetCollectionRequest{}
+	mi := &file_proto_service_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCollectionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCollectionRequest) ProtoMessage() {}
+
+func (x *GetCollectionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCollectionRequest.ProtoReflect.Descriptor instead.
+func (*GetCollectionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GetCollectionRequest) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+// Without an update_mask empty fields are left unchanged. With one, exactly the listed fields
+// (name, data, access_level, organization_uid, version_retention, or "*" for all of them) are written, including
+// zero values, so data can be cleared and organization_uid removed.
+type UpdateCollectionRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Uid             string                 `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	Name            string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Data            *structpb.Struct       `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	AccessLevel     AccessLevel            `protobuf:"varint,4,opt,name=access_level,json=accessLevel,proto3,enum=censys.v1.AccessLevel" json:"access_level,omitempty"`
+	OrganizationUid string                 `protobuf:"bytes,5,opt,name=organization_uid,json=organizationUid,proto3" json:"organization_uid,omitempty"`
+	UpdateMask      *fieldmaskpb.FieldMask `protobuf:"bytes,6,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	// when set the update fails with ABORTED unless the collection still has this etag
+	Etag             string `protobuf:"bytes,7,opt,name=etag,proto3" json:"etag,omitempty"`
+	VersionRetention int32  `protobuf:"varint,8,opt,name=version_retention,json=versionRetention,proto3" json:"version_retention,omitempty"`
+	// replaces all labels, use the "labels" or "labels.<key>" update_mask paths to clear or remove them
+	Labels        map[string]string `protobuf:"bytes,9,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCollectionRequest) Reset() {
+	*x = UpdateCollectionRequest{}
+	mi := &file_proto_service_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCollectionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCollectionRequest) ProtoMessage() {}
+
+func (x *UpdateCollectionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCollectionRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCollectionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *UpdateCollectionRequest) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+func (x *UpdateCollectionRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateCollectionRequest) GetData() *structpb.Struct {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *UpdateCollectionRequest) GetAccessLevel() AccessLevel {
+	if x != nil {
+		return x.AccessLevel
+	}
+	return AccessLevel_ACCESS_LEVEL_UNSPECIFIED
+}
+
+func (x *UpdateCollectionRequest) GetOrganizationUid() string {
+	if x != nil {
+		return x.OrganizationUid
+	}
+	return ""
+}
+
+func (x *UpdateCollectionRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+func (x *UpdateCollectionRequest) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
+func (x *UpdateCollectionRequest) GetVersionRetention() int32 {
+	if x != nil {
+		return x.VersionRetention
+	}
+	return 0
+}
+
+func (x *UpdateCollectionRequest) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+type PatchCollectionDataRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Uid       string                 `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	PatchType PatchType              `protobuf:"varint,2,opt,name=patch_type,json=patchType,proto3,enum=censys.v1.PatchType" json:"patch_type,omitempty"`
+	// the patch document as JSON text, paths are relative to the collection data
+	Patch         string `protobuf:"bytes,3,opt,name=patch,proto3" json:"patch,omitempty"`
+	Etag          string `protobuf:"bytes,4,opt,name=etag,proto3" json:"etag,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PatchCollectionDataRequest) Reset() {
+	*x = PatchCollectionDataRequest{}
+	mi := &file_proto_service_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PatchCollectionDataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PatchCollectionDataRequest) ProtoMessage() {}
+
+func (x *PatchCollectionDataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PatchCollectionDataRequest.ProtoReflect.Descriptor instead.
+func (*PatchCollectionDataRequest) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *PatchCollectionDataRequest) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+func (x *PatchCollectionDataRequest) GetPatchType() PatchType {
+	if x != nil {
+		return x.PatchType
+	}
+	return PatchType_PATCH_TYPE_UNSPECIFIED
+}
+
+func (x *PatchCollectionDataRequest) GetPatch() string {
+	if x != nil {
+		return x.Patch
+	}
+	return ""
+}
+
+func (x *PatchCollectionDataRequest) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
+type DeleteCollectionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uid           string                 `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	Etag          string                 `protobuf:"bytes,2,opt,name=etag,proto3" json:"etag,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCollectionRequest) Reset() {
+	*x = DeleteCollectionRequest{}
+	mi := &file_proto_service_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCollectionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCollectionRequest) ProtoMessage() {}
+
+func (x *DeleteCollectionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCollectionRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCollectionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *DeleteCollectionRequest) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+func (x *DeleteCollectionRequest) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
+// Copies name, data and labels into a new collection owned by the caller. The source is either a
+// collection the caller can access (uid) or one shared with them (share_token), exactly one is required.
+type CopyCollectionRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Uid        string                 `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	ShareToken string                 `protobuf:"bytes,2,opt,name=share_token,json=shareToken,proto3" json:"share_token,omitempty"`
+	// defaults to the source name
+	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// when set the copy is an organization collection there, otherwise it is private
+	OrganizationUid string `protobuf:"bytes,4,opt,name=organization_uid,json=organizationUid,proto3" json:"organization_uid,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CopyCollectionRequest) Reset() {
+	*x = CopyCollectionRequest{}
+	mi := &file_proto_service_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CopyCollectionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CopyCollectionRequest) ProtoMessage() {}
+
+func (x *CopyCollectionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CopyCollectionRequest.ProtoReflect.Descriptor instead.
+func (*CopyCollectionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *CopyCollectionRequest) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+func (x *CopyCollectionRequest) GetShareToken() string {
+	if x != nil {
+		return x.ShareToken
+	}
+	return ""
+}
+
+func (x *CopyCollectionRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CopyCollectionRequest) GetOrganizationUid() string {
+	if x != nil {
+		return x.OrganizationUid
+	}
+	return ""
+}
+
+// A large payload stored in chunks next to a collection's inline data, for content that does not fit in a
+// single message. Payloads are not kept in the version history.
+type CollectionPayload struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CollectionUid string                 `protobuf:"bytes,1,opt,name=collection_uid,json=collectionUid,proto3" json:"collection_uid,omitempty"`
+	ContentType   string                 `protobuf:"bytes,2,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	Size          int64                  `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	Sha256        string                 `protobuf:"bytes,4,opt,name=sha256,proto3" json:"sha256,omitempty"` // hex digest of the whole payload
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CollectionPayload) Reset() {
+	*x = CollectionPayload{}
+	mi := &file_proto_service_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CollectionPayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CollectionPayload) ProtoMessage() {}
+
+func (x *CollectionPayload) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CollectionPayload.ProtoReflect.Descriptor instead.
+func (*CollectionPayload) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *CollectionPayload) GetCollectionUid() string {
+	if x != nil {
+		return x.CollectionUid
+	}
+	return ""
+}
+
+func (x *CollectionPayload) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *CollectionPayload) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *CollectionPayload) GetSha256() string {
+	if x != nil {
+		return x.Sha256
+	}
+	return ""
+}
+
+func (x *CollectionPayload) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type UploadCollectionDataHeader struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CollectionUid string                 `protobuf:"bytes,1,opt,name=collection_uid,json=collectionUid,proto3" json:"collection_uid,omitempty"`
+	// defaults to application/octet-stream
+	ContentType   string `protobuf:"bytes,2,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	Etag          string `protobuf:"bytes,3,opt,name=etag,proto3" json:"etag,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadCollectionDataHeader) Reset() {
+	*x = UploadCollectionDataHeader{}
+	mi := &file_proto_service_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadCollectionDataHeader) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadCollectionDataHeader) ProtoMessage() {}
+
+func (x *UploadCollectionDataHeader) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadCollectionDataHeader.ProtoReflect.Descriptor instead.
+func (*UploadCollectionDataHeader) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *UploadCollectionDataHeader) GetCollectionUid() string {
+	if x != nil {
+		return x.CollectionUid
+	}
+	return ""
+}
+
+func (x *UploadCollectionDataHeader) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *UploadCollectionDataHeader) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
+// The first message carries the header and every later one a chunk of the payload. The upload replaces
+// any existing payload once the stream completes, an upload without chunks removes it.
+type UploadCollectionDataRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Part:
+	//
+	//	*UploadCollectionDataRequest_Header
+	//	*UploadCollectionDataRequest_Chunk
+	Part          isUploadCollectionDataRequest_Part `protobuf_oneof:"part"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadCollectionDataRequest) Reset() {
+	*x = UploadCollectionDataRequest{}
+	mi := &file_proto_service_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadCollectionDataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadCollectionDataRequest) ProtoMessage() {}
+
+func (x *UploadCollectionDataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadCollectionDataRequest.ProtoReflect.Descriptor instead.
+func (*UploadCollectionDataRequest) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *UploadCollectionDataRequest) GetPart() isUploadCollectionDataRequest_Part {
+	if x != nil {
+		return x.Part
+	}
+	return nil
+}
+
+func (x *UploadCollectionDataRequest) GetHeader() *UploadCollectionDataHeader {
+	if x != nil {
+		if x, ok := x.Part.(*UploadCollectionDataRequest_Header); ok {
+			return x.Header
+		}
+	}
+	return nil
+}
+
+func (x *UploadCollectionDataRequest) GetChunk() []byte {
+	if x != nil {
+		if x, ok := x.Part.(*UploadCollectionDataRequest_Chunk); ok {
+			return x.Chunk
+		}
+	}
+	return nil
+}
+
+type isUploadCollectionDataRequest_Part interface {
+	isUploadCollectionDataRequest_Part()
+}
+
+type UploadCollectionDataRequest_Header struct {
+	Header *UploadCollectionDataHeader `protobuf:"bytes,1,opt,name=header,proto3,oneof"`
+}
+
+type UploadCollectionDataRequest_Chunk struct {
+	Chunk []byte `protobuf:"bytes,2,opt,name=chunk,proto3,oneof"`
+}
+
+func (*UploadCollectionDataRequest_Header) isUploadCollectionDataRequest_Part() {}
+
+func (*UploadCollectionDataRequest_Chunk) isUploadCollectionDataRequest_Part() {}
+
+// Reads length bytes starting at offset, a length of zero reads to the end.
+type DownloadCollectionDataRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CollectionUid string                 `protobuf:"bytes,1,opt,name=collection_uid,json=collectionUid,proto3" json:"collection_uid,omitempty"`
+	Offset        int64                  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	Length        int64                  `protobuf:"varint,3,opt,name=length,proto3" json:"length,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DownloadCollectionDataRequest) Reset() {
+	*x = DownloadCollectionDataRequest{}
+	mi := &file_proto_service_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DownloadCollectionDataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadCollectionDataRequest) ProtoMessage() {}
+
+func (x *DownloadCollectionDataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadCollectionDataRequest.ProtoReflect.Descriptor instead.
+func (*DownloadCollectionDataRequest) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *DownloadCollectionDataRequest) GetCollectionUid() string {
+	if x != nil {
+		return x.CollectionUid
+	}
+	return ""
+}
+
+func (x *DownloadCollectionDataRequest) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *DownloadCollectionDataRequest) GetLength() int64 {
+	if x != nil {
+		return x.Length
+	}
+	return 0
+}
+
+// The payload description is only set on the first message.
+type DownloadCollectionDataResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Payload       *CollectionPayload     `protobuf:"bytes,1,opt,name=payload,proto3" json:"payload,omitempty"`
+	Offset        int64                  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	Data          []byte                 `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DownloadCollectionDataResponse) Reset() {
+	*x = DownloadCollectionDataResponse{}
+	mi := &file_proto_service_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DownloadCollectionDataResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadCollectionDataResponse) ProtoMessage() {}
+
+func (x *DownloadCollectionDataResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadCollectionDataResponse.ProtoReflect.Descriptor instead.
+func (*DownloadCollectionDataResponse) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *DownloadCollectionDataResponse) GetPayload() *CollectionPayload {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *DownloadCollectionDataResponse) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *DownloadCollectionDataResponse) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type CollectionEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          CollectionEventType    `protobuf:"varint,1,opt,name=type,proto3,enum=censys.v1.CollectionEventType" json:"type,omitempty"`
+	CollectionUid string                 `protobuf:"bytes,2,opt,name=collection_uid,json=collectionUid,proto3" json:"collection_uid,omitempty"`
+	// The collection after the change. Unset for deletions and when the watcher can no longer see it.
+	Collection    *Collection `protobuf:"bytes,3,opt,name=collection,proto3" json:"collection,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CollectionEvent) Reset() {
+	*x = CollectionEvent{}
+	mi := &file_proto_service_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CollectionEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CollectionEvent) ProtoMessage() {}
+
+func (x *CollectionEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CollectionEvent.ProtoReflect.Descriptor instead.
+func (*CollectionEvent) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *CollectionEvent) GetType() CollectionEventType {
+	if x != nil {
+		return x.Type
+	}
+	return CollectionEventType_COLLECTION_EVENT_TYPE_UNSPECIFIED
+}
+
+func (x *CollectionEvent) GetCollectionUid() string {
+	if x != nil {
+		return x.CollectionUid
+	}
+	return ""
+}
+
+func (x *CollectionEvent) GetCollection() *Collection {
+	if x != nil {
+		return x.Collection
+	}
+	return nil
+}
+
+// Streams changes to one collection. The stream ends after the collection is deleted and fails with
+// PERMISSION_DENIED once the caller loses access.
+type WatchCollectionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uid           string                 `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchCollectionRequest) Reset() {
+	*x = WatchCollectionRequest{}
+	mi := &file_proto_service_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchCollectionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchCollectionRequest) ProtoMessage() {}
+
+func (x *WatchCollectionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchCollectionRequest.ProtoReflect.Descriptor instead.
+func (*WatchCollectionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *WatchCollectionRequest) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+// Streams changes to the organization's shared collections. The stream fails with PERMISSION_DENIED
+// once the caller leaves the organization.
+type WatchOrganizationCollectionsRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationUid string                 `protobuf:"bytes,1,opt,name=organization_uid,json=organizationUid,proto3" json:"organization_uid,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *WatchOrganizationCollectionsRequest) Reset() {
+	*x = WatchOrganizationCollectionsRequest{}
+	mi := &file_proto_service_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchOrganizationCollectionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchOrganizationCollectionsRequest) ProtoMessage() {}
+
+func (x *WatchOrganizationCollectionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchOrganizationCollectionsRequest.ProtoReflect.Descriptor instead.
+func (*WatchOrganizationCollectionsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *WatchOrganizationCollectionsRequest) GetOrganizationUid() string {
+	if x != nil {
+		return x.OrganizationUid
+	}
+	return ""
+}
+
+// Webhooks receive a JSON POST for every matching event in their organization. Requests are signed with
+// HMAC-SHA256 over "<X-Webhook-Timestamp>.<body>" using the subscription secret, sent in X-Webhook-Signature
+// as "sha256=<hex>". Failed deliveries are retried with backoff and then kept as dead letters.
+type WebhookSubscription struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Uid             string                 `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	OrganizationUid string                 `protobuf:"bytes,2,opt,name=organization_uid,json=organizationUid,proto3" json:"organization_uid,omitempty"`
+	Url             string                 `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`
+	// empty means every event type
+	EventTypes []string `protobuf:"bytes,4,rep,name=event_types,json=eventTypes,proto3" json:"event_types,omitempty"`
+	// only returned when the subscription is created
+	Secret        string                 `protobuf:"bytes,5,opt,name=secret,proto3" json:"secret,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WebhookSubscription) Reset() {
+	*x = WebhookSubscription{}
+	mi := &file_proto_service_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WebhookSubscription) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhookSubscription) ProtoMessage() {}
+
+func (x *WebhookSubscription) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhookSubscription.ProtoReflect.Descriptor instead.
+func (*WebhookSubscription) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *WebhookSubscription) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+func (x *WebhookSubscription) GetOrganizationUid() string {
+	if x != nil {
+		return x.OrganizationUid
+	}
+	return ""
+}
+
+func (x *WebhookSubscription) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *WebhookSubscription) GetEventTypes() []string {
+	if x != nil {
+		return x.EventTypes
+	}
+	return nil
+}
+
+func (x *WebhookSubscription) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+func (x *WebhookSubscription) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// Managing webhooks needs the organization admin role.
+type CreateWebhookSubscriptionRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationUid string                 `protobuf:"bytes,1,opt,name=organization_uid,json=organizationUid,proto3" json:"organization_uid,omitempty"`
+	Url             string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	// collection.created, collection.updated, collection.deleted, collection.access_changed,
+	// share_link.created or share_link.revoked
+	EventTypes    []string `protobuf:"bytes,3,rep,name=event_types,json=eventTypes,proto3" json:"event_types,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateWebhookSubscriptionRequest) Reset() {
+	*x = CreateWebhookSubscriptionRequest{}
+	mi := &file_proto_service_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateWebhookSubscriptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateWebhookSubscriptionRequest) ProtoMessage() {}
+
+func (x *CreateWebhookSubscriptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateWebhookSubscriptionRequest.ProtoReflect.Descriptor instead.
+func (*CreateWebhookSubscriptionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *CreateWebhookSubscriptionRequest) GetOrganizationUid() string {
+	if x != nil {
+		return x.OrganizationUid
+	}
+	return ""
+}
+
+func (x *CreateWebhookSubscriptionRequest) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *CreateWebhookSubscriptionRequest) GetEventTypes() []string {
+	if x != nil {
+		return x.EventTypes
+	}
+	return nil
+}
+
+type ListWebhookSubscriptionsRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationUid string                 `protobuf:"bytes,1,opt,name=organization_uid,json=organizationUid,proto3" json:"organization_uid,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ListWebhookSubscriptionsRequest) Reset() {
+	*x = ListWebhookSubscriptionsRequest{}
+	mi := &file_proto_service_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWebhookSubscriptionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhookSubscriptionsRequest) ProtoMessage() {}
+
+func (x *ListWebhookSubscriptionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhookSubscriptionsRequest.ProtoReflect.Descriptor instead.
+func (*ListWebhookSubscriptionsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ListWebhookSubscriptionsRequest) GetOrganizationUid() string {
+	if x != nil {
+		return x.OrganizationUid
+	}
+	return ""
+}
+
+type ListWebhookSubscriptionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Subscriptions []*WebhookSubscription `protobuf:"bytes,1,rep,name=subscriptions,proto3" json:"subscriptions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWebhookSubscriptionsResponse) Reset() {
+	*x = ListWebhookSubscriptionsResponse{}
+	mi := &file_proto_service_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWebhookSubscriptionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhookSubscriptionsResponse) ProtoMessage() {}
+
+func (x *ListWebhookSubscriptionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhookSubscriptionsResponse.ProtoReflect.Descriptor instead.
+func (*ListWebhookSubscriptionsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ListWebhookSubscriptionsResponse) GetSubscriptions() []*WebhookSubscription {
+	if x != nil {
+		return x.Subscriptions
+	}
+	return nil
+}
+
+type DeleteWebhookSubscriptionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uid           string                 `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteWebhookSubscriptionRequest) Reset() {
+	*x = DeleteWebhookSubscriptionRequest{}
+	mi := &file_proto_service_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteWebhookSubscriptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteWebhookSubscriptionRequest) ProtoMessage() {}
+
+func (x *DeleteWebhookSubscriptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteWebhookSubscriptionRequest.ProtoReflect.Descriptor instead.
+func (*DeleteWebhookSubscriptionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *DeleteWebhookSubscriptionRequest) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+type WebhookDelivery struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	EventUid      string                 `protobuf:"bytes,2,opt,name=event_uid,json=eventUid,proto3" json:"event_uid,omitempty"`
+	EventType     string                 `protobuf:"bytes,3,opt,name=event_type,json=eventType,proto3" json:"event_type,omitempty"`
+	Status        WebhookDeliveryStatus  `protobuf:"varint,4,opt,name=status,proto3,enum=censys.v1.WebhookDeliveryStatus" json:"status,omitempty"`
+	Attempts      int32                  `protobuf:"varint,5,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	LastError     string                 `protobuf:"bytes,6,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	NextAttemptAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=next_attempt_at,json=nextAttemptAt,proto3" json:"next_attempt_at,omitempty"`
+	DeliveredAt   *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=delivered_at,json=deliveredAt,proto3" json:"delivered_at,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WebhookDelivery) Reset() {
+	*x = WebhookDelivery{}
+	mi := &file_proto_service_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WebhookDelivery) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhookDelivery) ProtoMessage() {}
+
+func (x *WebhookDelivery) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhookDelivery.ProtoReflect.Descriptor instead.
+func (*WebhookDelivery) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *WebhookDelivery) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *WebhookDelivery) GetEventUid() string {
+	if x != nil {
+		return x.EventUid
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetEventType() string {
+	if x != nil {
+		return x.EventType
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetStatus() WebhookDeliveryStatus {
+	if x != nil {
+		return x.Status
+	}
+	return WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_UNSPECIFIED
+}
+
+func (x *WebhookDelivery) GetAttempts() int32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *WebhookDelivery) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetNextAttemptAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextAttemptAt
+	}
+	return nil
+}
+
+func (x *WebhookDelivery) GetDeliveredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeliveredAt
+	}
+	return nil
+}
+
+func (x *WebhookDelivery) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type ListWebhookDeliveriesRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	SubscriptionUid string                 `protobuf:"bytes,1,opt,name=subscription_uid,json=subscriptionUid,proto3" json:"subscription_uid,omitempty"`
+	// unspecified lists every status
+	Status        WebhookDeliveryStatus `protobuf:"varint,2,opt,name=status,proto3,enum=censys.v1.WebhookDeliveryStatus" json:"status,omitempty"`
+	PageSize      int32                 `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string                `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWebhookDeliveriesRequest) Reset() {
+	*x = ListWebhookDeliveriesRequest{}
+	mi := &file_proto_service_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWebhookDeliveriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhookDeliveriesRequest) ProtoMessage() {}
+
+func (x *ListWebhookDeliveriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhookDeliveriesRequest.ProtoReflect.Descriptor instead.
+func (*ListWebhookDeliveriesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ListWebhookDeliveriesRequest) GetSubscriptionUid() string {
+	if x != nil {
+		return x.SubscriptionUid
+	}
+	return ""
+}
+
+func (x *ListWebhookDeliveriesRequest) GetStatus() WebhookDeliveryStatus {
+	if x != nil {
+		return x.Status
+	}
+	return WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_UNSPECIFIED
+}
+
+func (x *ListWebhookDeliveriesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListWebhookDeliveriesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListWebhookDeliveriesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Deliveries    []*WebhookDelivery     `protobuf:"bytes,1,rep,name=deliveries,proto3" json:"deliveries,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWebhookDeliveriesResponse) Reset() {
+	*x = ListWebhookDeliveriesResponse{}
+	mi := &file_proto_service_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWebhookDeliveriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhookDeliveriesResponse) ProtoMessage() {}
+
+func (x *ListWebhookDeliveriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhookDeliveriesResponse.ProtoReflect.Descriptor instead.
+func (*ListWebhookDeliveriesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ListWebhookDeliveriesResponse) GetDeliveries() []*WebhookDelivery {
+	if x != nil {
+		return x.Deliveries
+	}
+	return nil
+}
+
+func (x *ListWebhookDeliveriesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// Moves a dead delivery back to pending with a fresh set of attempts.
+type RetryWebhookDeliveryRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	SubscriptionUid string                 `protobuf:"bytes,1,opt,name=subscription_uid,json=subscriptionUid,proto3" json:"subscription_uid,omitempty"`
+	DeliveryId      int32                  `protobuf:"varint,2,opt,name=delivery_id,json=deliveryId,proto3" json:"delivery_id,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *RetryWebhookDeliveryRequest) Reset() {
+	*x = RetryWebhookDeliveryRequest{}
+	mi := &file_proto_service_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RetryWebhookDeliveryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetryWebhookDeliveryRequest) ProtoMessage() {}
+
+func (x *RetryWebhookDeliveryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetryWebhookDeliveryRequest.ProtoReflect.Descriptor instead.
+func (*RetryWebhookDeliveryRequest) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *RetryWebhookDeliveryRequest) GetSubscriptionUid() string {
+	if x != nil {
+		return x.SubscriptionUid
+	}
+	return ""
+}
+
+func (x *RetryWebhookDeliveryRequest) GetDeliveryId() int32 {
+	if x != nil {
+		return x.DeliveryId
+	}
+	return 0
+}
+
+// Each message carries either one record or a chunk of NDJSON text. NDJSON lines are
+// CreateCollectionRequest objects in their JSON form and may be split across messages.
+// The mode is read from the first message.
+type ImportCollectionsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Mode  ImportMode             `protobuf:"varint,1,opt,name=mode,proto3,enum=censys.v1.ImportMode" json:"mode,omitempty"`
+	// Types that are valid to be assigned to Record:
+	//
+	//	*ImportCollectionsRequest_Collection
+	//	*ImportCollectionsRequest_Ndjson
+	Record        isImportCollectionsRequest_Record `protobuf_oneof:"record"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportCollectionsRequest) Reset() {
+	*x = ImportCollectionsRequest{}
+	mi := &file_proto_service_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportCollectionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportCollectionsRequest) ProtoMessage() {}
+
+func (x *ImportCollectionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportCollectionsRequest.ProtoReflect.Descriptor instead.
+func (*ImportCollectionsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ImportCollectionsRequest) GetMode() ImportMode {
+	if x != nil {
+		return x.Mode
+	}
+	return ImportMode_IMPORT_MODE_UNSPECIFIED
+}
+
+func (x *ImportCollectionsRequest) GetRecord() isImportCollectionsRequest_Record {
+	if x != nil {
+		return x.Record
+	}
+	return nil
+}
+
+func (x *ImportCollectionsRequest) GetCollection() *CreateCollectionRequest {
+	if x != nil {
+		if x, ok := x.Record.(*ImportCollectionsRequest_Collection); ok {
+			return x.Collection
+		}
+	}
+	return nil
+}
+
+func (x *ImportCollectionsRequest) GetNdjson() []byte {
+	if x != nil {
+		if x, ok := x.Record.(*ImportCollectionsRequest_Ndjson); ok {
+			return x.Ndjson
+		}
+	}
+	return nil
+}
+
+type isImportCollectionsRequest_Record interface {
+	isImportCollectionsRequest_Record()
+}
+
+type ImportCollectionsRequest_Collection struct {
+	Collection *CreateCollectionRequest `protobuf:"bytes,2,opt,name=collection,proto3,oneof"`
+}
+
+type ImportCollectionsRequest_Ndjson struct {
+	Ndjson []byte `protobuf:"bytes,3,opt,name=ndjson,proto3,oneof"`
+}
+
+func (*ImportCollectionsRequest_Collection) isImportCollectionsRequest_Record() {}
+
+func (*ImportCollectionsRequest_Ndjson) isImportCollectionsRequest_Record() {}
+
+type ImportError struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// position of the record in the import, counting from zero
+	Index         int32  `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	Code          int32  `protobuf:"varint,2,opt,name=code,proto3" json:"code,omitempty"` // google.rpc.Code
+	Message       string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportError) Reset() {
+	*x = ImportError{}
+	mi := &file_proto_service_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportError) ProtoMessage() {}
+
+func (x *ImportError) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportError.ProtoReflect.Descriptor instead.
+func (*ImportError) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *ImportError) GetIndex() int32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *ImportError) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *ImportError) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// imported and failed count every record, errors and collection_uids only list the first 1000 of each.
+type ImportCollectionsResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Imported       int32                  `protobuf:"varint,1,opt,name=imported,proto3" json:"imported,omitempty"`
+	Failed         int32                  `protobuf:"varint,2,opt,name=failed,proto3" json:"failed,omitempty"`
+	Errors         []*ImportError         `protobuf:"bytes,3,rep,name=errors,proto3" json:"errors,omitempty"`
+	CollectionUids []string               `protobuf:"bytes,4,rep,name=collection_uids,json=collectionUids,proto3" json:"collection_uids,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ImportCollectionsResponse) Reset() {
+	*x = ImportCollectionsResponse{}
+	mi := &file_proto_service_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportCollectionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportCollectionsResponse) ProtoMessage() {}
+
+func (x *ImportCollectionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportCollectionsResponse.ProtoReflect.Descriptor instead.
+func (*ImportCollectionsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ImportCollectionsResponse) GetImported() int32 {
+	if x != nil {
+		return x.Imported
+	}
+	return 0
+}
+
+func (x *ImportCollectionsResponse) GetFailed() int32 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+func (x *ImportCollectionsResponse) GetErrors() []*ImportError {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+func (x *ImportCollectionsResponse) GetCollectionUids() []string {
+	if x != nil {
+		return x.CollectionUids
+	}
+	return nil
+}
+
+// Streams every collection the caller can access, newest first.
+type ExportCollectionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Format        ExportFormat           `protobuf:"varint,1,opt,name=format,proto3,enum=censys.v1.ExportFormat" json:"format,omitempty"`
+	LabelSelector string                 `protobuf:"bytes,2,opt,name=label_selector,json=labelSelector,proto3" json:"label_selector,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportCollectionsRequest) Reset() {
+	*x = ExportCollectionsRequest{}
+	mi := &file_proto_service_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportCollectionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportCollectionsRequest) ProtoMessage() {}
+
+func (x *ExportCollectionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportCollectionsRequest.ProtoReflect.Descriptor instead.
+func (*ExportCollectionsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ExportCollectionsRequest) GetFormat() ExportFormat {
+	if x != nil {
+		return x.Format
+	}
+	return ExportFormat_EXPORT_FORMAT_UNSPECIFIED
+}
+
+func (x *ExportCollectionsRequest) GetLabelSelector() string {
+	if x != nil {
+		return x.LabelSelector
+	}
+	return ""
+}
+
+type ExportCollectionsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Record:
+	//
+	//	*ExportCollectionsResponse_Collection
+	//	*ExportCollectionsResponse_Ndjson
+	Record        isExportCollectionsResponse_Record `protobuf_oneof:"record"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportCollectionsResponse) Reset() {
+	*x = ExportCollectionsResponse{}
+	mi := &file_proto_service_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportCollectionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportCollectionsResponse) ProtoMessage() {}
+
+func (x *ExportCollectionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportCollectionsResponse.ProtoReflect.Descriptor instead.
+func (*ExportCollectionsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ExportCollectionsResponse) GetRecord() isExportCollectionsResponse_Record {
+	if x != nil {
+		return x.Record
+	}
+	return nil
+}
+
+func (x *ExportCollectionsResponse) GetCollection() *Collection {
+	if x != nil {
+		if x, ok := x.Record.(*ExportCollectionsResponse_Collection); ok {
+			return x.Collection
+		}
+	}
+	return nil
+}
+
+func (x *ExportCollectionsResponse) GetNdjson() []byte {
+	if x != nil {
+		if x, ok := x.Record.(*ExportCollectionsResponse_Ndjson); ok {
+			return x.Ndjson
+		}
+	}
+	return nil
+}
+
+type isExportCollectionsResponse_Record interface {
+	isExportCollectionsResponse_Record()
+}
+
+type ExportCollectionsResponse_Collection struct {
+	Collection *Collection `protobuf:"bytes,1,opt,name=collection,proto3,oneof"`
+}
+
+type ExportCollectionsResponse_Ndjson struct {
+	Ndjson []byte `protobuf:"bytes,2,opt,name=ndjson,proto3,oneof"`
+}
+
+func (*ExportCollectionsResponse_Collection) isExportCollectionsResponse_Record() {}
+
+func (*ExportCollectionsResponse_Ndjson) isExportCollectionsResponse_Record() {}
+
+// Matches data where the value at path (dot separated, e.g. "type" or "query.limit") equals value.
+type DataFilter struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Path          string                 `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	Value         *structpb.Value        `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DataFilter) Reset() {
+	*x = DataFilter{}
+	mi := &file_proto_service_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DataFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DataFilter) ProtoMessage() {}
+
+func (x *DataFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DataFilter.ProtoReflect.Descriptor instead.
+func (*DataFilter) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *DataFilter) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *DataFilter) GetValue() *structpb.Value {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+// All set criteria must match. Only collections the caller can access are searched.
+type SearchCollectionsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// full text search over the name, supports "quoted phrases", OR and -exclusions
+	Query   string        `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	Filters []*DataFilter `protobuf:"bytes,2,rep,name=filters,proto3" json:"filters,omitempty"`
+	// a SQL/JSON path predicate evaluated against data, e.g. $.limit > 10
+	DataPath  string `protobuf:"bytes,3,opt,name=data_path,json=dataPath,proto3" json:"data_path,omitempty"`
+	PageSize  int32  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken string `protobuf:"bytes,5,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// comma separated label requirements: key=value, key!=value, key and !key
+	LabelSelector string `protobuf:"bytes,6,opt,name=label_selector,json=labelSelector,proto3" json:"label_selector,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchCollectionsRequest) Reset() {
+	*x = SearchCollectionsRequest{}
+	mi := &file_proto_service_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchCollectionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchCollectionsRequest) ProtoMessage() {}
+
+func (x *SearchCollectionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchCollectionsRequest.ProtoReflect.Descriptor instead.
+func (*SearchCollectionsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *SearchCollectionsRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchCollectionsRequest) GetFilters() []*DataFilter {
+	if x != nil {
+		return x.Filters
+	}
+	return nil
+}
+
+func (x *SearchCollectionsRequest) GetDataPath() string {
+	if x != nil {
+		return x.DataPath
+	}
+	return ""
+}
+
+func (x *SearchCollectionsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *SearchCollectionsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *SearchCollectionsRequest) GetLabelSelector() string {
+	if x != nil {
+		return x.LabelSelector
+	}
+	return ""
+}
+
+type SearchCollectionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Collections   []*Collection          `protobuf:"bytes,1,rep,name=collections,proto3" json:"collections,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchCollectionsResponse) Reset() {
+	*x = SearchCollectionsResponse{}
+	mi := &file_proto_service_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchCollectionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchCollectionsResponse) ProtoMessage() {}
+
+func (x *SearchCollectionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchCollectionsResponse.ProtoReflect.Descriptor instead.
+func (*SearchCollectionsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *SearchCollectionsResponse) GetCollections() []*Collection {
+	if x != nil {
+		return x.Collections
+	}
+	return nil
+}
+
+func (x *SearchCollectionsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// A JSON Schema that data must satisfy for organization collections whose data.type equals type.
+// Violations are returned as INVALID_ARGUMENT with google.rpc.BadRequest field violations.
+type CollectionSchema struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationUid string                 `protobuf:"bytes,1,opt,name=organization_uid,json=organizationUid,proto3" json:"organization_uid,omitempty"`
+	Type            string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Schema          *structpb.Struct       `protobuf:"bytes,3,opt,name=schema,proto3" json:"schema,omitempty"`
+	CreatedAt       *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt       *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CollectionSchema) Reset() {
+	*x = CollectionSchema{}
+	mi := &file_proto_service_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CollectionSchema) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CollectionSchema) ProtoMessage() {}
+
+func (x *CollectionSchema) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CollectionSchema.ProtoReflect.Descriptor instead.
+func (*CollectionSchema) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *CollectionSchema) GetOrganizationUid() string {
+	if x != nil {
+		return x.OrganizationUid
+	}
+	return ""
+}
+
+func (x *CollectionSchema) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *CollectionSchema) GetSchema() *structpb.Struct {
+	if x != nil {
+		return x.Schema
+	}
+	return nil
+}
+
+func (x *CollectionSchema) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *CollectionSchema) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// Registering a type that already has a schema replaces it. Existing collections are not re-validated.
+type RegisterCollectionSchemaRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationUid string                 `protobuf:"bytes,1,opt,name=organization_uid,json=organizationUid,proto3" json:"organization_uid,omitempty"`
+	Type            string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Schema          *structpb.Struct       `protobuf:"bytes,3,opt,name=schema,proto3" json:"schema,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *RegisterCollectionSchemaRequest) Reset() {
+	*x = RegisterCollectionSchemaRequest{}
+	mi := &file_proto_service_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegisterCollectionSchemaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterCollectionSchemaRequest) ProtoMessage() {}
+
+func (x *RegisterCollectionSchemaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterCollectionSchemaRequest.ProtoReflect.Descriptor instead.
+func (*RegisterCollectionSchemaRequest) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *RegisterCollectionSchemaRequest) GetOrganizationUid() string {
+	if x != nil {
+		return x.OrganizationUid
+	}
+	return ""
+}
+
+func (x *RegisterCollectionSchemaRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *RegisterCollectionSchemaRequest) GetSchema() *structpb.Struct {
+	if x != nil {
+		return x.Schema
+	}
+	return nil
+}
+
+type ListCollectionSchemasRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationUid string                 `protobuf:"bytes,1,opt,name=organization_uid,json=organizationUid,proto3" json:"organization_uid,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ListCollectionSchemasRequest) Reset() {
+	*x = ListCollectionSchemasRequest{}
+	mi := &file_proto_service_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCollectionSchemasRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCollectionSchemasRequest) ProtoMessage() {}
+
+func (x *ListCollectionSchemasRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCollectionSchemasRequest.ProtoReflect.Descriptor instead.
+func (*ListCollectionSchemasRequest) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *ListCollectionSchemasRequest) GetOrganizationUid() string {
+	if x != nil {
+		return x.OrganizationUid
+	}
+	return ""
+}
+
+type ListCollectionSchemasResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Schemas       []*CollectionSchema    `protobuf:"bytes,1,rep,name=schemas,proto3" json:"schemas,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCollectionSchemasResponse) Reset() {
+	*x = ListCollectionSchemasResponse{}
+	mi := &file_proto_service_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCollectionSchemasResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCollectionSchemasResponse) ProtoMessage() {}
+
+func (x *ListCollectionSchemasResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCollectionSchemasResponse.ProtoReflect.Descriptor instead.
+func (*ListCollectionSchemasResponse) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *ListCollectionSchemasResponse) GetSchemas() []*CollectionSchema {
+	if x != nil {
+		return x.Schemas
+	}
+	return nil
+}
+
+type DeleteCollectionSchemaRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationUid string                 `protobuf:"bytes,1,opt,name=organization_uid,json=organizationUid,proto3" json:"organization_uid,omitempty"`
+	Type            string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *DeleteCollectionSchemaRequest) Reset() {
+	*x = DeleteCollectionSchemaRequest{}
+	mi := &file_proto_service_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCollectionSchemaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCollectionSchemaRequest) ProtoMessage() {}
+
+func (x *DeleteCollectionSchemaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCollectionSchemaRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCollectionSchemaRequest) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *DeleteCollectionSchemaRequest) GetOrganizationUid() string {
+	if x != nil {
+		return x.OrganizationUid
+	}
+	return ""
+}
+
+func (x *DeleteCollectionSchemaRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+// Deleted collections stay in the trash, hidden along with their share links, until they are purged.
+type ListTrashRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PageSize      int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	LabelSelector string                 `protobuf:"bytes,3,opt,name=label_selector,json=labelSelector,proto3" json:"label_selector,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTrashRequest) Reset() {
+	*x = ListTrashRequest{}
+	mi := &file_proto_service_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTrashRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTrashRequest) ProtoMessage() {}
+
+func (x *ListTrashRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTrashRequest.ProtoReflect.Descriptor instead.
+func (*ListTrashRequest) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *ListTrashRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListTrashRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListTrashRequest) GetLabelSelector() string {
+	if x != nil {
+		return x.LabelSelector
+	}
+	return ""
+}
+
+type ListTrashResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Collections   []*Collection          `protobuf:"bytes,1,rep,name=collections,proto3" json:"collections,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTrashResponse) Reset() {
+	*x = ListTrashResponse{}
+	mi := &file_proto_service_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTrashResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTrashResponse) ProtoMessage() {}
+
+func (x *ListTrashResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTrashResponse.ProtoReflect.Descriptor instead.
+func (*ListTrashResponse) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *ListTrashResponse) GetCollections() []*Collection {
+	if x != nil {
+		return x.Collections
+	}
+	return nil
+}
+
+func (x *ListTrashResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type UndeleteCollectionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uid           string                 `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UndeleteCollectionRequest) Reset() {
+	*x = UndeleteCollectionRequest{}
+	mi := &file_proto_service_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UndeleteCollectionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UndeleteCollectionRequest) ProtoMessage() {}
+
+func (x *UndeleteCollectionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UndeleteCollectionRequest.ProtoReflect.Descriptor instead.
+func (*UndeleteCollectionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *UndeleteCollectionRequest) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+// A snapshot of a collection as it was at revision, before the write that replaced it.
+type CollectionVersion struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CollectionUid string                 `protobuf:"bytes,1,opt,name=collection_uid,json=collectionUid,proto3" json:"collection_uid,omitempty"`
+	Revision      int64                  `protobuf:"varint,2,opt,name=revision,proto3" json:"revision,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Data          *structpb.Struct       `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
+	// the user whose write replaced this version, not its author
+	SupersededBy string `protobuf:"bytes,5,opt,name=superseded_by,json=supersededBy,proto3" json:"superseded_by,omitempty"`
+	// when the version was replaced
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CollectionVersion) Reset() {
+	*x = CollectionVersion{}
+	mi := &file_proto_service_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CollectionVersion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CollectionVersion) ProtoMessage() {}
+
+func (x *CollectionVersion) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CollectionVersion.ProtoReflect.Descriptor instead.
+func (*CollectionVersion) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *CollectionVersion) GetCollectionUid() string {
+	if x != nil {
+		return x.CollectionUid
+	}
+	return ""
+}
+
+func (x *CollectionVersion) GetRevision() int64 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+func (x *CollectionVersion) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CollectionVersion) GetData() *structpb.Struct {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *CollectionVersion) GetSupersededBy() string {
+	if x != nil {
+		return x.SupersededBy
+	}
+	return ""
+}
+
+func (x *CollectionVersion) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type ListCollectionVersionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CollectionUid string                 `protobuf:"bytes,1,opt,name=collection_uid,json=collectionUid,proto3" json:"collection_uid,omitempty"`
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCollectionVersionsRequest) Reset() {
+	*x = ListCollectionVersionsRequest{}
+	mi := &file_proto_service_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCollectionVersionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCollectionVersionsRequest) ProtoMessage() {}
+
+func (x *ListCollectionVersionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCollectionVersionsRequest.ProtoReflect.Descriptor instead.
+func (*ListCollectionVersionsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *ListCollectionVersionsRequest) GetCollectionUid() string {
+	if x != nil {
+		return x.CollectionUid
+	}
+	return ""
+}
+
+func (x *ListCollectionVersionsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListCollectionVersionsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListCollectionVersionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Versions      []*CollectionVersion   `protobuf:"bytes,1,rep,name=versions,proto3" json:"versions,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCollectionVersionsResponse) Reset() {
+	*x = ListCollectionVersionsResponse{}
+	mi := &file_proto_service_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCollectionVersionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCollectionVersionsResponse) ProtoMessage() {}
+
+func (x *ListCollectionVersionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCollectionVersionsResponse.ProtoReflect.Descriptor instead.
+func (*ListCollectionVersionsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *ListCollectionVersionsResponse) GetVersions() []*CollectionVersion {
+	if x != nil {
+		return x.Versions
+	}
+	return nil
+}
+
+func (x *ListCollectionVersionsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type GetCollectionVersionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CollectionUid string                 `protobuf:"bytes,1,opt,name=collection_uid,json=collectionUid,proto3" json:"collection_uid,omitempty"`
+	Revision      int64                  `protobuf:"varint,2,opt,name=revision,proto3" json:"revision,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCollectionVersionRequest) Reset() {
+	*x = GetCollectionVersionRequest{}
+	mi := &file_proto_service_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCollectionVersionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCollectionVersionRequest) ProtoMessage() {}
+
+func (x *GetCollectionVersionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCollectionVersionRequest.ProtoReflect.Descriptor instead.
+func (*GetCollectionVersionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *GetCollectionVersionRequest) GetCollectionUid() string {
+	if x != nil {
+		return x.CollectionUid
+	}
+	return ""
+}
+
+func (x *GetCollectionVersionRequest) GetRevision() int64 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+// Restoring brings back the name and data of a version as a new write. Access settings are not
+// rolled back so a restore can never re-expose a collection.
+type RestoreCollectionVersionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CollectionUid string                 `protobuf:"bytes,1,opt,name=collection_uid,json=collectionUid,proto3" json:"collection_uid,omitempty"`
+	Revision      int64                  `protobuf:"varint,2,opt,name=revision,proto3" json:"revision,omitempty"`
+	Etag          string                 `protobuf:"bytes,3,opt,name=etag,proto3" json:"etag,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreCollectionVersionRequest) Reset() {
+	*x = RestoreCollectionVersionRequest{}
+	mi := &file_proto_service_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreCollectionVersionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreCollectionVersionRequest) ProtoMessage() {}
+
+func (x *RestoreCollectionVersionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreCollectionVersionRequest.ProtoReflect.Descriptor instead.
+func (*RestoreCollectionVersionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *RestoreCollectionVersionRequest) GetCollectionUid() string {
+	if x != nil {
+		return x.CollectionUid
+	}
+	return ""
+}
+
+func (x *RestoreCollectionVersionRequest) GetRevision() int64 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+func (x *RestoreCollectionVersionRequest) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
+// A request to hand a collection to another user or organization. Exactly one of to_user_uid and
+// to_organization_uid is set. Nothing changes until the receiving side accepts.
+type CollectionTransfer struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Uid               string                 `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	CollectionUid     string                 `protobuf:"bytes,2,opt,name=collection_uid,json=collectionUid,proto3" json:"collection_uid,omitempty"`
+	ToUserUid         string                 `protobuf:"bytes,3,opt,name=to_user_uid,json=toUserUid,proto3" json:"to_user_uid,omitempty"`
+	ToOrganizationUid string                 `protobuf:"bytes,4,opt,name=to_organization_uid,json=toOrganizationUid,proto3" json:"to_organization_uid,omitempty"`
+	Status            TransferStatus         `protobuf:"varint,5,opt,name=status,proto3,enum=censys.v1.TransferStatus" json:"status,omitempty"`
+	CreatedAt         *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ResolvedAt        *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=resolved_at,json=resolvedAt,proto3" json:"resolved_at,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *CollectionTransfer) Reset() {
+	*x = CollectionTransfer{}
+	mi := &file_proto_service_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CollectionTransfer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CollectionTransfer) ProtoMessage() {}
+
+func (x *CollectionTransfer) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CollectionTransfer.ProtoReflect.Descriptor instead.
+func (*CollectionTransfer) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *CollectionTransfer) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+func (x *CollectionTransfer) GetCollectionUid() string {
+	if x != nil {
+		return x.CollectionUid
+	}
+	return ""
+}
+
+func (x *CollectionTransfer) GetToUserUid() string {
+	if x != nil {
+		return x.ToUserUid
+	}
+	return ""
+}
+
+func (x *CollectionTransfer) GetToOrganizationUid() string {
+	if x != nil {
+		return x.ToOrganizationUid
+	}
+	return ""
+}
+
+func (x *CollectionTransfer) GetStatus() TransferStatus {
+	if x != nil {
+		return x.Status
+	}
+	return TransferStatus_TRANSFER_STATUS_UNSPECIFIED
+}
+
+func (x *CollectionTransfer) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *CollectionTransfer) GetResolvedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ResolvedAt
+	}
+	return nil
+}
+
+// The caller needs access to the collection. A collection can only have one pending transfer.
+type TransferCollectionRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	CollectionUid     string                 `protobuf:"bytes,1,opt,name=collection_uid,json=collectionUid,proto3" json:"collection_uid,omitempty"`
+	ToUserUid         string                 `protobuf:"bytes,2,opt,name=to_user_uid,json=toUserUid,proto3" json:"to_user_uid,omitempty"`
+	ToOrganizationUid string                 `protobuf:"bytes,3,opt,name=to_organization_uid,json=toOrganizationUid,proto3" json:"to_organization_uid,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *TransferCollectionRequest) Reset() {
+	*x = TransferCollectionRequest{}
+	mi := &file_proto_service_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransferCollectionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferCollectionRequest) ProtoMessage() {}
+
+func (x *TransferCollectionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferCollectionRequest.ProtoReflect.Descriptor instead.
+func (*TransferCollectionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *TransferCollectionRequest) GetCollectionUid() string {
+	if x != nil {
+		return x.CollectionUid
+	}
+	return ""
+}
+
+func (x *TransferCollectionRequest) GetToUserUid() string {
+	if x != nil {
+		return x.ToUserUid
+	}
+	return ""
+}
+
+func (x *TransferCollectionRequest) GetToOrganizationUid() string {
+	if x != nil {
+		return x.ToOrganizationUid
+	}
+	return ""
+}
+
+// Accepted by the receiving user, or any member of the receiving organization. A user receives the
+// collection as its private owner, an organization receives it as an organization collection without
+// an individual owner.
+type AcceptCollectionTransferRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TransferUid   string                 `protobuf:"bytes,1,opt,name=transfer_uid,json=transferUid,proto3" json:"transfer_uid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcceptCollectionTransferRequest) Reset() {
+	*x = AcceptCollectionTransferRequest{}
+	mi := &file_proto_service_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptCollectionTransferRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptCollectionTransferRequest) ProtoMessage() {}
+
+func (x *AcceptCollectionTransferRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptCollectionTransferRequest.ProtoReflect.Descriptor instead.
+func (*AcceptCollectionTransferRequest) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *AcceptCollectionTransferRequest) GetTransferUid() string {
+	if x != nil {
+		return x.TransferUid
+	}
+	return ""
+}
+
+// Declined by the receiving side, or withdrawn by the user who requested it.
+type DeclineCollectionTransferRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TransferUid   string                 `protobuf:"bytes,1,opt,name=transfer_uid,json=transferUid,proto3" json:"transfer_uid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeclineCollectionTransferRequest) Reset() {
+	*x = DeclineCollectionTransferRequest{}
+	mi := &file_proto_service_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeclineCollectionTransferRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeclineCollectionTransferRequest) ProtoMessage() {}
+
+func (x *DeclineCollectionTransferRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeclineCollectionTransferRequest.ProtoReflect.Descriptor instead.
+func (*DeclineCollectionTransferRequest) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *DeclineCollectionTransferRequest) GetTransferUid() string {
+	if x != nil {
+		return x.TransferUid
+	}
+	return ""
+}
+
+// Lists the pending transfers the caller can accept.
+type ListCollectionTransfersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCollectionTransfersRequest) Reset() {
+	*x = ListCollectionTransfersRequest{}
+	mi := &file_proto_service_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCollectionTransfersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCollectionTransfersRequest) ProtoMessage() {}
+
+func (x *ListCollectionTransfersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCollectionTransfersRequest.ProtoReflect.Descriptor instead.
+func (*ListCollectionTransfersRequest) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{63}
+}
+
+type ListCollectionTransfersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Transfers     []*CollectionTransfer  `protobuf:"bytes,1,rep,name=transfers,proto3" json:"transfers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCollectionTransfersResponse) Reset() {
+	*x = ListCollectionTransfersResponse{}
+	mi := &file_proto_service_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCollectionTransfersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCollectionTransfersResponse) ProtoMessage() {}
+
+func (x *ListCollectionTransfersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCollectionTransfersResponse.ProtoReflect.Descriptor instead.
+func (*ListCollectionTransfersResponse) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *ListCollectionTransfersResponse) GetTransfers() []*CollectionTransfer {
+	if x != nil {
+		return x.Transfers
+	}
+	return nil
+}
+
+// Without an organization_uid the caller's own usage is returned.
+type GetQuotaUsageRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationUid string                 `protobuf:"bytes,1,opt,name=organization_uid,json=organizationUid,proto3" json:"organization_uid,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetQuotaUsageRequest) Reset() {
+	*x = GetQuotaUsageRequest{}
+	mi := &file_proto_service_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetQuotaUsageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetQuotaUsageRequest) ProtoMessage() {}
+
+func (x *GetQuotaUsageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetQuotaUsageRequest.ProtoReflect.Descriptor instead.
+func (*GetQuotaUsageRequest) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *GetQuotaUsageRequest) GetOrganizationUid() string {
+	if x != nil {
+		return x.OrganizationUid
+	}
+	return ""
+}
+
+// Current usage next to the configured limits, a limit of zero means unlimited.
+// Collections in the trash do not count, storage includes payloads.
+type QuotaUsage struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationUid string                 `protobuf:"bytes,1,opt,name=organization_uid,json=organizationUid,proto3" json:"organization_uid,omitempty"`
+	Collections     int64                  `protobuf:"varint,2,opt,name=collections,proto3" json:"collections,omitempty"`
+	MaxCollections  int64                  `protobuf:"varint,3,opt,name=max_collections,json=maxCollections,proto3" json:"max_collections,omitempty"`
+	ShareTokens     int64                  `protobuf:"varint,4,opt,name=share_tokens,json=shareTokens,proto3" json:"share_tokens,omitempty"`
+	MaxShareTokens  int64                  `protobuf:"varint,5,opt,name=max_share_tokens,json=maxShareTokens,proto3" json:"max_share_tokens,omitempty"`
+	StorageBytes    int64                  `protobuf:"varint,6,opt,name=storage_bytes,json=storageBytes,proto3" json:"storage_bytes,omitempty"`
+	MaxStorageBytes int64                  `protobuf:"varint,7,opt,name=max_storage_bytes,json=maxStorageBytes,proto3" json:"max_storage_bytes,omitempty"`
+	MaxDataBytes    int64                  `protobuf:"varint,8,opt,name=max_data_bytes,json=maxDataBytes,proto3" json:"max_data_bytes,omitempty"`
+	MaxPayloadBytes int64                  `protobuf:"varint,9,opt,name=max_payload_bytes,json=maxPayloadBytes,proto3" json:"max_payload_bytes,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *QuotaUsage) Reset() {
+	*x = QuotaUsage{}
+	mi := &file_proto_service_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuotaUsage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuotaUsage) ProtoMessage() {}
+
+func (x *QuotaUsage) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuotaUsage.ProtoReflect.Descriptor instead.
+func (*QuotaUsage) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *QuotaUsage) GetOrganizationUid() string {
+	if x != nil {
+		return x.OrganizationUid
+	}
+	return ""
+}
+
+func (x *QuotaUsage) GetCollections() int64 {
+	if x != nil {
+		return x.Collections
+	}
+	return 0
+}
+
+func (x *QuotaUsage) GetMaxCollections() int64 {
+	if x != nil {
+		return x.MaxCollections
+	}
+	return 0
+}
+
+func (x *QuotaUsage) GetShareTokens() int64 {
+	if x != nil {
+		return x.ShareTokens
+	}
+	return 0
+}
+
+func (x *QuotaUsage) GetMaxShareTokens() int64 {
+	if x != nil {
+		return x.MaxShareTokens
+	}
+	return 0
+}
+
+func (x *QuotaUsage) GetStorageBytes() int64 {
+	if x != nil {
+		return x.StorageBytes
+	}
+	return 0
+}
+
+func (x *QuotaUsage) GetMaxStorageBytes() int64 {
+	if x != nil {
+		return x.MaxStorageBytes
+	}
+	return 0
+}
+
+func (x *QuotaUsage) GetMaxDataBytes() int64 {
+	if x != nil {
+		return x.MaxDataBytes
+	}
+	return 0
+}
+
+func (x *QuotaUsage) GetMaxPayloadBytes() int64 {
+	if x != nil {
+		return x.MaxPayloadBytes
+	}
+	return 0
+}
+
+type ShareToken struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	CollectionUid string                 `protobuf:"bytes,2,opt,name=collection_uid,json=collectionUid,proto3" json:"collection_uid,omitempty"`
+	AccessCount   int32                  `protobuf:"varint,3,opt,name=access_count,json=accessCount,proto3" json:"access_count,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// zero means the server wide default applies
+	RateLimit              int32 `protobuf:"varint,5,opt,name=rate_limit,json=rateLimit,proto3" json:"rate_limit,omitempty"`
+	RateLimitWindowSeconds int32 `protobuf:"varint,6,opt,name=rate_limit_window_seconds,json=rateLimitWindowSeconds,proto3" json:"rate_limit_window_seconds,omitempty"`
+	// suspended tokens are refused by GetSharedCollection until resumed
+	Suspended       bool                   `protobuf:"varint,7,opt,name=suspended,proto3" json:"suspended,omitempty"`
+	SuspendedReason string                 `protobuf:"bytes,8,opt,name=suspended_reason,json=suspendedReason,proto3" json:"suspended_reason,omitempty"`
+	SuspendedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=suspended_at,json=suspendedAt,proto3" json:"suspended_at,omitempty"`
+	// how long /s/{token} responses may be cached, zero means the server wide default applies
+	CacheMaxAgeSeconds int32 `protobuf:"varint,10,opt,name=cache_max_age_seconds,json=cacheMaxAgeSeconds,proto3" json:"cache_max_age_seconds,omitempty"`
+	// unset when the token never expires
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShareToken) Reset() {
+	*x = ShareToken{}
+	mi := &file_proto_service_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShareToken) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShareToken) ProtoMessage() {}
+
+func (x *ShareToken) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShareToken.ProtoReflect.Descriptor instead.
+func (*ShareToken) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *ShareToken) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *ShareToken) GetCollectionUid() string {
+	if x != nil {
+		return x.CollectionUid
+	}
+	return ""
+}
+
+func (x *ShareToken) GetAccessCount() int32 {
+	if x != nil {
+		return x.AccessCount
+	}
+	return 0
+}
+
+func (x *ShareToken) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *ShareToken) GetRateLimit() int32 {
+	if x != nil {
+		return x.RateLimit
+	}
+	return 0
+}
+
+func (x *ShareToken) GetRateLimitWindowSeconds() int32 {
+	if x != nil {
+		return x.RateLimitWindowSeconds
+	}
+	return 0
+}
+
+func (x *ShareToken) GetSuspended() bool {
+	if x != nil {
+		return x.Suspended
+	}
+	return false
+}
+
+func (x *ShareToken) GetSuspendedReason() string {
+	if x != nil {
+		return x.SuspendedReason
+	}
+	return ""
+}
+
+func (x *ShareToken) GetSuspendedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SuspendedAt
+	}
+	return nil
+}
+
+func (x *ShareToken) GetCacheMaxAgeSeconds() int32 {
+	if x != nil {
+		return x.CacheMaxAgeSeconds
+	}
+	return 0
+}
+
+func (x *ShareToken) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+type CreateShareTokenRequest struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	CollectionUid          string                 `protobuf:"bytes,1,opt,name=collection_uid,json=collectionUid,proto3" json:"collection_uid,omitempty"`
+	RateLimit              int32                  `protobuf:"varint,2,opt,name=rate_limit,json=rateLimit,proto3" json:"rate_limit,omitempty"`
+	RateLimitWindowSeconds int32                  `protobuf:"varint,3,opt,name=rate_limit_window_seconds,json=rateLimitWindowSeconds,proto3" json:"rate_limit_window_seconds,omitempty"`
+	CacheMaxAgeSeconds     int32                  `protobuf:"varint,4,opt,name=cache_max_age_seconds,json=cacheMaxAgeSeconds,proto3" json:"cache_max_age_seconds,omitempty"`
+	// how long the token works for, zero means it never expires
+	ExpiresInSeconds int32 `protobuf:"varint,5,opt,name=expires_in_seconds,json=expiresInSeconds,proto3" json:"expires_in_seconds,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *CreateShareTokenRequest) Reset() {
+	*x = CreateShareTokenRequest{}
+	mi := &file_proto_service_proto_msgTypes[68]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCollectionRequest) String() string {
+func (x *CreateShareTokenRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCollectionRequest) ProtoMessage() {}
+func (*CreateShareTokenRequest) ProtoMessage() {}
 
-func (x *GetCollectionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_service_proto_msgTypes[10]
+func (x *CreateShareTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[68]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -660,44 +4745,73 @@ func (x *GetCollectionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCollectionRequest.ProtoReflect.Descriptor instead.
-func (*GetCollectionRequest) Descriptor() ([]byte, []int) {
-	return file_proto_service_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use CreateShareTokenRequest.ProtoReflect.Descriptor instead.
+func (*CreateShareTokenRequest) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{68}
 }
 
-func (x *GetCollectionRequest) GetUid() string {
+func (x *CreateShareTokenRequest) GetCollectionUid() string {
 	if x != nil {
-		return x.Uid
+		return x.CollectionUid
 	}
 	return ""
 }
 
-type UpdateCollectionRequest struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	Uid             string                 `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
-	Name            string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Data            *structpb.Struct       `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
-	AccessLevel     AccessLevel            `protobuf:"varint,4,opt,name=access_level,json=accessLevel,proto3,enum=censys.v1.AccessLevel" json:"access_level,omitempty"`
-	OrganizationUid string                 `protobuf:"bytes,5,opt,name=organization_uid,json=organizationUid,proto3" json:"organization_uid,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+func (x *CreateShareTokenRequest) GetRateLimit() int32 {
+	if x != nil {
+		return x.RateLimit
+	}
+	return 0
 }
 
-func (x *UpdateCollectionRequest) Reset() {
-	*x = UpdateCollectionRequest{}
-	mi := &file_proto_service_proto_msgTypes[11]
+func (x *CreateShareTokenRequest) GetRateLimitWindowSeconds() int32 {
+	if x != nil {
+		return x.RateLimitWindowSeconds
+	}
+	return 0
+}
+
+func (x *CreateShareTokenRequest) GetCacheMaxAgeSeconds() int32 {
+	if x != nil {
+		return x.CacheMaxAgeSeconds
+	}
+	return 0
+}
+
+func (x *CreateShareTokenRequest) GetExpiresInSeconds() int32 {
+	if x != nil {
+		return x.ExpiresInSeconds
+	}
+	return 0
+}
+
+// Setting rate_limit or cache_max_age_seconds to zero clears that override and falls back to the server
+// wide default.
+type UpdateShareTokenRequest struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	Token                  string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	RateLimit              int32                  `protobuf:"varint,2,opt,name=rate_limit,json=rateLimit,proto3" json:"rate_limit,omitempty"`
+	RateLimitWindowSeconds int32                  `protobuf:"varint,3,opt,name=rate_limit_window_seconds,json=rateLimitWindowSeconds,proto3" json:"rate_limit_window_seconds,omitempty"`
+	CacheMaxAgeSeconds     int32                  `protobuf:"varint,4,opt,name=cache_max_age_seconds,json=cacheMaxAgeSeconds,proto3" json:"cache_max_age_seconds,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *UpdateShareTokenRequest) Reset() {
+	*x = UpdateShareTokenRequest{}
+	mi := &file_proto_service_proto_msgTypes[69]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateCollectionRequest) String() string {
+func (x *UpdateShareTokenRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateCollectionRequest) ProtoMessage() {}
+func (*UpdateShareTokenRequest) ProtoMessage() {}
 
-func (x *UpdateCollectionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_service_proto_msgTypes[11]
+func (x *UpdateShareTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[69]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -708,68 +4822,64 @@ func (x *UpdateCollectionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateCollectionRequest.ProtoReflect.Descriptor instead.
-func (*UpdateCollectionRequest) Descriptor() ([]byte, []int) {
-	return file_proto_service_proto_rawDescGZIP(), []int{11}
-}
-
-func (x *UpdateCollectionRequest) GetUid() string {
-	if x != nil {
-		return x.Uid
-	}
-	return ""
+// Deprecated: Use UpdateShareTokenRequest.ProtoReflect.Descriptor instead.
+func (*UpdateShareTokenRequest) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{69}
 }
 
-func (x *UpdateCollectionRequest) GetName() string {
+func (x *UpdateShareTokenRequest) GetToken() string {
 	if x != nil {
-		return x.Name
+		return x.Token
 	}
 	return ""
 }
 
-func (x *UpdateCollectionRequest) GetData() *structpb.Struct {
+func (x *UpdateShareTokenRequest) GetRateLimit() int32 {
 	if x != nil {
-		return x.Data
+		return x.RateLimit
 	}
-	return nil
+	return 0
 }
 
-func (x *UpdateCollectionRequest) GetAccessLevel() AccessLevel {
+func (x *UpdateShareTokenRequest) GetRateLimitWindowSeconds() int32 {
 	if x != nil {
-		return x.AccessLevel
+		return x.RateLimitWindowSeconds
 	}
-	return AccessLevel_ACCESS_LEVEL_UNSPECIFIED
+	return 0
 }
 
-func (x *UpdateCollectionRequest) GetOrganizationUid() string {
+func (x *UpdateShareTokenRequest) GetCacheMaxAgeSeconds() int32 {
 	if x != nil {
-		return x.OrganizationUid
+		return x.CacheMaxAgeSeconds
 	}
-	return ""
+	return 0
 }
 
-type DeleteCollectionRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Uid           string                 `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+type GetSharedCollectionRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Token string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	// An HTTP If-None-Match value. When it matches the current etag the response only has not_modified,
+	// etag and cache_max_age_seconds set, and the access is not counted.
+	IfNoneMatch   string `protobuf:"bytes,2,opt,name=if_none_match,json=ifNoneMatch,proto3" json:"if_none_match,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteCollectionRequest) Reset() {
-	*x = DeleteCollectionRequest{}
-	mi := &file_proto_service_proto_msgTypes[12]
+func (x *GetSharedCollectionRequest) Reset() {
+	*x = GetSharedCollectionRequest{}
+	mi := &file_proto_service_proto_msgTypes[70]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteCollectionRequest) String() string {
+func (x *GetSharedCollectionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteCollectionRequest) ProtoMessage() {}
+func (*GetSharedCollectionRequest) ProtoMessage() {}
 
-func (x *DeleteCollectionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_service_proto_msgTypes[12]
+func (x *GetSharedCollectionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[70]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -780,43 +4890,53 @@ func (x *DeleteCollectionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteCollectionRequest.ProtoReflect.Descriptor instead.
-func (*DeleteCollectionRequest) Descriptor() ([]byte, []int) {
-	return file_proto_service_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use GetSharedCollectionRequest.ProtoReflect.Descriptor instead.
+func (*GetSharedCollectionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{70}
 }
 
-func (x *DeleteCollectionRequest) GetUid() string {
+func (x *GetSharedCollectionRequest) GetToken() string {
 	if x != nil {
-		return x.Uid
+		return x.Token
 	}
 	return ""
 }
 
-type ShareToken struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
-	CollectionUid string                 `protobuf:"bytes,2,opt,name=collection_uid,json=collectionUid,proto3" json:"collection_uid,omitempty"`
-	AccessCount   int32                  `protobuf:"varint,3,opt,name=access_count,json=accessCount,proto3" json:"access_count,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *GetSharedCollectionRequest) GetIfNoneMatch() string {
+	if x != nil {
+		return x.IfNoneMatch
+	}
+	return ""
 }
 
-func (x *ShareToken) Reset() {
-	*x = ShareToken{}
-	mi := &file_proto_service_proto_msgTypes[13]
+type SharedCollectionResponse struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Collection  *Collection            `protobuf:"bytes,1,opt,name=collection,proto3" json:"collection,omitempty"`
+	AccessCount int32                  `protobuf:"varint,2,opt,name=access_count,json=accessCount,proto3" json:"access_count,omitempty"`
+	// strong etag of the shared collection, changes with its revision
+	Etag string `protobuf:"bytes,3,opt,name=etag,proto3" json:"etag,omitempty"`
+	// zero means the server wide default applies
+	CacheMaxAgeSeconds int32 `protobuf:"varint,4,opt,name=cache_max_age_seconds,json=cacheMaxAgeSeconds,proto3" json:"cache_max_age_seconds,omitempty"`
+	NotModified        bool  `protobuf:"varint,5,opt,name=not_modified,json=notModified,proto3" json:"not_modified,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *SharedCollectionResponse) Reset() {
+	*x = SharedCollectionResponse{}
+	mi := &file_proto_service_proto_msgTypes[71]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ShareToken) String() string {
+func (x *SharedCollectionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ShareToken) ProtoMessage() {}
+func (*SharedCollectionResponse) ProtoMessage() {}
 
-func (x *ShareToken) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_service_proto_msgTypes[13]
+func (x *SharedCollectionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[71]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -827,61 +4947,70 @@ func (x *ShareToken) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ShareToken.ProtoReflect.Descriptor instead.
-func (*ShareToken) Descriptor() ([]byte, []int) {
-	return file_proto_service_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use SharedCollectionResponse.ProtoReflect.Descriptor instead.
+func (*SharedCollectionResponse) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{71}
 }
 
-func (x *ShareToken) GetToken() string {
+func (x *SharedCollectionResponse) GetCollection() *Collection {
 	if x != nil {
-		return x.Token
+		return x.Collection
 	}
-	return ""
+	return nil
 }
 
-func (x *ShareToken) GetCollectionUid() string {
+func (x *SharedCollectionResponse) GetAccessCount() int32 {
 	if x != nil {
-		return x.CollectionUid
+		return x.AccessCount
+	}
+	return 0
+}
+
+func (x *SharedCollectionResponse) GetEtag() string {
+	if x != nil {
+		return x.Etag
 	}
 	return ""
 }
 
-func (x *ShareToken) GetAccessCount() int32 {
+func (x *SharedCollectionResponse) GetCacheMaxAgeSeconds() int32 {
 	if x != nil {
-		return x.AccessCount
+		return x.CacheMaxAgeSeconds
 	}
 	return 0
 }
 
-func (x *ShareToken) GetCreatedAt() *timestamppb.Timestamp {
+func (x *SharedCollectionResponse) GetNotModified() bool {
 	if x != nil {
-		return x.CreatedAt
+		return x.NotModified
 	}
-	return nil
+	return false
 }
 
-type CreateShareTokenRequest struct {
+// Sends the shared collection and then every update to it. The stream fails with NOT_FOUND once the
+// token is revoked or the collection deleted, and with PERMISSION_DENIED once the token is suspended.
+type WatchSharedCollectionRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	CollectionUid string                 `protobuf:"bytes,1,opt,name=collection_uid,json=collectionUid,proto3" json:"collection_uid,omitempty"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateShareTokenRequest) Reset() {
-	*x = CreateShareTokenRequest{}
-	mi := &file_proto_service_proto_msgTypes[14]
+func (x *WatchSharedCollectionRequest) Reset() {
+	*x = WatchSharedCollectionRequest{}
+	mi := &file_proto_service_proto_msgTypes[72]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateShareTokenRequest) String() string {
+func (x *WatchSharedCollectionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateShareTokenRequest) ProtoMessage() {}
+func (*WatchSharedCollectionRequest) ProtoMessage() {}
 
-func (x *CreateShareTokenRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_service_proto_msgTypes[14]
+func (x *WatchSharedCollectionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[72]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -892,40 +5021,40 @@ func (x *CreateShareTokenRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateShareTokenRequest.ProtoReflect.Descriptor instead.
-func (*CreateShareTokenRequest) Descriptor() ([]byte, []int) {
-	return file_proto_service_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use WatchSharedCollectionRequest.ProtoReflect.Descriptor instead.
+func (*WatchSharedCollectionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{72}
 }
 
-func (x *CreateShareTokenRequest) GetCollectionUid() string {
+func (x *WatchSharedCollectionRequest) GetToken() string {
 	if x != nil {
-		return x.CollectionUid
+		return x.Token
 	}
 	return ""
 }
 
-type GetSharedCollectionRequest struct {
+type RevokeShareTokenRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetSharedCollectionRequest) Reset() {
-	*x = GetSharedCollectionRequest{}
-	mi := &file_proto_service_proto_msgTypes[15]
+func (x *RevokeShareTokenRequest) Reset() {
+	*x = RevokeShareTokenRequest{}
+	mi := &file_proto_service_proto_msgTypes[73]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetSharedCollectionRequest) String() string {
+func (x *RevokeShareTokenRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSharedCollectionRequest) ProtoMessage() {}
+func (*RevokeShareTokenRequest) ProtoMessage() {}
 
-func (x *GetSharedCollectionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_service_proto_msgTypes[15]
+func (x *RevokeShareTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[73]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -936,41 +5065,41 @@ func (x *GetSharedCollectionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSharedCollectionRequest.ProtoReflect.Descriptor instead.
-func (*GetSharedCollectionRequest) Descriptor() ([]byte, []int) {
-	return file_proto_service_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use RevokeShareTokenRequest.ProtoReflect.Descriptor instead.
+func (*RevokeShareTokenRequest) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{73}
 }
 
-func (x *GetSharedCollectionRequest) GetToken() string {
+func (x *RevokeShareTokenRequest) GetToken() string {
 	if x != nil {
 		return x.Token
 	}
 	return ""
 }
 
-type SharedCollectionResponse struct {
+type SuspendShareTokenRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Collection    *Collection            `protobuf:"bytes,1,opt,name=collection,proto3" json:"collection,omitempty"`
-	AccessCount   int32                  `protobuf:"varint,2,opt,name=access_count,json=accessCount,proto3" json:"access_count,omitempty"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SharedCollectionResponse) Reset() {
-	*x = SharedCollectionResponse{}
-	mi := &file_proto_service_proto_msgTypes[16]
+func (x *SuspendShareTokenRequest) Reset() {
+	*x = SuspendShareTokenRequest{}
+	mi := &file_proto_service_proto_msgTypes[74]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SharedCollectionResponse) String() string {
+func (x *SuspendShareTokenRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SharedCollectionResponse) ProtoMessage() {}
+func (*SuspendShareTokenRequest) ProtoMessage() {}
 
-func (x *SharedCollectionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_service_proto_msgTypes[16]
+func (x *SuspendShareTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[74]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -981,47 +5110,47 @@ func (x *SharedCollectionResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SharedCollectionResponse.ProtoReflect.Descriptor instead.
-func (*SharedCollectionResponse) Descriptor() ([]byte, []int) {
-	return file_proto_service_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use SuspendShareTokenRequest.ProtoReflect.Descriptor instead.
+func (*SuspendShareTokenRequest) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{74}
 }
 
-func (x *SharedCollectionResponse) GetCollection() *Collection {
+func (x *SuspendShareTokenRequest) GetToken() string {
 	if x != nil {
-		return x.Collection
+		return x.Token
 	}
-	return nil
+	return ""
 }
 
-func (x *SharedCollectionResponse) GetAccessCount() int32 {
+func (x *SuspendShareTokenRequest) GetReason() string {
 	if x != nil {
-		return x.AccessCount
+		return x.Reason
 	}
-	return 0
+	return ""
 }
 
-type RevokeShareTokenRequest struct {
+type ResumeShareTokenRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RevokeShareTokenRequest) Reset() {
-	*x = RevokeShareTokenRequest{}
-	mi := &file_proto_service_proto_msgTypes[17]
+func (x *ResumeShareTokenRequest) Reset() {
+	*x = ResumeShareTokenRequest{}
+	mi := &file_proto_service_proto_msgTypes[75]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RevokeShareTokenRequest) String() string {
+func (x *ResumeShareTokenRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RevokeShareTokenRequest) ProtoMessage() {}
+func (*ResumeShareTokenRequest) ProtoMessage() {}
 
-func (x *RevokeShareTokenRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_service_proto_msgTypes[17]
+func (x *ResumeShareTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[75]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1032,12 +5161,12 @@ func (x *RevokeShareTokenRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RevokeShareTokenRequest.ProtoReflect.Descriptor instead.
-func (*RevokeShareTokenRequest) Descriptor() ([]byte, []int) {
-	return file_proto_service_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use ResumeShareTokenRequest.ProtoReflect.Descriptor instead.
+func (*ResumeShareTokenRequest) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{75}
 }
 
-func (x *RevokeShareTokenRequest) GetToken() string {
+func (x *ResumeShareTokenRequest) GetToken() string {
 	if x != nil {
 		return x.Token
 	}
@@ -1048,7 +5177,7 @@ var File_proto_service_proto protoreflect.FileDescriptor
 
 const file_proto_service_proto_rawDesc = "" +
 	"\n" +
-	"\x13proto/service.proto\x12\tcensys.v1\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1cgoogle/protobuf/struct.proto\x1a\x1fgoogle/protobuf/timestamp.proto\".\n" +
+	"\x13proto/service.proto\x12\tcensys.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a google/protobuf/field_mask.proto\x1a\x1cgoogle/protobuf/struct.proto\x1a\x1fgoogle/protobuf/timestamp.proto\".\n" +
 	"\x04User\x12\x10\n" +
 	"\x03uid\x18\x01 \x01(\tR\x03uid\x12\x14\n" +
 	"\x05email\x18\x02 \x01(\tR\x05email\"4\n" +
@@ -1058,17 +5187,39 @@ const file_proto_service_proto_rawDesc = "" +
 	"\x11CreateUserRequest\x12\x14\n" +
 	"\x05email\x18\x01 \x01(\tR\x05email\"/\n" +
 	"\x19CreateOrganizationRequest\x12\x12\n" +
-	"\x04name\x18\x01 \x01(\tR\x04name\"d\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"\x95\x01\n" +
 	"\x1cAddOrganizationMemberRequest\x12\x19\n" +
 	"\buser_uid\x18\x01 \x01(\tR\auserUid\x12)\n" +
-	"\x10organization_uid\x18\x02 \x01(\tR\x0forganizationUid\"z\n" +
+	"\x10organization_uid\x18\x02 \x01(\tR\x0forganizationUid\x12/\n" +
+	"\x04role\x18\x03 \x01(\x0e2\x1b.censys.v1.OrganizationRoleR\x04role\"\xab\x01\n" +
 	"\x16OrganizationMembership\x12#\n" +
 	"\x04user\x18\x01 \x01(\v2\x0f.censys.v1.UserR\x04user\x12;\n" +
-	"\forganization\x18\x02 \x01(\v2\x17.censys.v1.OrganizationR\forganization\"$\n" +
+	"\forganization\x18\x02 \x01(\v2\x17.censys.v1.OrganizationR\forganization\x12/\n" +
+	"\x04role\x18\x03 \x01(\x0e2\x1b.censys.v1.OrganizationRoleR\x04role\"q\n" +
+	"\x13OrphanedCollections\x12\x1e\n" +
+	"\n" +
+	"reassigned\x18\x01 \x01(\x05R\n" +
+	"reassigned\x12 \n" +
+	"\vquarantined\x18\x02 \x01(\x05R\vquarantined\x12\x18\n" +
+	"\adeleted\x18\x03 \x01(\x05R\adeleted\".\n" +
+	"\x11DeleteUserRequest\x12\x19\n" +
+	"\buser_uid\x18\x01 \x01(\tR\auserUid\"F\n" +
+	"\x19DeleteOrganizationRequest\x12)\n" +
+	"\x10organization_uid\x18\x01 \x01(\tR\x0forganizationUid\"_\n" +
+	"!ListQuarantinedCollectionsRequest\x12\x1b\n" +
+	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\"\x85\x01\n" +
+	"\"ListQuarantinedCollectionsResponse\x127\n" +
+	"\vcollections\x18\x01 \x03(\v2\x15.censys.v1.CollectionR\vcollections\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"]\n" +
+	"\x19ReassignCollectionRequest\x12%\n" +
+	"\x0ecollection_uid\x18\x01 \x01(\tR\rcollectionUid\x12\x19\n" +
+	"\buser_uid\x18\x02 \x01(\tR\auserUid\"$\n" +
 	"\fLoginRequest\x12\x14\n" +
 	"\x05email\x18\x01 \x01(\tR\x05email\"%\n" +
 	"\rLoginResponse\x12\x14\n" +
-	"\x05token\x18\x01 \x01(\tR\x05token\"\xd4\x02\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\"\xd9\x05\n" +
 	"\n" +
 	"Collection\x12\x10\n" +
 	"\x03uid\x18\x01 \x01(\tR\x03uid\x12\x12\n" +
@@ -1080,59 +5231,405 @@ const file_proto_service_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
-	"updated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xc0\x01\n" +
+	"updated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x1a\n" +
+	"\brevision\x18\t \x01(\x03R\brevision\x12\x12\n" +
+	"\x04etag\x18\n" +
+	" \x01(\tR\x04etag\x12+\n" +
+	"\x11version_retention\x18\v \x01(\x05R\x10versionRetention\x129\n" +
+	"\n" +
+	"deleted_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tdeletedAt\x129\n" +
+	"\x06labels\x18\r \x03(\v2!.censys.v1.Collection.LabelsEntryR\x06labels\x122\n" +
+	"\x15source_collection_uid\x18\x0e \x01(\tR\x13sourceCollectionUid\x12A\n" +
+	"\x0equarantined_at\x18\x0f \x01(\v2\x1a.google.protobuf.TimestampR\rquarantinedAt\x1a9\n" +
+	"\vLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xc3\x02\n" +
 	"\x17CreateCollectionRequest\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12+\n" +
 	"\x04data\x18\x02 \x01(\v2\x17.google.protobuf.StructR\x04data\x129\n" +
 	"\faccess_level\x18\x03 \x01(\x0e2\x16.censys.v1.AccessLevelR\vaccessLevel\x12)\n" +
-	"\x10organization_uid\x18\x04 \x01(\tR\x0forganizationUid\"(\n" +
+	"\x10organization_uid\x18\x04 \x01(\tR\x0forganizationUid\x12F\n" +
+	"\x06labels\x18\x05 \x03(\v2..censys.v1.CreateCollectionRequest.LabelsEntryR\x06labels\x1a9\n" +
+	"\vLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"(\n" +
 	"\x14GetCollectionRequest\x12\x10\n" +
-	"\x03uid\x18\x01 \x01(\tR\x03uid\"\xd2\x01\n" +
+	"\x03uid\x18\x01 \x01(\tR\x03uid\"\xd3\x03\n" +
 	"\x17UpdateCollectionRequest\x12\x10\n" +
 	"\x03uid\x18\x01 \x01(\tR\x03uid\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12+\n" +
 	"\x04data\x18\x03 \x01(\v2\x17.google.protobuf.StructR\x04data\x129\n" +
 	"\faccess_level\x18\x04 \x01(\x0e2\x16.censys.v1.AccessLevelR\vaccessLevel\x12)\n" +
-	"\x10organization_uid\x18\x05 \x01(\tR\x0forganizationUid\"+\n" +
+	"\x10organization_uid\x18\x05 \x01(\tR\x0forganizationUid\x12;\n" +
+	"\vupdate_mask\x18\x06 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask\x12\x12\n" +
+	"\x04etag\x18\a \x01(\tR\x04etag\x12+\n" +
+	"\x11version_retention\x18\b \x01(\x05R\x10versionRetention\x12F\n" +
+	"\x06labels\x18\t \x03(\v2..censys.v1.UpdateCollectionRequest.LabelsEntryR\x06labels\x1a9\n" +
+	"\vLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x8d\x01\n" +
+	"\x1aPatchCollectionDataRequest\x12\x10\n" +
+	"\x03uid\x18\x01 \x01(\tR\x03uid\x123\n" +
+	"\n" +
+	"patch_type\x18\x02 \x01(\x0e2\x14.censys.v1.PatchTypeR\tpatchType\x12\x14\n" +
+	"\x05patch\x18\x03 \x01(\tR\x05patch\x12\x12\n" +
+	"\x04etag\x18\x04 \x01(\tR\x04etag\"?\n" +
 	"\x17DeleteCollectionRequest\x12\x10\n" +
-	"\x03uid\x18\x01 \x01(\tR\x03uid\"\xa7\x01\n" +
+	"\x03uid\x18\x01 \x01(\tR\x03uid\x12\x12\n" +
+	"\x04etag\x18\x02 \x01(\tR\x04etag\"\x89\x01\n" +
+	"\x15CopyCollectionRequest\x12\x10\n" +
+	"\x03uid\x18\x01 \x01(\tR\x03uid\x12\x1f\n" +
+	"\vshare_token\x18\x02 \x01(\tR\n" +
+	"shareToken\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12)\n" +
+	"\x10organization_uid\x18\x04 \x01(\tR\x0forganizationUid\"\xc4\x01\n" +
+	"\x11CollectionPayload\x12%\n" +
+	"\x0ecollection_uid\x18\x01 \x01(\tR\rcollectionUid\x12!\n" +
+	"\fcontent_type\x18\x02 \x01(\tR\vcontentType\x12\x12\n" +
+	"\x04size\x18\x03 \x01(\x03R\x04size\x12\x16\n" +
+	"\x06sha256\x18\x04 \x01(\tR\x06sha256\x129\n" +
+	"\n" +
+	"updated_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"z\n" +
+	"\x1aUploadCollectionDataHeader\x12%\n" +
+	"\x0ecollection_uid\x18\x01 \x01(\tR\rcollectionUid\x12!\n" +
+	"\fcontent_type\x18\x02 \x01(\tR\vcontentType\x12\x12\n" +
+	"\x04etag\x18\x03 \x01(\tR\x04etag\"~\n" +
+	"\x1bUploadCollectionDataRequest\x12?\n" +
+	"\x06header\x18\x01 \x01(\v2%.censys.v1.UploadCollectionDataHeaderH\x00R\x06header\x12\x16\n" +
+	"\x05chunk\x18\x02 \x01(\fH\x00R\x05chunkB\x06\n" +
+	"\x04part\"v\n" +
+	"\x1dDownloadCollectionDataRequest\x12%\n" +
+	"\x0ecollection_uid\x18\x01 \x01(\tR\rcollectionUid\x12\x16\n" +
+	"\x06offset\x18\x02 \x01(\x03R\x06offset\x12\x16\n" +
+	"\x06length\x18\x03 \x01(\x03R\x06length\"\x84\x01\n" +
+	"\x1eDownloadCollectionDataResponse\x126\n" +
+	"\apayload\x18\x01 \x01(\v2\x1c.censys.v1.CollectionPayloadR\apayload\x12\x16\n" +
+	"\x06offset\x18\x02 \x01(\x03R\x06offset\x12\x12\n" +
+	"\x04data\x18\x03 \x01(\fR\x04data\"\xa3\x01\n" +
+	"\x0fCollectionEvent\x122\n" +
+	"\x04type\x18\x01 \x01(\x0e2\x1e.censys.v1.CollectionEventTypeR\x04type\x12%\n" +
+	"\x0ecollection_uid\x18\x02 \x01(\tR\rcollectionUid\x125\n" +
+	"\n" +
+	"collection\x18\x03 \x01(\v2\x15.censys.v1.CollectionR\n" +
+	"collection\"*\n" +
+	"\x16WatchCollectionRequest\x12\x10\n" +
+	"\x03uid\x18\x01 \x01(\tR\x03uid\"P\n" +
+	"#WatchOrganizationCollectionsRequest\x12)\n" +
+	"\x10organization_uid\x18\x01 \x01(\tR\x0forganizationUid\"\xd8\x01\n" +
+	"\x13WebhookSubscription\x12\x10\n" +
+	"\x03uid\x18\x01 \x01(\tR\x03uid\x12)\n" +
+	"\x10organization_uid\x18\x02 \x01(\tR\x0forganizationUid\x12\x10\n" +
+	"\x03url\x18\x03 \x01(\tR\x03url\x12\x1f\n" +
+	"\vevent_types\x18\x04 \x03(\tR\n" +
+	"eventTypes\x12\x16\n" +
+	"\x06secret\x18\x05 \x01(\tR\x06secret\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\x80\x01\n" +
+	" CreateWebhookSubscriptionRequest\x12)\n" +
+	"\x10organization_uid\x18\x01 \x01(\tR\x0forganizationUid\x12\x10\n" +
+	"\x03url\x18\x02 \x01(\tR\x03url\x12\x1f\n" +
+	"\vevent_types\x18\x03 \x03(\tR\n" +
+	"eventTypes\"L\n" +
+	"\x1fListWebhookSubscriptionsRequest\x12)\n" +
+	"\x10organization_uid\x18\x01 \x01(\tR\x0forganizationUid\"h\n" +
+	" ListWebhookSubscriptionsResponse\x12D\n" +
+	"\rsubscriptions\x18\x01 \x03(\v2\x1e.censys.v1.WebhookSubscriptionR\rsubscriptions\"4\n" +
+	" DeleteWebhookSubscriptionRequest\x12\x10\n" +
+	"\x03uid\x18\x01 \x01(\tR\x03uid\"\x90\x03\n" +
+	"\x0fWebhookDelivery\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x1b\n" +
+	"\tevent_uid\x18\x02 \x01(\tR\beventUid\x12\x1d\n" +
+	"\n" +
+	"event_type\x18\x03 \x01(\tR\teventType\x128\n" +
+	"\x06status\x18\x04 \x01(\x0e2 .censys.v1.WebhookDeliveryStatusR\x06status\x12\x1a\n" +
+	"\battempts\x18\x05 \x01(\x05R\battempts\x12\x1d\n" +
+	"\n" +
+	"last_error\x18\x06 \x01(\tR\tlastError\x12B\n" +
+	"\x0fnext_attempt_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\rnextAttemptAt\x12=\n" +
+	"\fdelivered_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\vdeliveredAt\x129\n" +
+	"\n" +
+	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xbf\x01\n" +
+	"\x1cListWebhookDeliveriesRequest\x12)\n" +
+	"\x10subscription_uid\x18\x01 \x01(\tR\x0fsubscriptionUid\x128\n" +
+	"\x06status\x18\x02 \x01(\x0e2 .censys.v1.WebhookDeliveryStatusR\x06status\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x04 \x01(\tR\tpageToken\"\x83\x01\n" +
+	"\x1dListWebhookDeliveriesResponse\x12:\n" +
+	"\n" +
+	"deliveries\x18\x01 \x03(\v2\x1a.censys.v1.WebhookDeliveryR\n" +
+	"deliveries\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"i\n" +
+	"\x1bRetryWebhookDeliveryRequest\x12)\n" +
+	"\x10subscription_uid\x18\x01 \x01(\tR\x0fsubscriptionUid\x12\x1f\n" +
+	"\vdelivery_id\x18\x02 \x01(\x05R\n" +
+	"deliveryId\"\xaf\x01\n" +
+	"\x18ImportCollectionsRequest\x12)\n" +
+	"\x04mode\x18\x01 \x01(\x0e2\x15.censys.v1.ImportModeR\x04mode\x12D\n" +
+	"\n" +
+	"collection\x18\x02 \x01(\v2\".censys.v1.CreateCollectionRequestH\x00R\n" +
+	"collection\x12\x18\n" +
+	"\x06ndjson\x18\x03 \x01(\fH\x00R\x06ndjsonB\b\n" +
+	"\x06record\"Q\n" +
+	"\vImportError\x12\x14\n" +
+	"\x05index\x18\x01 \x01(\x05R\x05index\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\x05R\x04code\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\"\xa8\x01\n" +
+	"\x19ImportCollectionsResponse\x12\x1a\n" +
+	"\bimported\x18\x01 \x01(\x05R\bimported\x12\x16\n" +
+	"\x06failed\x18\x02 \x01(\x05R\x06failed\x12.\n" +
+	"\x06errors\x18\x03 \x03(\v2\x16.censys.v1.ImportErrorR\x06errors\x12'\n" +
+	"\x0fcollection_uids\x18\x04 \x03(\tR\x0ecollectionUids\"r\n" +
+	"\x18ExportCollectionsRequest\x12/\n" +
+	"\x06format\x18\x01 \x01(\x0e2\x17.censys.v1.ExportFormatR\x06format\x12%\n" +
+	"\x0elabel_selector\x18\x02 \x01(\tR\rlabelSelector\"x\n" +
+	"\x19ExportCollectionsResponse\x127\n" +
+	"\n" +
+	"collection\x18\x01 \x01(\v2\x15.censys.v1.CollectionH\x00R\n" +
+	"collection\x12\x18\n" +
+	"\x06ndjson\x18\x02 \x01(\fH\x00R\x06ndjsonB\b\n" +
+	"\x06record\"N\n" +
+	"\n" +
+	"DataFilter\x12\x12\n" +
+	"\x04path\x18\x01 \x01(\tR\x04path\x12,\n" +
+	"\x05value\x18\x02 \x01(\v2\x16.google.protobuf.ValueR\x05value\"\xe1\x01\n" +
+	"\x18SearchCollectionsRequest\x12\x14\n" +
+	"\x05query\x18\x01 \x01(\tR\x05query\x12/\n" +
+	"\afilters\x18\x02 \x03(\v2\x15.censys.v1.DataFilterR\afilters\x12\x1b\n" +
+	"\tdata_path\x18\x03 \x01(\tR\bdataPath\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x05 \x01(\tR\tpageToken\x12%\n" +
+	"\x0elabel_selector\x18\x06 \x01(\tR\rlabelSelector\"|\n" +
+	"\x19SearchCollectionsResponse\x127\n" +
+	"\vcollections\x18\x01 \x03(\v2\x15.censys.v1.CollectionR\vcollections\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\xf8\x01\n" +
+	"\x10CollectionSchema\x12)\n" +
+	"\x10organization_uid\x18\x01 \x01(\tR\x0forganizationUid\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12/\n" +
+	"\x06schema\x18\x03 \x01(\v2\x17.google.protobuf.StructR\x06schema\x129\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\x91\x01\n" +
+	"\x1fRegisterCollectionSchemaRequest\x12)\n" +
+	"\x10organization_uid\x18\x01 \x01(\tR\x0forganizationUid\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12/\n" +
+	"\x06schema\x18\x03 \x01(\v2\x17.google.protobuf.StructR\x06schema\"I\n" +
+	"\x1cListCollectionSchemasRequest\x12)\n" +
+	"\x10organization_uid\x18\x01 \x01(\tR\x0forganizationUid\"V\n" +
+	"\x1dListCollectionSchemasResponse\x125\n" +
+	"\aschemas\x18\x01 \x03(\v2\x1b.censys.v1.CollectionSchemaR\aschemas\"^\n" +
+	"\x1dDeleteCollectionSchemaRequest\x12)\n" +
+	"\x10organization_uid\x18\x01 \x01(\tR\x0forganizationUid\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\"u\n" +
+	"\x10ListTrashRequest\x12\x1b\n" +
+	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\x12%\n" +
+	"\x0elabel_selector\x18\x03 \x01(\tR\rlabelSelector\"t\n" +
+	"\x11ListTrashResponse\x127\n" +
+	"\vcollections\x18\x01 \x03(\v2\x15.censys.v1.CollectionR\vcollections\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"-\n" +
+	"\x19UndeleteCollectionRequest\x12\x10\n" +
+	"\x03uid\x18\x01 \x01(\tR\x03uid\"\xf7\x01\n" +
+	"\x11CollectionVersion\x12%\n" +
+	"\x0ecollection_uid\x18\x01 \x01(\tR\rcollectionUid\x12\x1a\n" +
+	"\brevision\x18\x02 \x01(\x03R\brevision\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12+\n" +
+	"\x04data\x18\x04 \x01(\v2\x17.google.protobuf.StructR\x04data\x12#\n" +
+	"\rsuperseded_by\x18\x05 \x01(\tR\fsupersededBy\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\x82\x01\n" +
+	"\x1dListCollectionVersionsRequest\x12%\n" +
+	"\x0ecollection_uid\x18\x01 \x01(\tR\rcollectionUid\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\"\x82\x01\n" +
+	"\x1eListCollectionVersionsResponse\x128\n" +
+	"\bversions\x18\x01 \x03(\v2\x1c.censys.v1.CollectionVersionR\bversions\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"`\n" +
+	"\x1bGetCollectionVersionRequest\x12%\n" +
+	"\x0ecollection_uid\x18\x01 \x01(\tR\rcollectionUid\x12\x1a\n" +
+	"\brevision\x18\x02 \x01(\x03R\brevision\"x\n" +
+	"\x1fRestoreCollectionVersionRequest\x12%\n" +
+	"\x0ecollection_uid\x18\x01 \x01(\tR\rcollectionUid\x12\x1a\n" +
+	"\brevision\x18\x02 \x01(\x03R\brevision\x12\x12\n" +
+	"\x04etag\x18\x03 \x01(\tR\x04etag\"\xc8\x02\n" +
+	"\x12CollectionTransfer\x12\x10\n" +
+	"\x03uid\x18\x01 \x01(\tR\x03uid\x12%\n" +
+	"\x0ecollection_uid\x18\x02 \x01(\tR\rcollectionUid\x12\x1e\n" +
+	"\vto_user_uid\x18\x03 \x01(\tR\ttoUserUid\x12.\n" +
+	"\x13to_organization_uid\x18\x04 \x01(\tR\x11toOrganizationUid\x121\n" +
+	"\x06status\x18\x05 \x01(\x0e2\x19.censys.v1.TransferStatusR\x06status\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12;\n" +
+	"\vresolved_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"resolvedAt\"\x92\x01\n" +
+	"\x19TransferCollectionRequest\x12%\n" +
+	"\x0ecollection_uid\x18\x01 \x01(\tR\rcollectionUid\x12\x1e\n" +
+	"\vto_user_uid\x18\x02 \x01(\tR\ttoUserUid\x12.\n" +
+	"\x13to_organization_uid\x18\x03 \x01(\tR\x11toOrganizationUid\"D\n" +
+	"\x1fAcceptCollectionTransferRequest\x12!\n" +
+	"\ftransfer_uid\x18\x01 \x01(\tR\vtransferUid\"E\n" +
+	" DeclineCollectionTransferRequest\x12!\n" +
+	"\ftransfer_uid\x18\x01 \x01(\tR\vtransferUid\" \n" +
+	"\x1eListCollectionTransfersRequest\"^\n" +
+	"\x1fListCollectionTransfersResponse\x12;\n" +
+	"\ttransfers\x18\x01 \x03(\v2\x1d.censys.v1.CollectionTransferR\ttransfers\"A\n" +
+	"\x14GetQuotaUsageRequest\x12)\n" +
+	"\x10organization_uid\x18\x01 \x01(\tR\x0forganizationUid\"\xf2\x02\n" +
+	"\n" +
+	"QuotaUsage\x12)\n" +
+	"\x10organization_uid\x18\x01 \x01(\tR\x0forganizationUid\x12 \n" +
+	"\vcollections\x18\x02 \x01(\x03R\vcollections\x12'\n" +
+	"\x0fmax_collections\x18\x03 \x01(\x03R\x0emaxCollections\x12!\n" +
+	"\fshare_tokens\x18\x04 \x01(\x03R\vshareTokens\x12(\n" +
+	"\x10max_share_tokens\x18\x05 \x01(\x03R\x0emaxShareTokens\x12#\n" +
+	"\rstorage_bytes\x18\x06 \x01(\x03R\fstorageBytes\x12*\n" +
+	"\x11max_storage_bytes\x18\a \x01(\x03R\x0fmaxStorageBytes\x12$\n" +
+	"\x0emax_data_bytes\x18\b \x01(\x03R\fmaxDataBytes\x12*\n" +
+	"\x11max_payload_bytes\x18\t \x01(\x03R\x0fmaxPayloadBytes\"\xf7\x03\n" +
 	"\n" +
 	"ShareToken\x12\x14\n" +
 	"\x05token\x18\x01 \x01(\tR\x05token\x12%\n" +
 	"\x0ecollection_uid\x18\x02 \x01(\tR\rcollectionUid\x12!\n" +
 	"\faccess_count\x18\x03 \x01(\x05R\vaccessCount\x129\n" +
 	"\n" +
-	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"@\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"rate_limit\x18\x05 \x01(\x05R\trateLimit\x129\n" +
+	"\x19rate_limit_window_seconds\x18\x06 \x01(\x05R\x16rateLimitWindowSeconds\x12\x1c\n" +
+	"\tsuspended\x18\a \x01(\bR\tsuspended\x12)\n" +
+	"\x10suspended_reason\x18\b \x01(\tR\x0fsuspendedReason\x12=\n" +
+	"\fsuspended_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\vsuspendedAt\x121\n" +
+	"\x15cache_max_age_seconds\x18\n" +
+	" \x01(\x05R\x12cacheMaxAgeSeconds\x129\n" +
+	"\n" +
+	"expires_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\"\xfb\x01\n" +
 	"\x17CreateShareTokenRequest\x12%\n" +
-	"\x0ecollection_uid\x18\x01 \x01(\tR\rcollectionUid\"2\n" +
+	"\x0ecollection_uid\x18\x01 \x01(\tR\rcollectionUid\x12\x1d\n" +
+	"\n" +
+	"rate_limit\x18\x02 \x01(\x05R\trateLimit\x129\n" +
+	"\x19rate_limit_window_seconds\x18\x03 \x01(\x05R\x16rateLimitWindowSeconds\x121\n" +
+	"\x15cache_max_age_seconds\x18\x04 \x01(\x05R\x12cacheMaxAgeSeconds\x12,\n" +
+	"\x12expires_in_seconds\x18\x05 \x01(\x05R\x10expiresInSeconds\"\xbc\x01\n" +
+	"\x17UpdateShareTokenRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12\x1d\n" +
+	"\n" +
+	"rate_limit\x18\x02 \x01(\x05R\trateLimit\x129\n" +
+	"\x19rate_limit_window_seconds\x18\x03 \x01(\x05R\x16rateLimitWindowSeconds\x121\n" +
+	"\x15cache_max_age_seconds\x18\x04 \x01(\x05R\x12cacheMaxAgeSeconds\"V\n" +
 	"\x1aGetSharedCollectionRequest\x12\x14\n" +
-	"\x05token\x18\x01 \x01(\tR\x05token\"t\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12\"\n" +
+	"\rif_none_match\x18\x02 \x01(\tR\vifNoneMatch\"\xde\x01\n" +
 	"\x18SharedCollectionResponse\x125\n" +
 	"\n" +
 	"collection\x18\x01 \x01(\v2\x15.censys.v1.CollectionR\n" +
 	"collection\x12!\n" +
-	"\faccess_count\x18\x02 \x01(\x05R\vaccessCount\"/\n" +
+	"\faccess_count\x18\x02 \x01(\x05R\vaccessCount\x12\x12\n" +
+	"\x04etag\x18\x03 \x01(\tR\x04etag\x121\n" +
+	"\x15cache_max_age_seconds\x18\x04 \x01(\x05R\x12cacheMaxAgeSeconds\x12!\n" +
+	"\fnot_modified\x18\x05 \x01(\bR\vnotModified\"4\n" +
+	"\x1cWatchSharedCollectionRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\"/\n" +
 	"\x17RevokeShareTokenRequest\x12\x14\n" +
-	"\x05token\x18\x01 \x01(\tR\x05token*}\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\"H\n" +
+	"\x18SuspendShareTokenRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\"/\n" +
+	"\x17ResumeShareTokenRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token*p\n" +
+	"\x10OrganizationRole\x12!\n" +
+	"\x1dORGANIZATION_ROLE_UNSPECIFIED\x10\x00\x12\x1c\n" +
+	"\x18ORGANIZATION_ROLE_MEMBER\x10\x01\x12\x1b\n" +
+	"\x17ORGANIZATION_ROLE_ADMIN\x10\x02*}\n" +
 	"\vAccessLevel\x12\x1c\n" +
 	"\x18ACCESS_LEVEL_UNSPECIFIED\x10\x00\x12\x18\n" +
 	"\x14ACCESS_LEVEL_PRIVATE\x10\x01\x12\x1d\n" +
 	"\x19ACCESS_LEVEL_ORGANIZATION\x10\x02\x12\x17\n" +
-	"\x13ACCESS_LEVEL_SHARED\x10\x032\x85\x02\n" +
+	"\x13ACCESS_LEVEL_SHARED\x10\x03*^\n" +
+	"\tPatchType\x12\x1a\n" +
+	"\x16PATCH_TYPE_UNSPECIFIED\x10\x00\x12\x19\n" +
+	"\x15PATCH_TYPE_JSON_PATCH\x10\x01\x12\x1a\n" +
+	"\x16PATCH_TYPE_MERGE_PATCH\x10\x02*\xcf\x01\n" +
+	"\x13CollectionEventType\x12%\n" +
+	"!COLLECTION_EVENT_TYPE_UNSPECIFIED\x10\x00\x12!\n" +
+	"\x1dCOLLECTION_EVENT_TYPE_CREATED\x10\x01\x12!\n" +
+	"\x1dCOLLECTION_EVENT_TYPE_UPDATED\x10\x02\x12!\n" +
+	"\x1dCOLLECTION_EVENT_TYPE_DELETED\x10\x03\x12(\n" +
+	"$COLLECTION_EVENT_TYPE_ACCESS_CHANGED\x10\x04*\xae\x01\n" +
+	"\x15WebhookDeliveryStatus\x12'\n" +
+	"#WEBHOOK_DELIVERY_STATUS_UNSPECIFIED\x10\x00\x12#\n" +
+	"\x1fWEBHOOK_DELIVERY_STATUS_PENDING\x10\x01\x12%\n" +
+	"!WEBHOOK_DELIVERY_STATUS_DELIVERED\x10\x02\x12 \n" +
+	"\x1cWEBHOOK_DELIVERY_STATUS_DEAD\x10\x03*e\n" +
+	"\n" +
+	"ImportMode\x12\x1b\n" +
+	"\x17IMPORT_MODE_UNSPECIFIED\x10\x00\x12\x1b\n" +
+	"\x17IMPORT_MODE_BEST_EFFORT\x10\x01\x12\x1d\n" +
+	"\x19IMPORT_MODE_TRANSACTIONAL\x10\x02*`\n" +
+	"\fExportFormat\x12\x1d\n" +
+	"\x19EXPORT_FORMAT_UNSPECIFIED\x10\x00\x12\x17\n" +
+	"\x13EXPORT_FORMAT_PROTO\x10\x01\x12\x18\n" +
+	"\x14EXPORT_FORMAT_NDJSON\x10\x02*\x8a\x01\n" +
+	"\x0eTransferStatus\x12\x1f\n" +
+	"\x1bTRANSFER_STATUS_UNSPECIFIED\x10\x00\x12\x1b\n" +
+	"\x17TRANSFER_STATUS_PENDING\x10\x01\x12\x1c\n" +
+	"\x18TRANSFER_STATUS_ACCEPTED\x10\x02\x12\x1c\n" +
+	"\x18TRANSFER_STATUS_DECLINED\x10\x032\xca\x05\n" +
 	"\fAdminService\x12;\n" +
 	"\n" +
 	"CreateUser\x12\x1c.censys.v1.CreateUserRequest\x1a\x0f.censys.v1.User\x12S\n" +
 	"\x12CreateOrganization\x12$.censys.v1.CreateOrganizationRequest\x1a\x17.censys.v1.Organization\x12c\n" +
-	"\x15AddOrganizationMember\x12'.censys.v1.AddOrganizationMemberRequest\x1a!.censys.v1.OrganizationMembership2\x88\x05\n" +
-	"\x11CollectionService\x12:\n" +
-	"\x05Login\x12\x17.censys.v1.LoginRequest\x1a\x18.censys.v1.LoginResponse\x12M\n" +
-	"\x10CreateCollection\x12\".censys.v1.CreateCollectionRequest\x1a\x15.censys.v1.Collection\x12G\n" +
-	"\rGetCollection\x12\x1f.censys.v1.GetCollectionRequest\x1a\x15.censys.v1.Collection\x12M\n" +
-	"\x10UpdateCollection\x12\".censys.v1.UpdateCollectionRequest\x1a\x15.censys.v1.Collection\x12N\n" +
-	"\x10DeleteCollection\x12\".censys.v1.DeleteCollectionRequest\x1a\x16.google.protobuf.Empty\x12M\n" +
-	"\x10CreateShareToken\x12\".censys.v1.CreateShareTokenRequest\x1a\x15.censys.v1.ShareToken\x12a\n" +
-	"\x13GetSharedCollection\x12%.censys.v1.GetSharedCollectionRequest\x1a#.censys.v1.SharedCollectionResponse\x12N\n" +
-	"\x10RevokeShareToken\x12\".censys.v1.RevokeShareTokenRequest\x1a\x16.google.protobuf.EmptyB\x9c\x01\n" +
+	"\x15AddOrganizationMember\x12'.censys.v1.AddOrganizationMemberRequest\x1a!.censys.v1.OrganizationMembership\x12M\n" +
+	"\x10UpdateShareToken\x12\".censys.v1.UpdateShareTokenRequest\x1a\x15.censys.v1.ShareToken\x12J\n" +
+	"\n" +
+	"DeleteUser\x12\x1c.censys.v1.DeleteUserRequest\x1a\x1e.censys.v1.OrphanedCollections\x12Z\n" +
+	"\x12DeleteOrganization\x12$.censys.v1.DeleteOrganizationRequest\x1a\x1e.censys.v1.OrphanedCollections\x12y\n" +
+	"\x1aListQuarantinedCollections\x12,.censys.v1.ListQuarantinedCollectionsRequest\x1a-.censys.v1.ListQuarantinedCollectionsResponse\x12Q\n" +
+	"\x12ReassignCollection\x12$.censys.v1.ReassignCollectionRequest\x1a\x15.censys.v1.Collection2\xde(\n" +
+	"\x11CollectionService\x12P\n" +
+	"\x05Login\x12\x17.censys.v1.LoginRequest\x1a\x18.censys.v1.LoginResponse\"\x14\x82\xd3\xe4\x93\x02\x0e:\x01*\"\t/v1/login\x12i\n" +
+	"\x10CreateCollection\x12\".censys.v1.CreateCollectionRequest\x1a\x15.censys.v1.Collection\"\x1a\x82\xd3\xe4\x93\x02\x14:\x01*\"\x0f/v1/collections\x12f\n" +
+	"\rGetCollection\x12\x1f.censys.v1.GetCollectionRequest\x1a\x15.censys.v1.Collection\"\x1d\x82\xd3\xe4\x93\x02\x17\x12\x15/v1/collections/{uid}\x12o\n" +
+	"\x10UpdateCollection\x12\".censys.v1.UpdateCollectionRequest\x1a\x15.censys.v1.Collection\" \x82\xd3\xe4\x93\x02\x1a:\x01*2\x15/v1/collections/{uid}\x12\x7f\n" +
+	"\x13PatchCollectionData\x12%.censys.v1.PatchCollectionDataRequest\x1a\x15.censys.v1.Collection\"*\x82\xd3\xe4\x93\x02$:\x01*\"\x1f/v1/collections/{uid}:patchData\x12m\n" +
+	"\x10DeleteCollection\x12\".censys.v1.DeleteCollectionRequest\x1a\x16.google.protobuf.Empty\"\x1d\x82\xd3\xe4\x93\x02\x17*\x15/v1/collections/{uid}\x12j\n" +
+	"\x0eCopyCollection\x12 .censys.v1.CopyCollectionRequest\x1a\x15.censys.v1.Collection\"\x1f\x82\xd3\xe4\x93\x02\x19:\x01*\"\x14/v1/collections:copy\x12^\n" +
+	"\x14UploadCollectionData\x12&.censys.v1.UploadCollectionDataRequest\x1a\x1c.censys.v1.CollectionPayload(\x01\x12\x9e\x01\n" +
+	"\x16DownloadCollectionData\x12(.censys.v1.DownloadCollectionDataRequest\x1a).censys.v1.DownloadCollectionDataResponse\"-\x82\xd3\xe4\x93\x02'\x12%/v1/collections/{collection_uid}/data0\x01\x12w\n" +
+	"\x0fWatchCollection\x12!.censys.v1.WatchCollectionRequest\x1a\x1a.censys.v1.CollectionEvent\"#\x82\xd3\xe4\x93\x02\x1d\x12\x1b/v1/collections/{uid}:watch0\x01\x12\xac\x01\n" +
+	"\x1cWatchOrganizationCollections\x12..censys.v1.WatchOrganizationCollectionsRequest\x1a\x1a.censys.v1.CollectionEvent\">\x82\xd3\xe4\x93\x028\x126/v1/organizations/{organization_uid}/collections:watch0\x01\x12`\n" +
+	"\x11ImportCollections\x12#.censys.v1.ImportCollectionsRequest\x1a$.censys.v1.ImportCollectionsResponse(\x01\x12\x80\x01\n" +
+	"\x11ExportCollections\x12#.censys.v1.ExportCollectionsRequest\x1a$.censys.v1.ExportCollectionsResponse\"\x1e\x82\xd3\xe4\x93\x02\x18\x12\x16/v1/collections:export0\x01\x12\x81\x01\n" +
+	"\x11SearchCollections\x12#.censys.v1.SearchCollectionsRequest\x1a$.censys.v1.SearchCollectionsResponse\"!\x82\xd3\xe4\x93\x02\x1b:\x01*\"\x16/v1/collections:search\x12\x9c\x01\n" +
+	"\x18RegisterCollectionSchema\x12*.censys.v1.RegisterCollectionSchemaRequest\x1a\x1b.censys.v1.CollectionSchema\"7\x82\xd3\xe4\x93\x021:\x01*\",/v1/organizations/{organization_uid}/schemas\x12\xa0\x01\n" +
+	"\x15ListCollectionSchemas\x12'.censys.v1.ListCollectionSchemasRequest\x1a(.censys.v1.ListCollectionSchemasResponse\"4\x82\xd3\xe4\x93\x02.\x12,/v1/organizations/{organization_uid}/schemas\x12\x97\x01\n" +
+	"\x16DeleteCollectionSchema\x12(.censys.v1.DeleteCollectionSchemaRequest\x1a\x16.google.protobuf.Empty\";\x82\xd3\xe4\x93\x025*3/v1/organizations/{organization_uid}/schemas/{type}\x12Y\n" +
+	"\tListTrash\x12\x1b.censys.v1.ListTrashRequest\x1a\x1c.censys.v1.ListTrashResponse\"\x11\x82\xd3\xe4\x93\x02\v\x12\t/v1/trash\x12v\n" +
+	"\x12UndeleteCollection\x12$.censys.v1.UndeleteCollectionRequest\x1a\x15.censys.v1.Collection\"#\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/v1/trash/{uid}:undelete\x12\xa0\x01\n" +
+	"\x16ListCollectionVersions\x12(.censys.v1.ListCollectionVersionsRequest\x1a).censys.v1.ListCollectionVersionsResponse\"1\x82\xd3\xe4\x93\x02+\x12)/v1/collections/{collection_uid}/versions\x12\x9a\x01\n" +
+	"\x14GetCollectionVersion\x12&.censys.v1.GetCollectionVersionRequest\x1a\x1c.censys.v1.CollectionVersion\"<\x82\xd3\xe4\x93\x026\x124/v1/collections/{collection_uid}/versions/{revision}\x12\xa6\x01\n" +
+	"\x18RestoreCollectionVersion\x12*.censys.v1.RestoreCollectionVersionRequest\x1a\x15.censys.v1.Collection\"G\x82\xd3\xe4\x93\x02A:\x01*\"</v1/collections/{collection_uid}/versions/{revision}:restore\x12\x8f\x01\n" +
+	"\x12TransferCollection\x12$.censys.v1.TransferCollectionRequest\x1a\x1d.censys.v1.CollectionTransfer\"4\x82\xd3\xe4\x93\x02.:\x01*\")/v1/collections/{collection_uid}:transfer\x12\x95\x01\n" +
+	"\x18AcceptCollectionTransfer\x12*.censys.v1.AcceptCollectionTransferRequest\x1a\x1d.censys.v1.CollectionTransfer\".\x82\xd3\xe4\x93\x02(:\x01*\"#/v1/transfers/{transfer_uid}:accept\x12\x98\x01\n" +
+	"\x19DeclineCollectionTransfer\x12+.censys.v1.DeclineCollectionTransferRequest\x1a\x1d.censys.v1.CollectionTransfer\"/\x82\xd3\xe4\x93\x02):\x01*\"$/v1/transfers/{transfer_uid}:decline\x12\x87\x01\n" +
+	"\x17ListCollectionTransfers\x12).censys.v1.ListCollectionTransfersRequest\x1a*.censys.v1.ListCollectionTransfersResponse\"\x15\x82\xd3\xe4\x93\x02\x0f\x12\r/v1/transfers\x12Z\n" +
+	"\rGetQuotaUsage\x12\x1f.censys.v1.GetQuotaUsageRequest\x1a\x15.censys.v1.QuotaUsage\"\x11\x82\xd3\xe4\x93\x02\v\x12\t/v1/quota\x12\xa2\x01\n" +
+	"\x19CreateWebhookSubscription\x12+.censys.v1.CreateWebhookSubscriptionRequest\x1a\x1e.censys.v1.WebhookSubscription\"8\x82\xd3\xe4\x93\x022:\x01*\"-/v1/organizations/{organization_uid}/webhooks\x12\xaa\x01\n" +
+	"\x18ListWebhookSubscriptions\x12*.censys.v1.ListWebhookSubscriptionsRequest\x1a+.censys.v1.ListWebhookSubscriptionsResponse\"5\x82\xd3\xe4\x93\x02/\x12-/v1/organizations/{organization_uid}/webhooks\x12|\n" +
+	"\x19DeleteWebhookSubscription\x12+.censys.v1.DeleteWebhookSubscriptionRequest\x1a\x16.google.protobuf.Empty\"\x1a\x82\xd3\xe4\x93\x02\x14*\x12/v1/webhooks/{uid}\x12\x9e\x01\n" +
+	"\x15ListWebhookDeliveries\x12'.censys.v1.ListWebhookDeliveriesRequest\x1a(.censys.v1.ListWebhookDeliveriesResponse\"2\x82\xd3\xe4\x93\x02,\x12*/v1/webhooks/{subscription_uid}/deliveries\x12\xa1\x01\n" +
+	"\x14RetryWebhookDelivery\x12&.censys.v1.RetryWebhookDeliveryRequest\x1a\x16.google.protobuf.Empty\"I\x82\xd3\xe4\x93\x02C:\x01*\">/v1/webhooks/{subscription_uid}/deliveries/{delivery_id}:retry\x12\x86\x01\n" +
+	"\x10CreateShareToken\x12\".censys.v1.CreateShareTokenRequest\x1a\x15.censys.v1.ShareToken\"7\x82\xd3\xe4\x93\x021:\x01*\",/v1/collections/{collection_uid}/shareTokens\x12}\n" +
+	"\x13GetSharedCollection\x12%.censys.v1.GetSharedCollectionRequest\x1a#.censys.v1.SharedCollectionResponse\"\x1a\x82\xd3\xe4\x93\x02\x14\x12\x12/v1/shared/{token}\x12\x89\x01\n" +
+	"\x15WatchSharedCollection\x12'.censys.v1.WatchSharedCollectionRequest\x1a#.censys.v1.SharedCollectionResponse\" \x82\xd3\xe4\x93\x02\x1a\x12\x18/v1/shared/{token}:watch0\x01\x12o\n" +
+	"\x10RevokeShareToken\x12\".censys.v1.RevokeShareTokenRequest\x1a\x16.google.protobuf.Empty\"\x1f\x82\xd3\xe4\x93\x02\x19*\x17/v1/shareTokens/{token}\x12q\n" +
+	"\x10UpdateShareToken\x12\".censys.v1.UpdateShareTokenRequest\x1a\x15.censys.v1.ShareToken\"\"\x82\xd3\xe4\x93\x02\x1c:\x01*2\x17/v1/shareTokens/{token}\x12{\n" +
+	"\x11SuspendShareToken\x12#.censys.v1.SuspendShareTokenRequest\x1a\x15.censys.v1.ShareToken\"*\x82\xd3\xe4\x93\x02$:\x01*\"\x1f/v1/shareTokens/{token}:suspend\x12x\n" +
+	"\x10ResumeShareToken\x12\".censys.v1.ResumeShareTokenRequest\x1a\x15.censys.v1.ShareToken\")\x82\xd3\xe4\x93\x02#:\x01*\"\x1e/v1/shareTokens/{token}:resumeB\x9c\x01\n" +
 	"\rcom.censys.v1B\fServiceProtoP\x01Z8github.com/ajscimone/censys-challenge/gen/proto;censysv1\xa2\x02\x03CXX\xaa\x02\tCensys.V1\xca\x02\tCensys\\V1\xe2\x02\x15Censys\\V1\\GPBMetadata\xea\x02\n" +
 	"Censys::V1b\x06proto3"
 
@@ -1148,72 +5645,260 @@ func file_proto_service_proto_rawDescGZIP() []byte {
 	return file_proto_service_proto_rawDescData
 }
 
-var file_proto_service_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_proto_service_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
+var file_proto_service_proto_enumTypes = make([]protoimpl.EnumInfo, 8)
+var file_proto_service_proto_msgTypes = make([]protoimpl.MessageInfo, 79)
 var file_proto_service_proto_goTypes = []any{
-	(AccessLevel)(0),                     // 0: censys.v1.AccessLevel
-	(*User)(nil),                         // 1: censys.v1.User
-	(*Organization)(nil),                 // 2: censys.v1.Organization
-	(*CreateUserRequest)(nil),            // 3: censys.v1.CreateUserRequest
-	(*CreateOrganizationRequest)(nil),    // 4: censys.v1.CreateOrganizationRequest
-	(*AddOrganizationMemberRequest)(nil), // 5: censys.v1.AddOrganizationMemberRequest
-	(*OrganizationMembership)(nil),       // 6: censys.v1.OrganizationMembership
-	(*LoginRequest)(nil),                 // 7: censys.v1.LoginRequest
-	(*LoginResponse)(nil),                // 8: censys.v1.LoginResponse
-	(*Collection)(nil),                   // 9: censys.v1.Collection
-	(*CreateCollectionRequest)(nil),      // 10: censys.v1.CreateCollectionRequest
-	(*GetCollectionRequest)(nil),         // 11: censys.v1.GetCollectionRequest
-	(*UpdateCollectionRequest)(nil),      // 12: censys.v1.UpdateCollectionRequest
-	(*DeleteCollectionRequest)(nil),      // 13: censys.v1.DeleteCollectionRequest
-	(*ShareToken)(nil),                   // 14: censys.v1.ShareToken
-	(*CreateShareTokenRequest)(nil),      // 15: censys.v1.CreateShareTokenRequest
-	(*GetSharedCollectionRequest)(nil),   // 16: censys.v1.GetSharedCollectionRequest
-	(*SharedCollectionResponse)(nil),     // 17: censys.v1.SharedCollectionResponse
-	(*RevokeShareTokenRequest)(nil),      // 18: censys.v1.RevokeShareTokenRequest
-	(*structpb.Struct)(nil),              // 19: google.protobuf.Struct
-	(*timestamppb.Timestamp)(nil),        // 20: google.protobuf.Timestamp
-	(*emptypb.Empty)(nil),                // 21: google.protobuf.Empty
+	(OrganizationRole)(0),                       // 0: censys.v1.OrganizationRole
+	(AccessLevel)(0),                            // 1: censys.v1.AccessLevel
+	(PatchType)(0),                              // 2: censys.v1.PatchType
+	(CollectionEventType)(0),                    // 3: censys.v1.CollectionEventType
+	(WebhookDeliveryStatus)(0),                  // 4: censys.v1.WebhookDeliveryStatus
+	(ImportMode)(0),                             // 5: censys.v1.ImportMode
+	(ExportFormat)(0),                           // 6: censys.v1.ExportFormat
+	(TransferStatus)(0),                         // 7: censys.v1.TransferStatus
+	(*User)(nil),                                // 8: censys.v1.User
+	(*Organization)(nil),                        // 9: censys.v1.Organization
+	(*CreateUserRequest)(nil),                   // 10: censys.v1.CreateUserRequest
+	(*CreateOrganizationRequest)(nil),           // 11: censys.v1.CreateOrganizationRequest
+	(*AddOrganizationMemberRequest)(nil),        // 12: censys.v1.AddOrganizationMemberRequest
+	(*OrganizationMembership)(nil),              // 13: censys.v1.OrganizationMembership
+	(*OrphanedCollections)(nil),                 // 14: censys.v1.OrphanedCollections
+	(*DeleteUserRequest)(nil),                   // 15: censys.v1.DeleteUserRequest
+	(*DeleteOrganizationRequest)(nil),           // 16: censys.v1.DeleteOrganizationRequest
+	(*ListQuarantinedCollectionsRequest)(nil),   // 17: censys.v1.ListQuarantinedCollectionsRequest
+	(*ListQuarantinedCollectionsResponse)(nil),  // 18: censys.v1.ListQuarantinedCollectionsResponse
+	(*ReassignCollectionRequest)(nil),           // 19: censys.v1.ReassignCollectionRequest
+	(*LoginRequest)(nil),                        // 20: censys.v1.LoginRequest
+	(*LoginResponse)(nil),                       // 21: censys.v1.LoginResponse
+	(*Collection)(nil),                          // 22: censys.v1.Collection
+	(*CreateCollectionRequest)(nil),             // 23: censys.v1.CreateCollectionRequest
+	(*GetCollectionRequest)(nil),                // 24: censys.v1.GetCollectionRequest
+	(*UpdateCollectionRequest)(nil),             // 25: censys.v1.UpdateCollectionRequest
+	(*PatchCollectionDataRequest)(nil),          // 26: censys.v1.PatchCollectionDataRequest
+	(*DeleteCollectionRequest)(nil),             // 27: censys.v1.DeleteCollectionRequest
+	(*CopyCollectionRequest)(nil),               // 28: censys.v1.CopyCollectionRequest
+	(*CollectionPayload)(nil),                   // 29: censys.v1.CollectionPayload
+	(*UploadCollectionDataHeader)(nil),          // 30: censys.v1.UploadCollectionDataHeader
+	(*UploadCollectionDataRequest)(nil),         // 31: censys.v1.UploadCollectionDataRequest
+	(*DownloadCollectionDataRequest)(nil),       // 32: censys.v1.DownloadCollectionDataRequest
+	(*DownloadCollectionDataResponse)(nil),      // 33: censys.v1.DownloadCollectionDataResponse
+	(*CollectionEvent)(nil),                     // 34: censys.v1.CollectionEvent
+	(*WatchCollectionRequest)(nil),              // 35: censys.v1.WatchCollectionRequest
+	(*WatchOrganizationCollectionsRequest)(nil), // 36: censys.v1.WatchOrganizationCollectionsRequest
+	(*WebhookSubscription)(nil),                 // 37: censys.v1.WebhookSubscription
+	(*CreateWebhookSubscriptionRequest)(nil),    // 38: censys.v1.CreateWebhookSubscriptionRequest
+	(*ListWebhookSubscriptionsRequest)(nil),     // 39: censys.v1.ListWebhookSubscriptionsRequest
+	(*ListWebhookSubscriptionsResponse)(nil),    // 40: censys.v1.ListWebhookSubscriptionsResponse
+	(*DeleteWebhookSubscriptionRequest)(nil),    // 41: censys.v1.DeleteWebhookSubscriptionRequest
+	(*WebhookDelivery)(nil),                     // 42: censys.v1.WebhookDelivery
+	(*ListWebhookDeliveriesRequest)(nil),        // 43: censys.v1.ListWebhookDeliveriesRequest
+	(*ListWebhookDeliveriesResponse)(nil),       // 44: censys.v1.ListWebhookDeliveriesResponse
+	(*RetryWebhookDeliveryRequest)(nil),         // 45: censys.v1.RetryWebhookDeliveryRequest
+	(*ImportCollectionsRequest)(nil),            // 46: censys.v1.ImportCollectionsRequest
+	(*ImportError)(nil),                         // 47: censys.v1.ImportError
+	(*ImportCollectionsResponse)(nil),           // 48: censys.v1.ImportCollectionsResponse
+	(*ExportCollectionsRequest)(nil),            // 49: censys.v1.ExportCollectionsRequest
+	(*ExportCollectionsResponse)(nil),           // 50: censys.v1.ExportCollectionsResponse
+	(*DataFilter)(nil),                          // 51: censys.v1.DataFilter
+	(*SearchCollectionsRequest)(nil),            // 52: censys.v1.SearchCollectionsRequest
+	(*SearchCollectionsResponse)(nil),           // 53: censys.v1.SearchCollectionsResponse
+	(*CollectionSchema)(nil),                    // 54: censys.v1.CollectionSchema
+	(*RegisterCollectionSchemaRequest)(nil),     // 55: censys.v1.RegisterCollectionSchemaRequest
+	(*ListCollectionSchemasRequest)(nil),        // 56: censys.v1.ListCollectionSchemasRequest
+	(*ListCollectionSchemasResponse)(nil),       // 57: censys.v1.ListCollectionSchemasResponse
+	(*DeleteCollectionSchemaRequest)(nil),       // 58: censys.v1.DeleteCollectionSchemaRequest
+	(*ListTrashRequest)(nil),                    // 59: censys.v1.ListTrashRequest
+	(*ListTrashResponse)(nil),                   // 60: censys.v1.ListTrashResponse
+	(*UndeleteCollectionRequest)(nil),           // 61: censys.v1.UndeleteCollectionRequest
+	(*CollectionVersion)(nil),                   // 62: censys.v1.CollectionVersion
+	(*ListCollectionVersionsRequest)(nil),       // 63: censys.v1.ListCollectionVersionsRequest
+	(*ListCollectionVersionsResponse)(nil),      // 64: censys.v1.ListCollectionVersionsResponse
+	(*GetCollectionVersionRequest)(nil),         // 65: censys.v1.GetCollectionVersionRequest
+	(*RestoreCollectionVersionRequest)(nil),     // 66: censys.v1.RestoreCollectionVersionRequest
+	(*CollectionTransfer)(nil),                  // 67: censys.v1.CollectionTransfer
+	(*TransferCollectionRequest)(nil),           // 68: censys.v1.TransferCollectionRequest
+	(*AcceptCollectionTransferRequest)(nil),     // 69: censys.v1.AcceptCollectionTransferRequest
+	(*DeclineCollectionTransferRequest)(nil),    // 70: censys.v1.DeclineCollectionTransferRequest
+	(*ListCollectionTransfersRequest)(nil),      // 71: censys.v1.ListCollectionTransfersRequest
+	(*ListCollectionTransfersResponse)(nil),     // 72: censys.v1.ListCollectionTransfersResponse
+	(*GetQuotaUsageRequest)(nil),                // 73: censys.v1.GetQuotaUsageRequest
+	(*QuotaUsage)(nil),                          // 74: censys.v1.QuotaUsage
+	(*ShareToken)(nil),                          // 75: censys.v1.ShareToken
+	(*CreateShareTokenRequest)(nil),             // 76: censys.v1.CreateShareTokenRequest
+	(*UpdateShareTokenRequest)(nil),             // 77: censys.v1.UpdateShareTokenRequest
+	(*GetSharedCollectionRequest)(nil),          // 78: censys.v1.GetSharedCollectionRequest
+	(*SharedCollectionResponse)(nil),            // 79: censys.v1.SharedCollectionResponse
+	(*WatchSharedCollectionRequest)(nil),        // 80: censys.v1.WatchSharedCollectionRequest
+	(*RevokeShareTokenRequest)(nil),             // 81: censys.v1.RevokeShareTokenRequest
+	(*SuspendShareTokenRequest)(nil),            // 82: censys.v1.SuspendShareTokenRequest
+	(*ResumeShareTokenRequest)(nil),             // 83: censys.v1.ResumeShareTokenRequest
+	nil,                                         // 84: censys.v1.Collection.LabelsEntry
+	nil,                                         // 85: censys.v1.CreateCollectionRequest.LabelsEntry
+	nil,                                         // 86: censys.v1.UpdateCollectionRequest.LabelsEntry
+	(*structpb.Struct)(nil),                     // 87: google.protobuf.Struct
+	(*timestamppb.Timestamp)(nil),               // 88: google.protobuf.Timestamp
+	(*fieldmaskpb.FieldMask)(nil),               // 89: google.protobuf.FieldMask
+	(*structpb.Value)(nil),                      // 90: google.protobuf.Value
+	(*emptypb.Empty)(nil),                       // 91: google.protobuf.Empty
 }
 var file_proto_service_proto_depIdxs = []int32{
-	1,  // 0: censys.v1.OrganizationMembership.user:type_name -> censys.v1.User
-	2,  // 1: censys.v1.OrganizationMembership.organization:type_name -> censys.v1.Organization
-	19, // 2: censys.v1.Collection.data:type_name -> google.protobuf.Struct
-	0,  // 3: censys.v1.Collection.access_level:type_name -> censys.v1.AccessLevel
-	20, // 4: censys.v1.Collection.created_at:type_name -> google.protobuf.Timestamp
-	20, // 5: censys.v1.Collection.updated_at:type_name -> google.protobuf.Timestamp
-	19, // 6: censys.v1.CreateCollectionRequest.data:type_name -> google.protobuf.Struct
-	0,  // 7: censys.v1.CreateCollectionRequest.access_level:type_name -> censys.v1.AccessLevel
-	19, // 8: censys.v1.UpdateCollectionRequest.data:type_name -> google.protobuf.Struct
-	0,  // 9: censys.v1.UpdateCollectionRequest.access_level:type_name -> censys.v1.AccessLevel
-	20, // 10: censys.v1.ShareToken.created_at:type_name -> google.protobuf.Timestamp
-	9,  // 11: censys.v1.SharedCollectionResponse.collection:type_name -> censys.v1.Collection
-	3,  // 12: censys.v1.AdminService.CreateUser:input_type -> censys.v1.CreateUserRequest
-	4,  // 13: censys.v1.AdminService.CreateOrganization:input_type -> censys.v1.CreateOrganizationRequest
-	5,  // 14: censys.v1.AdminService.AddOrganizationMember:input_type -> censys.v1.AddOrganizationMemberRequest
-	7,  // 15: censys.v1.CollectionService.Login:input_type -> censys.v1.LoginRequest
-	10, // 16: censys.v1.CollectionService.CreateCollection:input_type -> censys.v1.CreateCollectionRequest
-	11, // 17: censys.v1.CollectionService.GetCollection:input_type -> censys.v1.GetCollectionRequest
-	12, // 18: censys.v1.CollectionService.UpdateCollection:input_type -> censys.v1.UpdateCollectionRequest
-	13, // 19: censys.v1.CollectionService.DeleteCollection:input_type -> censys.v1.DeleteCollectionRequest
-	15, // 20: censys.v1.CollectionService.CreateShareToken:input_type -> censys.v1.CreateShareTokenRequest
-	16, // 21: censys.v1.CollectionService.GetSharedCollection:input_type -> censys.v1.GetSharedCollectionRequest
-	18, // 22: censys.v1.CollectionService.RevokeShareToken:input_type -> censys.v1.RevokeShareTokenRequest
-	1,  // 23: censys.v1.AdminService.CreateUser:output_type -> censys.v1.User
-	2,  // 24: censys.v1.AdminService.CreateOrganization:output_type -> censys.v1.Organization
-	6,  // 25: censys.v1.AdminService.AddOrganizationMember:output_type -> censys.v1.OrganizationMembership
-	8,  // 26: censys.v1.CollectionService.Login:output_type -> censys.v1.LoginResponse
-	9,  // 27: censys.v1.CollectionService.CreateCollection:output_type -> censys.v1.Collection
-	9,  // 28: censys.v1.CollectionService.GetCollection:output_type -> censys.v1.Collection
-	9,  // 29: censys.v1.CollectionService.UpdateCollection:output_type -> censys.v1.Collection
-	21, // 30: censys.v1.CollectionService.DeleteCollection:output_type -> google.protobuf.Empty
-	14, // 31: censys.v1.CollectionService.CreateShareToken:output_type -> censys.v1.ShareToken
-	17, // 32: censys.v1.CollectionService.GetSharedCollection:output_type -> censys.v1.SharedCollectionResponse
-	21, // 33: censys.v1.CollectionService.RevokeShareToken:output_type -> google.protobuf.Empty
-	23, // [23:34] is the sub-list for method output_type
-	12, // [12:23] is the sub-list for method input_type
-	12, // [12:12] is the sub-list for extension type_name
-	12, // [12:12] is the sub-list for extension extendee
-	0,  // [0:12] is the sub-list for field type_name
+	0,   // 0: censys.v1.AddOrganizationMemberRequest.role:type_name -> censys.v1.OrganizationRole
+	8,   // 1: censys.v1.OrganizationMembership.user:type_name -> censys.v1.User
+	9,   // 2: censys.v1.OrganizationMembership.organization:type_name -> censys.v1.Organization
+	0,   // 3: censys.v1.OrganizationMembership.role:type_name -> censys.v1.OrganizationRole
+	22,  // 4: censys.v1.ListQuarantinedCollectionsResponse.collections:type_name -> censys.v1.Collection
+	87,  // 5: censys.v1.Collection.data:type_name -> google.protobuf.Struct
+	1,   // 6: censys.v1.Collection.access_level:type_name -> censys.v1.AccessLevel
+	88,  // 7: censys.v1.Collection.created_at:type_name -> google.protobuf.Timestamp
+	88,  // 8: censys.v1.Collection.updated_at:type_name -> google.protobuf.Timestamp
+	88,  // 9: censys.v1.Collection.deleted_at:type_name -> google.protobuf.Timestamp
+	84,  // 10: censys.v1.Collection.labels:type_name -> censys.v1.Collection.LabelsEntry
+	88,  // 11: censys.v1.Collection.quarantined_at:type_name -> google.protobuf.Timestamp
+	87,  // 12: censys.v1.CreateCollectionRequest.data:type_name -> google.protobuf.Struct
+	1,   // 13: censys.v1.CreateCollectionRequest.access_level:type_name -> censys.v1.AccessLevel
+	85,  // 14: censys.v1.CreateCollectionRequest.labels:type_name -> censys.v1.CreateCollectionRequest.LabelsEntry
+	87,  // 15: censys.v1.UpdateCollectionRequest.data:type_name -> google.protobuf.Struct
+	1,   // 16: censys.v1.UpdateCollectionRequest.access_level:type_name -> censys.v1.AccessLevel
+	89,  // 17: censys.v1.UpdateCollectionRequest.update_mask:type_name -> google.protobuf.FieldMask
+	86,  // 18: censys.v1.UpdateCollectionRequest.labels:type_name -> censys.v1.UpdateCollectionRequest.LabelsEntry
+	2,   // 19: censys.v1.PatchCollectionDataRequest.patch_type:type_name -> censys.v1.PatchType
+	88,  // 20: censys.v1.CollectionPayload.updated_at:type_name -> google.protobuf.Timestamp
+	30,  // 21: censys.v1.UploadCollectionDataRequest.header:type_name -> censys.v1.UploadCollectionDataHeader
+	29,  // 22: censys.v1.DownloadCollectionDataResponse.payload:type_name -> censys.v1.CollectionPayload
+	3,   // 23: censys.v1.CollectionEvent.type:type_name -> censys.v1.CollectionEventType
+	22,  // 24: censys.v1.CollectionEvent.collection:type_name -> censys.v1.Collection
+	88,  // 25: censys.v1.WebhookSubscription.created_at:type_name -> google.protobuf.Timestamp
+	37,  // 26: censys.v1.ListWebhookSubscriptionsResponse.subscriptions:type_name -> censys.v1.WebhookSubscription
+	4,   // 27: censys.v1.WebhookDelivery.status:type_name -> censys.v1.WebhookDeliveryStatus
+	88,  // 28: censys.v1.WebhookDelivery.next_attempt_at:type_name -> google.protobuf.Timestamp
+	88,  // 29: censys.v1.WebhookDelivery.delivered_at:type_name -> google.protobuf.Timestamp
+	88,  // 30: censys.v1.WebhookDelivery.created_at:type_name -> google.protobuf.Timestamp
+	4,   // 31: censys.v1.ListWebhookDeliveriesRequest.status:type_name -> censys.v1.WebhookDeliveryStatus
+	42,  // 32: censys.v1.ListWebhookDeliveriesResponse.deliveries:type_name -> censys.v1.WebhookDelivery
+	5,   // 33: censys.v1.ImportCollectionsRequest.mode:type_name -> censys.v1.ImportMode
+	23,  // 34: censys.v1.ImportCollectionsRequest.collection:type_name -> censys.v1.CreateCollectionRequest
+	47,  // 35: censys.v1.ImportCollectionsResponse.errors:type_name -> censys.v1.ImportError
+	6,   // 36: censys.v1.ExportCollectionsRequest.format:type_name -> censys.v1.ExportFormat
+	22,  // 37: censys.v1.ExportCollectionsResponse.collection:type_name -> censys.v1.Collection
+	90,  // 38: censys.v1.DataFilter.value:type_name -> google.protobuf.Value
+	51,  // 39: censys.v1.SearchCollectionsRequest.filters:type_name -> censys.v1.DataFilter
+	22,  // 40: censys.v1.SearchCollectionsResponse.collections:type_name -> censys.v1.Collection
+	87,  // 41: censys.v1.CollectionSchema.schema:type_name -> google.protobuf.Struct
+	88,  // 42: censys.v1.CollectionSchema.created_at:type_name -> google.protobuf.Timestamp
+	88,  // 43: censys.v1.CollectionSchema.updated_at:type_name -> google.protobuf.Timestamp
+	87,  // 44: censys.v1.RegisterCollectionSchemaRequest.schema:type_name -> google.protobuf.Struct
+	54,  // 45: censys.v1.ListCollectionSchemasResponse.schemas:type_name -> censys.v1.CollectionSchema
+	22,  // 46: censys.v1.ListTrashResponse.collections:type_name -> censys.v1.Collection
+	87,  // 47: censys.v1.CollectionVersion.data:type_name -> google.protobuf.Struct
+	88,  // 48: censys.v1.CollectionVersion.created_at:type_name -> google.protobuf.Timestamp
+	62,  // 49: censys.v1.ListCollectionVersionsResponse.versions:type_name -> censys.v1.CollectionVersion
+	7,   // 50: censys.v1.CollectionTransfer.status:type_name -> censys.v1.TransferStatus
+	88,  // 51: censys.v1.CollectionTransfer.created_at:type_name -> google.protobuf.Timestamp
+	88,  // 52: censys.v1.CollectionTransfer.resolved_at:type_name -> google.protobuf.Timestamp
+	67,  // 53: censys.v1.ListCollectionTransfersResponse.transfers:type_name -> censys.v1.CollectionTransfer
+	88,  // 54: censys.v1.ShareToken.created_at:type_name -> google.protobuf.Timestamp
+	88,  // 55: censys.v1.ShareToken.suspended_at:type_name -> google.protobuf.Timestamp
+	88,  // 56: censys.v1.ShareToken.expires_at:type_name -> google.protobuf.Timestamp
+	22,  // 57: censys.v1.SharedCollectionResponse.collection:type_name -> censys.v1.Collection
+	10,  // 58: censys.v1.AdminService.CreateUser:input_type -> censys.v1.CreateUserRequest
+	11,  // 59: censys.v1.AdminService.CreateOrganization:input_type -> censys.v1.CreateOrganizationRequest
+	12,  // 60: censys.v1.AdminService.AddOrganizationMember:input_type -> censys.v1.AddOrganizationMemberRequest
+	77,  // 61: censys.v1.AdminService.UpdateShareToken:input_type -> censys.v1.UpdateShareTokenRequest
+	15,  // 62: censys.v1.AdminService.DeleteUser:input_type -> censys.v1.DeleteUserRequest
+	16,  // 63: censys.v1.AdminService.DeleteOrganization:input_type -> censys.v1.DeleteOrganizationRequest
+	17,  // 64: censys.v1.AdminService.ListQuarantinedCollections:input_type -> censys.v1.ListQuarantinedCollectionsRequest
+	19,  // 65: censys.v1.AdminService.ReassignCollection:input_type -> censys.v1.ReassignCollectionRequest
+	20,  // 66: censys.v1.CollectionService.Login:input_type -> censys.v1.LoginRequest
+	23,  // 67: censys.v1.CollectionService.CreateCollection:input_type -> censys.v1.CreateCollectionRequest
+	24,  // 68: censys.v1.CollectionService.GetCollection:input_type -> censys.v1.GetCollectionRequest
+	25,  // 69: censys.v1.CollectionService.UpdateCollection:input_type -> censys.v1.UpdateCollectionRequest
+	26,  // 70: censys.v1.CollectionService.PatchCollectionData:input_type -> censys.v1.PatchCollectionDataRequest
+	27,  // 71: censys.v1.CollectionService.DeleteCollection:input_type -> censys.v1.DeleteCollectionRequest
+	28,  // 72: censys.v1.CollectionService.CopyCollection:input_type -> censys.v1.CopyCollectionRequest
+	31,  // 73: censys.v1.CollectionService.UploadCollectionData:input_type -> censys.v1.UploadCollectionDataRequest
+	32,  // 74: censys.v1.CollectionService.DownloadCollectionData:input_type -> censys.v1.DownloadCollectionDataRequest
+	35,  // 75: censys.v1.CollectionService.WatchCollection:input_type -> censys.v1.WatchCollectionRequest
+	36,  // 76: censys.v1.CollectionService.WatchOrganizationCollections:input_type -> censys.v1.WatchOrganizationCollectionsRequest
+	46,  // 77: censys.v1.CollectionService.ImportCollections:input_type -> censys.v1.ImportCollectionsRequest
+	49,  // 78: censys.v1.CollectionService.ExportCollections:input_type -> censys.v1.ExportCollectionsRequest
+	52,  // 79: censys.v1.CollectionService.SearchCollections:input_type -> censys.v1.SearchCollectionsRequest
+	55,  // 80: censys.v1.CollectionService.RegisterCollectionSchema:input_type -> censys.v1.RegisterCollectionSchemaRequest
+	56,  // 81: censys.v1.CollectionService.ListCollectionSchemas:input_type -> censys.v1.ListCollectionSchemasRequest
+	58,  // 82: censys.v1.CollectionService.DeleteCollectionSchema:input_type -> censys.v1.DeleteCollectionSchemaRequest
+	59,  // 83: censys.v1.CollectionService.ListTrash:input_type -> censys.v1.ListTrashRequest
+	61,  // 84: censys.v1.CollectionService.UndeleteCollection:input_type -> censys.v1.UndeleteCollectionRequest
+	63,  // 85: censys.v1.CollectionService.ListCollectionVersions:input_type -> censys.v1.ListCollectionVersionsRequest
+	65,  // 86: censys.v1.CollectionService.GetCollectionVersion:input_type -> censys.v1.GetCollectionVersionRequest
+	66,  // 87: censys.v1.CollectionService.RestoreCollectionVersion:input_type -> censys.v1.RestoreCollectionVersionRequest
+	68,  // 88: censys.v1.CollectionService.TransferCollection:input_type -> censys.v1.TransferCollectionRequest
+	69,  // 89: censys.v1.CollectionService.AcceptCollectionTransfer:input_type -> censys.v1.AcceptCollectionTransferRequest
+	70,  // 90: censys.v1.CollectionService.DeclineCollectionTransfer:input_type -> censys.v1.DeclineCollectionTransferRequest
+	71,  // 91: censys.v1.CollectionService.ListCollectionTransfers:input_type -> censys.v1.ListCollectionTransfersRequest
+	73,  // 92: censys.v1.CollectionService.GetQuotaUsage:input_type -> censys.v1.GetQuotaUsageRequest
+	38,  // 93: censys.v1.CollectionService.CreateWebhookSubscription:input_type -> censys.v1.CreateWebhookSubscriptionRequest
+	39,  // 94: censys.v1.CollectionService.ListWebhookSubscriptions:input_type -> censys.v1.ListWebhookSubscriptionsRequest
+	41,  // 95: censys.v1.CollectionService.DeleteWebhookSubscription:input_type -> censys.v1.DeleteWebhookSubscriptionRequest
+	43,  // 96: censys.v1.CollectionService.ListWebhookDeliveries:input_type -> censys.v1.ListWebhookDeliveriesRequest
+	45,  // 97: censys.v1.CollectionService.RetryWebhookDelivery:input_type -> censys.v1.RetryWebhookDeliveryRequest
+	76,  // 98: censys.v1.CollectionService.CreateShareToken:input_type -> censys.v1.CreateShareTokenRequest
+	78,  // 99: censys.v1.CollectionService.GetSharedCollection:input_type -> censys.v1.GetSharedCollectionRequest
+	80,  // 100: censys.v1.CollectionService.WatchSharedCollection:input_type -> censys.v1.WatchSharedCollectionRequest
+	81,  // 101: censys.v1.CollectionService.RevokeShareToken:input_type -> censys.v1.RevokeShareTokenRequest
+	77,  // 102: censys.v1.CollectionService.UpdateShareToken:input_type -> censys.v1.UpdateShareTokenRequest
+	82,  // 103: censys.v1.CollectionService.SuspendShareToken:input_type -> censys.v1.SuspendShareTokenRequest
+	83,  // 104: censys.v1.CollectionService.ResumeShareToken:input_type -> censys.v1.ResumeShareTokenRequest
+	8,   // 105: censys.v1.AdminService.CreateUser:output_type -> censys.v1.User
+	9,   // 106: censys.v1.AdminService.CreateOrganization:output_type -> censys.v1.Organization
+	13,  // 107: censys.v1.AdminService.AddOrganizationMember:output_type -> censys.v1.OrganizationMembership
+	75,  // 108: censys.v1.AdminService.UpdateShareToken:output_type -> censys.v1.ShareToken
+	14,  // 109: censys.v1.AdminService.DeleteUser:output_type -> censys.v1.OrphanedCollections
+	14,  // 110: censys.v1.AdminService.DeleteOrganization:output_type -> censys.v1.OrphanedCollections
+	18,  // 111: censys.v1.AdminService.ListQuarantinedCollections:output_type -> censys.v1.ListQuarantinedCollectionsResponse
+	22,  // 112: censys.v1.AdminService.ReassignCollection:output_type -> censys.v1.Collection
+	21,  // 113: censys.v1.CollectionService.Login:output_type -> censys.v1.LoginResponse
+	22,  // 114: censys.v1.CollectionService.CreateCollection:output_type -> censys.v1.Collection
+	22,  // 115: censys.v1.CollectionService.GetCollection:output_type -> censys.v1.Collection
+	22,  // 116: censys.v1.CollectionService.UpdateCollection:output_type -> censys.v1.Collection
+	22,  // 117: censys.v1.CollectionService.PatchCollectionData:output_type -> censys.v1.Collection
+	91,  // 118: censys.v1.CollectionService.DeleteCollection:output_type -> google.protobuf.Empty
+	22,  // 119: censys.v1.CollectionService.CopyCollection:output_type -> censys.v1.Collection
+	29,  // 120: censys.v1.CollectionService.UploadCollectionData:output_type -> censys.v1.CollectionPayload
+	33,  // 121: censys.v1.CollectionService.DownloadCollectionData:output_type -> censys.v1.DownloadCollectionDataResponse
+	34,  // 122: censys.v1.CollectionService.WatchCollection:output_type -> censys.v1.CollectionEvent
+	34,  // 123: censys.v1.CollectionService.WatchOrganizationCollections:output_type -> censys.v1.CollectionEvent
+	48,  // 124: censys.v1.CollectionService.ImportCollections:output_type -> censys.v1.ImportCollectionsResponse
+	50,  // 125: censys.v1.CollectionService.ExportCollections:output_type -> censys.v1.ExportCollectionsResponse
+	53,  // 126: censys.v1.CollectionService.SearchCollections:output_type -> censys.v1.SearchCollectionsResponse
+	54,  // 127: censys.v1.CollectionService.RegisterCollectionSchema:output_type -> censys.v1.CollectionSchema
+	57,  // 128: censys.v1.CollectionService.ListCollectionSchemas:output_type -> censys.v1.ListCollectionSchemasResponse
+	91,  // 129: censys.v1.CollectionService.DeleteCollectionSchema:output_type -> google.protobuf.Empty
+	60,  // 130: censys.v1.CollectionService.ListTrash:output_type -> censys.v1.ListTrashResponse
+	22,  // 131: censys.v1.CollectionService.UndeleteCollection:output_type -> censys.v1.Collection
+	64,  // 132: censys.v1.CollectionService.ListCollectionVersions:output_type -> censys.v1.ListCollectionVersionsResponse
+	62,  // 133: censys.v1.CollectionService.GetCollectionVersion:output_type -> censys.v1.CollectionVersion
+	22,  // 134: censys.v1.CollectionService.RestoreCollectionVersion:output_type -> censys.v1.Collection
+	67,  // 135: censys.v1.CollectionService.TransferCollection:output_type -> censys.v1.CollectionTransfer
+	67,  // 136: censys.v1.CollectionService.AcceptCollectionTransfer:output_type -> censys.v1.CollectionTransfer
+	67,  // 137: censys.v1.CollectionService.DeclineCollectionTransfer:output_type -> censys.v1.CollectionTransfer
+	72,  // 138: censys.v1.CollectionService.ListCollectionTransfers:output_type -> censys.v1.ListCollectionTransfersResponse
+	74,  // 139: censys.v1.CollectionService.GetQuotaUsage:output_type -> censys.v1.QuotaUsage
+	37,  // 140: censys.v1.CollectionService.CreateWebhookSubscription:output_type -> censys.v1.WebhookSubscription
+	40,  // 141: censys.v1.CollectionService.ListWebhookSubscriptions:output_type -> censys.v1.ListWebhookSubscriptionsResponse
+	91,  // 142: censys.v1.CollectionService.DeleteWebhookSubscription:output_type -> google.protobuf.Empty
+	44,  // 143: censys.v1.CollectionService.ListWebhookDeliveries:output_type -> censys.v1.ListWebhookDeliveriesResponse
+	91,  // 144: censys.v1.CollectionService.RetryWebhookDelivery:output_type -> google.protobuf.Empty
+	75,  // 145: censys.v1.CollectionService.CreateShareToken:output_type -> censys.v1.ShareToken
+	79,  // 146: censys.v1.CollectionService.GetSharedCollection:output_type -> censys.v1.SharedCollectionResponse
+	79,  // 147: censys.v1.CollectionService.WatchSharedCollection:output_type -> censys.v1.SharedCollectionResponse
+	91,  // 148: censys.v1.CollectionService.RevokeShareToken:output_type -> google.protobuf.Empty
+	75,  // 149: censys.v1.CollectionService.UpdateShareToken:output_type -> censys.v1.ShareToken
+	75,  // 150: censys.v1.CollectionService.SuspendShareToken:output_type -> censys.v1.ShareToken
+	75,  // 151: censys.v1.CollectionService.ResumeShareToken:output_type -> censys.v1.ShareToken
+	105, // [105:152] is the sub-list for method output_type
+	58,  // [58:105] is the sub-list for method input_type
+	58,  // [58:58] is the sub-list for extension type_name
+	58,  // [58:58] is the sub-list for extension extendee
+	0,   // [0:58] is the sub-list for field type_name
 }
 
 func init() { file_proto_service_proto_init() }
@@ -1221,13 +5906,25 @@ func file_proto_service_proto_init() {
 	if File_proto_service_proto != nil {
 		return
 	}
+	file_proto_service_proto_msgTypes[23].OneofWrappers = []any{
+		(*UploadCollectionDataRequest_Header)(nil),
+		(*UploadCollectionDataRequest_Chunk)(nil),
+	}
+	file_proto_service_proto_msgTypes[38].OneofWrappers = []any{
+		(*ImportCollectionsRequest_Collection)(nil),
+		(*ImportCollectionsRequest_Ndjson)(nil),
+	}
+	file_proto_service_proto_msgTypes[42].OneofWrappers = []any{
+		(*ExportCollectionsResponse_Collection)(nil),
+		(*ExportCollectionsResponse_Ndjson)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_service_proto_rawDesc), len(file_proto_service_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   18,
+			NumEnums:      8,
+			NumMessages:   79,
 			NumExtensions: 0,
 			NumServices:   2,
 		},
@@ -20,9 +20,14 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	AdminService_CreateUser_FullMethodName            = "/censys.v1.AdminService/CreateUser"
-	AdminService_CreateOrganization_FullMethodName    = "/censys.v1.AdminService/CreateOrganization"
-	AdminService_AddOrganizationMember_FullMethodName = "/censys.v1.AdminService/AddOrganizationMember"
+	AdminService_CreateUser_FullMethodName                 = "/censys.v1.AdminService/CreateUser"
+	AdminService_CreateOrganization_FullMethodName         = "/censys.v1.AdminService/CreateOrganization"
+	AdminService_AddOrganizationMember_FullMethodName      = "/censys.v1.AdminService/AddOrganizationMember"
+	AdminService_UpdateShareToken_FullMethodName           = "/censys.v1.AdminService/UpdateShareToken"
+	AdminService_DeleteUser_FullMethodName                 = "/censys.v1.AdminService/DeleteUser"
+	AdminService_DeleteOrganization_FullMethodName         = "/censys.v1.AdminService/DeleteOrganization"
+	AdminService_ListQuarantinedCollections_FullMethodName = "/censys.v1.AdminService/ListQuarantinedCollections"
+	AdminService_ReassignCollection_FullMethodName         = "/censys.v1.AdminService/ReassignCollection"
 )
 
 // AdminServiceClient is the client API for AdminService service.
@@ -32,6 +37,11 @@ type AdminServiceClient interface {
 	CreateUser(ctx context.Context, in *CreateUserRequest, opts ...grpc.CallOption) (*User, error)
 	CreateOrganization(ctx context.Context, in *CreateOrganizationRequest, opts ...grpc.CallOption) (*Organization, error)
 	AddOrganizationMember(ctx context.Context, in *AddOrganizationMemberRequest, opts ...grpc.CallOption) (*OrganizationMembership, error)
+	UpdateShareToken(ctx context.Context, in *UpdateShareTokenRequest, opts ...grpc.CallOption) (*ShareToken, error)
+	DeleteUser(ctx context.Context, in *DeleteUserRequest, opts ...grpc.CallOption) (*OrphanedCollections, error)
+	DeleteOrganization(ctx context.Context, in *DeleteOrganizationRequest, opts ...grpc.CallOption) (*OrphanedCollections, error)
+	ListQuarantinedCollections(ctx context.Context, in *ListQuarantinedCollectionsRequest, opts ...grpc.CallOption) (*ListQuarantinedCollectionsResponse, error)
+	ReassignCollection(ctx context.Context, in *ReassignCollectionRequest, opts ...grpc.CallOption) (*Collection, error)
 }
 
 type adminServiceClient struct {
@@ -72,6 +82,56 @@ func (c *adminServiceClient) AddOrganizationMember(ctx context.Context, in *AddO
 	return out, nil
 }
 
+func (c *adminServiceClient) UpdateShareToken(ctx context.Context, in *UpdateShareTokenRequest, opts ...grpc.CallOption) (*ShareToken, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ShareToken)
+	err := c.cc.Invoke(ctx, AdminService_UpdateShareToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) DeleteUser(ctx context.Context, in *DeleteUserRequest, opts ...grpc.CallOption) (*OrphanedCollections, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OrphanedCollections)
+	err := c.cc.Invoke(ctx, AdminService_DeleteUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) DeleteOrganization(ctx context.Context, in *DeleteOrganizationRequest, opts ...grpc.CallOption) (*OrphanedCollections, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OrphanedCollections)
+	err := c.cc.Invoke(ctx, AdminService_DeleteOrganization_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ListQuarantinedCollections(ctx context.Context, in *ListQuarantinedCollectionsRequest, opts ...grpc.CallOption) (*ListQuarantinedCollectionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListQuarantinedCollectionsResponse)
+	err := c.cc.Invoke(ctx, AdminService_ListQuarantinedCollections_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ReassignCollection(ctx context.Context, in *ReassignCollectionRequest, opts ...grpc.CallOption) (*Collection, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Collection)
+	err := c.cc.Invoke(ctx, AdminService_ReassignCollection_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AdminServiceServer is the server API for AdminService service.
 // All implementations must embed UnimplementedAdminServiceServer
 // for forward compatibility.
@@ -79,6 +139,11 @@ type AdminServiceServer interface {
 	CreateUser(context.Context, *CreateUserRequest) (*User, error)
 	CreateOrganization(context.Context, *CreateOrganizationRequest) (*Organization, error)
 	AddOrganizationMember(context.Context, *AddOrganizationMemberRequest) (*OrganizationMembership, error)
+	UpdateShareToken(context.Context, *UpdateShareTokenRequest) (*ShareToken, error)
+	DeleteUser(context.Context, *DeleteUserRequest) (*OrphanedCollections, error)
+	DeleteOrganization(context.Context, *DeleteOrganizationRequest) (*OrphanedCollections, error)
+	ListQuarantinedCollections(context.Context, *ListQuarantinedCollectionsRequest) (*ListQuarantinedCollectionsResponse, error)
+	ReassignCollection(context.Context, *ReassignCollectionRequest) (*Collection, error)
 	mustEmbedUnimplementedAdminServiceServer()
 }
 
@@ -98,6 +163,21 @@ func (UnimplementedAdminServiceServer) CreateOrganization(context.Context, *Crea
 func (UnimplementedAdminServiceServer) AddOrganizationMember(context.Context, *AddOrganizationMemberRequest) (*OrganizationMembership, error) {
 	return nil, status.Error(codes.Unimplemented, "method AddOrganizationMember not implemented")
 }
+func (UnimplementedAdminServiceServer) UpdateShareToken(context.Context, *UpdateShareTokenRequest) (*ShareToken, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateShareToken not implemented")
+}
+func (UnimplementedAdminServiceServer) DeleteUser(context.Context, *DeleteUserRequest) (*OrphanedCollections, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteUser not implemented")
+}
+func (UnimplementedAdminServiceServer) DeleteOrganization(context.Context, *DeleteOrganizationRequest) (*OrphanedCollections, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteOrganization not implemented")
+}
+func (UnimplementedAdminServiceServer) ListQuarantinedCollections(context.Context, *ListQuarantinedCollectionsRequest) (*ListQuarantinedCollectionsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListQuarantinedCollections not implemented")
+}
+func (UnimplementedAdminServiceServer) ReassignCollection(context.Context, *ReassignCollectionRequest) (*Collection, error) {
+	return nil, status.Error(codes.Unimplemented, "method ReassignCollection not implemented")
+}
 func (UnimplementedAdminServiceServer) mustEmbedUnimplementedAdminServiceServer() {}
 func (UnimplementedAdminServiceServer) testEmbeddedByValue()                      {}
 
@@ -173,6 +253,96 @@ func _AdminService_AddOrganizationMember_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AdminService_UpdateShareToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateShareTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).UpdateShareToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_UpdateShareToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).UpdateShareToken(ctx, req.(*UpdateShareTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_DeleteUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).DeleteUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_DeleteUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).DeleteUser(ctx, req.(*DeleteUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_DeleteOrganization_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteOrganizationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).DeleteOrganization(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_DeleteOrganization_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).DeleteOrganization(ctx, req.(*DeleteOrganizationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ListQuarantinedCollections_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListQuarantinedCollectionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListQuarantinedCollections(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ListQuarantinedCollections_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListQuarantinedCollections(ctx, req.(*ListQuarantinedCollectionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ReassignCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReassignCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ReassignCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ReassignCollection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ReassignCollection(ctx, req.(*ReassignCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // AdminService_ServiceDesc is the grpc.ServiceDesc for AdminService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -192,34 +362,118 @@ var AdminService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AddOrganizationMember",
 			Handler:    _AdminService_AddOrganizationMember_Handler,
 		},
+		{
+			MethodName: "UpdateShareToken",
+			Handler:    _AdminService_UpdateShareToken_Handler,
+		},
+		{
+			MethodName: "DeleteUser",
+			Handler:    _AdminService_DeleteUser_Handler,
+		},
+		{
+			MethodName: "DeleteOrganization",
+			Handler:    _AdminService_DeleteOrganization_Handler,
+		},
+		{
+			MethodName: "ListQuarantinedCollections",
+			Handler:    _AdminService_ListQuarantinedCollections_Handler,
+		},
+		{
+			MethodName: "ReassignCollection",
+			Handler:    _AdminService_ReassignCollection_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/service.proto",
 }
 
 const (
-	CollectionService_Login_FullMethodName               = "/censys.v1.CollectionService/Login"
-	CollectionService_CreateCollection_FullMethodName    = "/censys.v1.CollectionService/CreateCollection"
-	CollectionService_GetCollection_FullMethodName       = "/censys.v1.CollectionService/GetCollection"
-	CollectionService_UpdateCollection_FullMethodName    = "/censys.v1.CollectionService/UpdateCollection"
-	CollectionService_DeleteCollection_FullMethodName    = "/censys.v1.CollectionService/DeleteCollection"
-	CollectionService_CreateShareToken_FullMethodName    = "/censys.v1.CollectionService/CreateShareToken"
-	CollectionService_GetSharedCollection_FullMethodName = "/censys.v1.CollectionService/GetSharedCollection"
-	CollectionService_RevokeShareToken_FullMethodName    = "/censys.v1.CollectionService/RevokeShareToken"
+	CollectionService_Login_FullMethodName                        = "/censys.v1.CollectionService/Login"
+	CollectionService_CreateCollection_FullMethodName             = "/censys.v1.CollectionService/CreateCollection"
+	CollectionService_GetCollection_FullMethodName                = "/censys.v1.CollectionService/GetCollection"
+	CollectionService_UpdateCollection_FullMethodName             = "/censys.v1.CollectionService/UpdateCollection"
+	CollectionService_PatchCollectionData_FullMethodName          = "/censys.v1.CollectionService/PatchCollectionData"
+	CollectionService_DeleteCollection_FullMethodName             = "/censys.v1.CollectionService/DeleteCollection"
+	CollectionService_CopyCollection_FullMethodName               = "/censys.v1.CollectionService/CopyCollection"
+	CollectionService_UploadCollectionData_FullMethodName         = "/censys.v1.CollectionService/UploadCollectionData"
+	CollectionService_DownloadCollectionData_FullMethodName       = "/censys.v1.CollectionService/DownloadCollectionData"
+	CollectionService_WatchCollection_FullMethodName              = "/censys.v1.CollectionService/WatchCollection"
+	CollectionService_WatchOrganizationCollections_FullMethodName = "/censys.v1.CollectionService/WatchOrganizationCollections"
+	CollectionService_ImportCollections_FullMethodName            = "/censys.v1.CollectionService/ImportCollections"
+	CollectionService_ExportCollections_FullMethodName            = "/censys.v1.CollectionService/ExportCollections"
+	CollectionService_SearchCollections_FullMethodName            = "/censys.v1.CollectionService/SearchCollections"
+	CollectionService_RegisterCollectionSchema_FullMethodName     = "/censys.v1.CollectionService/RegisterCollectionSchema"
+	CollectionService_ListCollectionSchemas_FullMethodName        = "/censys.v1.CollectionService/ListCollectionSchemas"
+	CollectionService_DeleteCollectionSchema_FullMethodName       = "/censys.v1.CollectionService/DeleteCollectionSchema"
+	CollectionService_ListTrash_FullMethodName                    = "/censys.v1.CollectionService/ListTrash"
+	CollectionService_UndeleteCollection_FullMethodName           = "/censys.v1.CollectionService/UndeleteCollection"
+	CollectionService_ListCollectionVersions_FullMethodName       = "/censys.v1.CollectionService/ListCollectionVersions"
+	CollectionService_GetCollectionVersion_FullMethodName         = "/censys.v1.CollectionService/GetCollectionVersion"
+	CollectionService_RestoreCollectionVersion_FullMethodName     = "/censys.v1.CollectionService/RestoreCollectionVersion"
+	CollectionService_TransferCollection_FullMethodName           = "/censys.v1.CollectionService/TransferCollection"
+	CollectionService_AcceptCollectionTransfer_FullMethodName     = "/censys.v1.CollectionService/AcceptCollectionTransfer"
+	CollectionService_DeclineCollectionTransfer_FullMethodName    = "/censys.v1.CollectionService/DeclineCollectionTransfer"
+	CollectionService_ListCollectionTransfers_FullMethodName      = "/censys.v1.CollectionService/ListCollectionTransfers"
+	CollectionService_GetQuotaUsage_FullMethodName                = "/censys.v1.CollectionService/GetQuotaUsage"
+	CollectionService_CreateWebhookSubscription_FullMethodName    = "/censys.v1.CollectionService/CreateWebhookSubscription"
+	CollectionService_ListWebhookSubscriptions_FullMethodName     = "/censys.v1.CollectionService/ListWebhookSubscriptions"
+	CollectionService_DeleteWebhookSubscription_FullMethodName    = "/censys.v1.CollectionService/DeleteWebhookSubscription"
+	CollectionService_ListWebhookDeliveries_FullMethodName        = "/censys.v1.CollectionService/ListWebhookDeliveries"
+	CollectionService_RetryWebhookDelivery_FullMethodName         = "/censys.v1.CollectionService/RetryWebhookDelivery"
+	CollectionService_CreateShareToken_FullMethodName             = "/censys.v1.CollectionService/CreateShareToken"
+	CollectionService_GetSharedCollection_FullMethodName          = "/censys.v1.CollectionService/GetSharedCollection"
+	CollectionService_WatchSharedCollection_FullMethodName        = "/censys.v1.CollectionService/WatchSharedCollection"
+	CollectionService_RevokeShareToken_FullMethodName             = "/censys.v1.CollectionService/RevokeShareToken"
+	CollectionService_UpdateShareToken_FullMethodName             = "/censys.v1.CollectionService/UpdateShareToken"
+	CollectionService_SuspendShareToken_FullMethodName            = "/censys.v1.CollectionService/SuspendShareToken"
+	CollectionService_ResumeShareToken_FullMethodName             = "/censys.v1.CollectionService/ResumeShareToken"
 )
 
 // CollectionServiceClient is the client API for CollectionService service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Every RPC but the client streaming uploads and imports is also served as HTTP/JSON under /v1 by the gateway.
 type CollectionServiceClient interface {
 	Login(ctx context.Context, in *LoginRequest, opts ...grpc.CallOption) (*LoginResponse, error)
 	CreateCollection(ctx context.Context, in *CreateCollectionRequest, opts ...grpc.CallOption) (*Collection, error)
 	GetCollection(ctx context.Context, in *GetCollectionRequest, opts ...grpc.CallOption) (*Collection, error)
 	UpdateCollection(ctx context.Context, in *UpdateCollectionRequest, opts ...grpc.CallOption) (*Collection, error)
+	PatchCollectionData(ctx context.Context, in *PatchCollectionDataRequest, opts ...grpc.CallOption) (*Collection, error)
 	DeleteCollection(ctx context.Context, in *DeleteCollectionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	CopyCollection(ctx context.Context, in *CopyCollectionRequest, opts ...grpc.CallOption) (*Collection, error)
+	UploadCollectionData(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[UploadCollectionDataRequest, CollectionPayload], error)
+	DownloadCollectionData(ctx context.Context, in *DownloadCollectionDataRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[DownloadCollectionDataResponse], error)
+	WatchCollection(ctx context.Context, in *WatchCollectionRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[CollectionEvent], error)
+	WatchOrganizationCollections(ctx context.Context, in *WatchOrganizationCollectionsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[CollectionEvent], error)
+	ImportCollections(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[ImportCollectionsRequest, ImportCollectionsResponse], error)
+	ExportCollections(ctx context.Context, in *ExportCollectionsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportCollectionsResponse], error)
+	SearchCollections(ctx context.Context, in *SearchCollectionsRequest, opts ...grpc.CallOption) (*SearchCollectionsResponse, error)
+	RegisterCollectionSchema(ctx context.Context, in *RegisterCollectionSchemaRequest, opts ...grpc.CallOption) (*CollectionSchema, error)
+	ListCollectionSchemas(ctx context.Context, in *ListCollectionSchemasRequest, opts ...grpc.CallOption) (*ListCollectionSchemasResponse, error)
+	DeleteCollectionSchema(ctx context.Context, in *DeleteCollectionSchemaRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ListTrash(ctx context.Context, in *ListTrashRequest, opts ...grpc.CallOption) (*ListTrashResponse, error)
+	UndeleteCollection(ctx context.Context, in *UndeleteCollectionRequest, opts ...grpc.CallOption) (*Collection, error)
+	ListCollectionVersions(ctx context.Context, in *ListCollectionVersionsRequest, opts ...grpc.CallOption) (*ListCollectionVersionsResponse, error)
+	GetCollectionVersion(ctx context.Context, in *GetCollectionVersionRequest, opts ...grpc.CallOption) (*CollectionVersion, error)
+	RestoreCollectionVersion(ctx context.Context, in *RestoreCollectionVersionRequest, opts ...grpc.CallOption) (*Collection, error)
+	TransferCollection(ctx context.Context, in *TransferCollectionRequest, opts ...grpc.CallOption) (*CollectionTransfer, error)
+	AcceptCollectionTransfer(ctx context.Context, in *AcceptCollectionTransferRequest, opts ...grpc.CallOption) (*CollectionTransfer, error)
+	DeclineCollectionTransfer(ctx context.Context, in *DeclineCollectionTransferRequest, opts ...grpc.CallOption) (*CollectionTransfer, error)
+	ListCollectionTransfers(ctx context.Context, in *ListCollectionTransfersRequest, opts ...grpc.CallOption) (*ListCollectionTransfersResponse, error)
+	GetQuotaUsage(ctx context.Context, in *GetQuotaUsageRequest, opts ...grpc.CallOption) (*QuotaUsage, error)
+	CreateWebhookSubscription(ctx context.Context, in *CreateWebhookSubscriptionRequest, opts ...grpc.CallOption) (*WebhookSubscription, error)
+	ListWebhookSubscriptions(ctx context.Context, in *ListWebhookSubscriptionsRequest, opts ...grpc.CallOption) (*ListWebhookSubscriptionsResponse, error)
+	DeleteWebhookSubscription(ctx context.Context, in *DeleteWebhookSubscriptionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ListWebhookDeliveries(ctx context.Context, in *ListWebhookDeliveriesRequest, opts ...grpc.CallOption) (*ListWebhookDeliveriesResponse, error)
+	RetryWebhookDelivery(ctx context.Context, in *RetryWebhookDeliveryRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	CreateShareToken(ctx context.Context, in *CreateShareTokenRequest, opts ...grpc.CallOption) (*ShareToken, error)
 	GetSharedCollection(ctx context.Context, in *GetSharedCollectionRequest, opts ...grpc.CallOption) (*SharedCollectionResponse, error)
+	WatchSharedCollection(ctx context.Context, in *WatchSharedCollectionRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[SharedCollectionResponse], error)
 	RevokeShareToken(ctx context.Context, in *RevokeShareTokenRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	UpdateShareToken(ctx context.Context, in *UpdateShareTokenRequest, opts ...grpc.CallOption) (*ShareToken, error)
+	SuspendShareToken(ctx context.Context, in *SuspendShareTokenRequest, opts ...grpc.CallOption) (*ShareToken, error)
+	ResumeShareToken(ctx context.Context, in *ResumeShareTokenRequest, opts ...grpc.CallOption) (*ShareToken, error)
 }
 
 type collectionServiceClient struct {
@@ -270,199 +524,1122 @@ func (c *collectionServiceClient) UpdateCollection(ctx context.Context, in *Upda
 	return out, nil
 }
 
-func (c *collectionServiceClient) DeleteCollection(ctx context.Context, in *DeleteCollectionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+func (c *collectionServiceClient) PatchCollectionData(ctx context.Context, in *PatchCollectionDataRequest, opts ...grpc.CallOption) (*Collection, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(emptypb.Empty)
-	err := c.cc.Invoke(ctx, CollectionService_DeleteCollection_FullMethodName, in, out, cOpts...)
+	out := new(Collection)
+	err := c.cc.Invoke(ctx, CollectionService_PatchCollectionData_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *collectionServiceClient) CreateShareToken(ctx context.Context, in *CreateShareTokenRequest, opts ...grpc.CallOption) (*ShareToken, error) {
+func (c *collectionServiceClient) DeleteCollection(ctx context.Context, in *DeleteCollectionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(ShareToken)
-	err := c.cc.Invoke(ctx, CollectionService_CreateShareToken_FullMethodName, in, out, cOpts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, CollectionService_DeleteCollection_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *collectionServiceClient) GetSharedCollection(ctx context.Context, in *GetSharedCollectionRequest, opts ...grpc.CallOption) (*SharedCollectionResponse, error) {
+func (c *collectionServiceClient) CopyCollection(ctx context.Context, in *CopyCollectionRequest, opts ...grpc.CallOption) (*Collection, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(SharedCollectionResponse)
-	err := c.cc.Invoke(ctx, CollectionService_GetSharedCollection_FullMethodName, in, out, cOpts...)
+	out := new(Collection)
+	err := c.cc.Invoke(ctx, CollectionService_CopyCollection_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *collectionServiceClient) RevokeShareToken(ctx context.Context, in *RevokeShareTokenRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+func (c *collectionServiceClient) UploadCollectionData(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[UploadCollectionDataRequest, CollectionPayload], error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(emptypb.Empty)
-	err := c.cc.Invoke(ctx, CollectionService_RevokeShareToken_FullMethodName, in, out, cOpts...)
+	stream, err := c.cc.NewStream(ctx, &CollectionService_ServiceDesc.Streams[0], CollectionService_UploadCollectionData_FullMethodName, cOpts...)
 	if err != nil {
 		return nil, err
 	}
-	return out, nil
-}
-
-// CollectionServiceServer is the server API for CollectionService service.
-// All implementations must embed UnimplementedCollectionServiceServer
-// for forward compatibility.
-type CollectionServiceServer interface {
-	Login(context.Context, *LoginRequest) (*LoginResponse, error)
-	CreateCollection(context.Context, *CreateCollectionRequest) (*Collection, error)
-	GetCollection(context.Context, *GetCollectionRequest) (*Collection, error)
-	UpdateCollection(context.Context, *UpdateCollectionRequest) (*Collection, error)
-	DeleteCollection(context.Context, *DeleteCollectionRequest) (*emptypb.Empty, error)
-	CreateShareToken(context.Context, *CreateShareTokenRequest) (*ShareToken, error)
-	GetSharedCollection(context.Context, *GetSharedCollectionRequest) (*SharedCollectionResponse, error)
-	RevokeShareToken(context.Context, *RevokeShareTokenRequest) (*emptypb.Empty, error)
-	mustEmbedUnimplementedCollectionServiceServer()
+	x := &grpc.GenericClientStream[UploadCollectionDataRequest, CollectionPayload]{ClientStream: stream}
+	return x, nil
 }
 
-// UnimplementedCollectionServiceServer must be embedded to have
-// forward compatible implementations.
-//
-// NOTE: this should be embedded by value instead of pointer to avoid a nil
-// pointer dereference when methods are called.
-type UnimplementedCollectionServiceServer struct{}
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type CollectionService_UploadCollectionDataClient = grpc.ClientStreamingClient[UploadCollectionDataRequest, CollectionPayload]
 
-func (UnimplementedCollectionServiceServer) Login(context.Context, *LoginRequest) (*LoginResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method Login not implemented")
-}
-func (UnimplementedCollectionServiceServer) CreateCollection(context.Context, *CreateCollectionRequest) (*Collection, error) {
-	return nil, status.Error(codes.Unimplemented, "method CreateCollection not implemented")
-}
-func (UnimplementedCollectionServiceServer) GetCollection(context.Context, *GetCollectionRequest) (*Collection, error) {
-	return nil, status.Error(codes.Unimplemented, "method GetCollection not implemented")
-}
-func (UnimplementedCollectionServiceServer) UpdateCollection(context.Context, *UpdateCollectionRequest) (*Collection, error) {
-	return nil, status.Error(codes.Unimplemented, "method UpdateCollection not implemented")
-}
-func (UnimplementedCollectionServiceServer) DeleteCollection(context.Context, *DeleteCollectionRequest) (*emptypb.Empty, error) {
-	return nil, status.Error(codes.Unimplemented, "method DeleteCollection not implemented")
-}
-func (UnimplementedCollectionServiceServer) CreateShareToken(context.Context, *CreateShareTokenRequest) (*ShareToken, error) {
-	return nil, status.Error(codes.Unimplemented, "method CreateShareToken not implemented")
-}
-func (UnimplementedCollectionServiceServer) GetSharedCollection(context.Context, *GetSharedCollectionRequest) (*SharedCollectionResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method GetSharedCollection not implemented")
-}
-func (UnimplementedCollectionServiceServer) RevokeShareToken(context.Context, *RevokeShareTokenRequest) (*emptypb.Empty, error) {
-	return nil, status.Error(codes.Unimplemented, "method RevokeShareToken not implemented")
+func (c *collectionServiceClient) DownloadCollectionData(ctx context.Context, in *DownloadCollectionDataRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[DownloadCollectionDataResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &CollectionService_ServiceDesc.Streams[1], CollectionService_DownloadCollectionData_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[DownloadCollectionDataRequest, DownloadCollectionDataResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
-func (UnimplementedCollectionServiceServer) mustEmbedUnimplementedCollectionServiceServer() {}
-func (UnimplementedCollectionServiceServer) testEmbeddedByValue()                           {}
 
-// UnsafeCollectionServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to CollectionServiceServer will
-// result in compilation errors.
-type UnsafeCollectionServiceServer interface {
-	mustEmbedUnimplementedCollectionServiceServer()
-}
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type CollectionService_DownloadCollectionDataClient = grpc.ServerStreamingClient[DownloadCollectionDataResponse]
 
-func RegisterCollectionServiceServer(s grpc.ServiceRegistrar, srv CollectionServiceServer) {
-	// If the following call panics, it indicates UnimplementedCollectionServiceServer was
-	// embedded by pointer and is nil.  This will cause panics if an
-	// unimplemented method is ever invoked, so we test this at initialization
-	// time to prevent it from happening at runtime later due to I/O.
-	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
-		t.testEmbeddedByValue()
+func (c *collectionServiceClient) WatchCollection(ctx context.Context, in *WatchCollectionRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[CollectionEvent], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &CollectionService_ServiceDesc.Streams[2], CollectionService_WatchCollection_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
 	}
-	s.RegisterService(&CollectionService_ServiceDesc, srv)
+	x := &grpc.GenericClientStream[WatchCollectionRequest, CollectionEvent]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
 
-func _CollectionService_Login_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LoginRequest)
-	if err := dec(in); err != nil {
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type CollectionService_WatchCollectionClient = grpc.ServerStreamingClient[CollectionEvent]
+
+func (c *collectionServiceClient) WatchOrganizationCollections(ctx context.Context, in *WatchOrganizationCollectionsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[CollectionEvent], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &CollectionService_ServiceDesc.Streams[3], CollectionService_WatchOrganizationCollections_FullMethodName, cOpts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(CollectionServiceServer).Login(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: CollectionService_Login_FullMethodName,
+	x := &grpc.GenericClientStream[WatchOrganizationCollectionsRequest, CollectionEvent]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CollectionServiceServer).Login(ctx, req.(*LoginRequest))
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return x, nil
 }
 
-func _CollectionService_CreateCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateCollectionRequest)
-	if err := dec(in); err != nil {
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type CollectionService_WatchOrganizationCollectionsClient = grpc.ServerStreamingClient[CollectionEvent]
+
+func (c *collectionServiceClient) ImportCollections(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[ImportCollectionsRequest, ImportCollectionsResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &CollectionService_ServiceDesc.Streams[4], CollectionService_ImportCollections_FullMethodName, cOpts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(CollectionServiceServer).CreateCollection(ctx, in)
+	x := &grpc.GenericClientStream[ImportCollectionsRequest, ImportCollectionsResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type CollectionService_ImportCollectionsClient = grpc.ClientStreamingClient[ImportCollectionsRequest, ImportCollectionsResponse]
+
+func (c *collectionServiceClient) ExportCollections(ctx context.Context, in *ExportCollectionsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportCollectionsResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &CollectionService_ServiceDesc.Streams[5], CollectionService_ExportCollections_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: CollectionService_CreateCollection_FullMethodName,
+	x := &grpc.GenericClientStream[ExportCollectionsRequest, ExportCollectionsResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CollectionServiceServer).CreateCollection(ctx, req.(*CreateCollectionRequest))
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return x, nil
 }
 
-func _CollectionService_GetCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetCollectionRequest)
-	if err := dec(in); err != nil {
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type CollectionService_ExportCollectionsClient = grpc.ServerStreamingClient[ExportCollectionsResponse]
+
+func (c *collectionServiceClient) SearchCollections(ctx context.Context, in *SearchCollectionsRequest, opts ...grpc.CallOption) (*SearchCollectionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchCollectionsResponse)
+	err := c.cc.Invoke(ctx, CollectionService_SearchCollections_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *collectionServiceClient) RegisterCollectionSchema(ctx context.Context, in *RegisterCollectionSchemaRequest, opts ...grpc.CallOption) (*CollectionSchema, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CollectionSchema)
+	err := c.cc.Invoke(ctx, CollectionService_RegisterCollectionSchema_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *collectionServiceClient) ListCollectionSchemas(ctx context.Context, in *ListCollectionSchemasRequest, opts ...grpc.CallOption) (*ListCollectionSchemasResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCollectionSchemasResponse)
+	err := c.cc.Invoke(ctx, CollectionService_ListCollectionSchemas_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *collectionServiceClient) DeleteCollectionSchema(ctx context.Context, in *DeleteCollectionSchemaRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, CollectionService_DeleteCollectionSchema_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *collectionServiceClient) ListTrash(ctx context.Context, in *ListTrashRequest, opts ...grpc.CallOption) (*ListTrashResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTrashResponse)
+	err := c.cc.Invoke(ctx, CollectionService_ListTrash_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *collectionServiceClient) UndeleteCollection(ctx context.Context, in *UndeleteCollectionRequest, opts ...grpc.CallOption) (*Collection, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Collection)
+	err := c.cc.Invoke(ctx, CollectionService_UndeleteCollection_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *collectionServiceClient) ListCollectionVersions(ctx context.Context, in *ListCollectionVersionsRequest, opts ...grpc.CallOption) (*ListCollectionVersionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCollectionVersionsResponse)
+	err := c.cc.Invoke(ctx, CollectionService_ListCollectionVersions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *collectionServiceClient) GetCollectionVersion(ctx context.Context, in *GetCollectionVersionRequest, opts ...grpc.CallOption) (*CollectionVersion, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CollectionVersion)
+	err := c.cc.Invoke(ctx, CollectionService_GetCollectionVersion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *collectionServiceClient) RestoreCollectionVersion(ctx context.Context, in *RestoreCollectionVersionRequest, opts ...grpc.CallOption) (*Collection, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Collection)
+	err := c.cc.Invoke(ctx, CollectionService_RestoreCollectionVersion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *collectionServiceClient) TransferCollection(ctx context.Context, in *TransferCollectionRequest, opts ...grpc.CallOption) (*CollectionTransfer, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CollectionTransfer)
+	err := c.cc.Invoke(ctx, CollectionService_TransferCollection_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *collectionServiceClient) AcceptCollectionTransfer(ctx context.Context, in *AcceptCollectionTransferRequest, opts ...grpc.CallOption) (*CollectionTransfer, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CollectionTransfer)
+	err := c.cc.Invoke(ctx, CollectionService_AcceptCollectionTransfer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *collectionServiceClient) DeclineCollectionTransfer(ctx context.Context, in *DeclineCollectionTransferRequest, opts ...grpc.CallOption) (*CollectionTransfer, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CollectionTransfer)
+	err := c.cc.Invoke(ctx, CollectionService_DeclineCollectionTransfer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *collectionServiceClient) ListCollectionTransfers(ctx context.Context, in *ListCollectionTransfersRequest, opts ...grpc.CallOption) (*ListCollectionTransfersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCollectionTransfersResponse)
+	err := c.cc.Invoke(ctx, CollectionService_ListCollectionTransfers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *collectionServiceClient) GetQuotaUsage(ctx context.Context, in *GetQuotaUsageRequest, opts ...grpc.CallOption) (*QuotaUsage, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QuotaUsage)
+	err := c.cc.Invoke(ctx, CollectionService_GetQuotaUsage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *collectionServiceClient) CreateWebhookSubscription(ctx context.Context, in *CreateWebhookSubscriptionRequest, opts ...grpc.CallOption) (*WebhookSubscription, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WebhookSubscription)
+	err := c.cc.Invoke(ctx, CollectionService_CreateWebhookSubscription_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *collectionServiceClient) ListWebhookSubscriptions(ctx context.Context, in *ListWebhookSubscriptionsRequest, opts ...grpc.CallOption) (*ListWebhookSubscriptionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListWebhookSubscriptionsResponse)
+	err := c.cc.Invoke(ctx, CollectionService_ListWebhookSubscriptions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *collectionServiceClient) DeleteWebhookSubscription(ctx context.Context, in *DeleteWebhookSubscriptionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, CollectionService_DeleteWebhookSubscription_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *collectionServiceClient) ListWebhookDeliveries(ctx context.Context, in *ListWebhookDeliveriesRequest, opts ...grpc.CallOption) (*ListWebhookDeliveriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListWebhookDeliveriesResponse)
+	err := c.cc.Invoke(ctx, CollectionService_ListWebhookDeliveries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *collectionServiceClient) RetryWebhookDelivery(ctx context.Context, in *RetryWebhookDeliveryRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, CollectionService_RetryWebhookDelivery_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *collectionServiceClient) CreateShareToken(ctx context.Context, in *CreateShareTokenRequest, opts ...grpc.CallOption) (*ShareToken, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ShareToken)
+	err := c.cc.Invoke(ctx, CollectionService_CreateShareToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *collectionServiceClient) GetSharedCollection(ctx context.Context, in *GetSharedCollectionRequest, opts ...grpc.CallOption) (*SharedCollectionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SharedCollectionResponse)
+	err := c.cc.Invoke(ctx, CollectionService_GetSharedCollection_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *collectionServiceClient) WatchSharedCollection(ctx context.Context, in *WatchSharedCollectionRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[SharedCollectionResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &CollectionService_ServiceDesc.Streams[6], CollectionService_WatchSharedCollection_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchSharedCollectionRequest, SharedCollectionResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type CollectionService_WatchSharedCollectionClient = grpc.ServerStreamingClient[SharedCollectionResponse]
+
+func (c *collectionServiceClient) RevokeShareToken(ctx context.Context, in *RevokeShareTokenRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, CollectionService_RevokeShareToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *collectionServiceClient) UpdateShareToken(ctx context.Context, in *UpdateShareTokenRequest, opts ...grpc.CallOption) (*ShareToken, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ShareToken)
+	err := c.cc.Invoke(ctx, CollectionService_UpdateShareToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *collectionServiceClient) SuspendShareToken(ctx context.Context, in *SuspendShareTokenRequest, opts ...grpc.CallOption) (*ShareToken, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ShareToken)
+	err := c.cc.Invoke(ctx, CollectionService_SuspendShareToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *collectionServiceClient) ResumeShareToken(ctx context.Context, in *ResumeShareTokenRequest, opts ...grpc.CallOption) (*ShareToken, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ShareToken)
+	err := c.cc.Invoke(ctx, CollectionService_ResumeShareToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CollectionServiceServer is the server API for CollectionService service.
+// All implementations must embed UnimplementedCollectionServiceServer
+// for forward compatibility.
+//
+// Every RPC but the client streaming uploads and imports is also served as HTTP/JSON under /v1 by the gateway.
+type CollectionServiceServer interface {
+	Login(context.Context, *LoginRequest) (*LoginResponse, error)
+	CreateCollection(context.Context, *CreateCollectionRequest) (*Collection, error)
+	GetCollection(context.Context, *GetCollectionRequest) (*Collection, error)
+	UpdateCollection(context.Context, *UpdateCollectionRequest) (*Collection, error)
+	PatchCollectionData(context.Context, *PatchCollectionDataRequest) (*Collection, error)
+	DeleteCollection(context.Context, *DeleteCollectionRequest) (*emptypb.Empty, error)
+	CopyCollection(context.Context, *CopyCollectionRequest) (*Collection, error)
+	UploadCollectionData(grpc.ClientStreamingServer[UploadCollectionDataRequest, CollectionPayload]) error
+	DownloadCollectionData(*DownloadCollectionDataRequest, grpc.ServerStreamingServer[DownloadCollectionDataResponse]) error
+	WatchCollection(*WatchCollectionRequest, grpc.ServerStreamingServer[CollectionEvent]) error
+	WatchOrganizationCollections(*WatchOrganizationCollectionsRequest, grpc.ServerStreamingServer[CollectionEvent]) error
+	ImportCollections(grpc.ClientStreamingServer[ImportCollectionsRequest, ImportCollectionsResponse]) error
+	ExportCollections(*ExportCollectionsRequest, grpc.ServerStreamingServer[ExportCollectionsResponse]) error
+	SearchCollections(context.Context, *SearchCollectionsRequest) (*SearchCollectionsResponse, error)
+	RegisterCollectionSchema(context.Context, *RegisterCollectionSchemaRequest) (*CollectionSchema, error)
+	ListCollectionSchemas(context.Context, *ListCollectionSchemasRequest) (*ListCollectionSchemasResponse, error)
+	DeleteCollectionSchema(context.Context, *DeleteCollectionSchemaRequest) (*emptypb.Empty, error)
+	ListTrash(context.Context, *ListTrashRequest) (*ListTrashResponse, error)
+	UndeleteCollection(context.Context, *UndeleteCollectionRequest) (*Collection, error)
+	ListCollectionVersions(context.Context, *ListCollectionVersionsRequest) (*ListCollectionVersionsResponse, error)
+	GetCollectionVersion(context.Context, *GetCollectionVersionRequest) (*CollectionVersion, error)
+	RestoreCollectionVersion(context.Context, *RestoreCollectionVersionRequest) (*Collection, error)
+	TransferCollection(context.Context, *TransferCollectionRequest) (*CollectionTransfer, error)
+	AcceptCollectionTransfer(context.Context, *AcceptCollectionTransferRequest) (*CollectionTransfer, error)
+	DeclineCollectionTransfer(context.Context, *DeclineCollectionTransferRequest) (*CollectionTransfer, error)
+	ListCollectionTransfers(context.Context, *ListCollectionTransfersRequest) (*ListCollectionTransfersResponse, error)
+	GetQuotaUsage(context.Context, *GetQuotaUsageRequest) (*QuotaUsage, error)
+	CreateWebhookSubscription(context.Context, *CreateWebhookSubscriptionRequest) (*WebhookSubscription, error)
+	ListWebhookSubscriptions(context.Context, *ListWebhookSubscriptionsRequest) (*ListWebhookSubscriptionsResponse, error)
+	DeleteWebhookSubscription(context.Context, *DeleteWebhookSubscriptionRequest) (*emptypb.Empty, error)
+	ListWebhookDeliveries(context.Context, *ListWebhookDeliveriesRequest) (*ListWebhookDeliveriesResponse, error)
+	RetryWebhookDelivery(context.Context, *RetryWebhookDeliveryRequest) (*emptypb.Empty, error)
+	CreateShareToken(context.Context, *CreateShareTokenRequest) (*ShareToken, error)
+	GetSharedCollection(context.Context, *GetSharedCollectionRequest) (*SharedCollectionResponse, error)
+	WatchSharedCollection(*WatchSharedCollectionRequest, grpc.ServerStreamingServer[SharedCollectionResponse]) error
+	RevokeShareToken(context.Context, *RevokeShareTokenRequest) (*emptypb.Empty, error)
+	UpdateShareToken(context.Context, *UpdateShareTokenRequest) (*ShareToken, error)
+	SuspendShareToken(context.Context, *SuspendShareTokenRequest) (*ShareToken, error)
+	ResumeShareToken(context.Context, *ResumeShareTokenRequest) (*ShareToken, error)
+	mustEmbedUnimplementedCollectionServiceServer()
+}
+
+// UnimplementedCollectionServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedCollectionServiceServer struct{}
+
+func (UnimplementedCollectionServiceServer) Login(context.Context, *LoginRequest) (*LoginResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method Login not implemented")
+}
+func (UnimplementedCollectionServiceServer) CreateCollection(context.Context, *CreateCollectionRequest) (*Collection, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateCollection not implemented")
+}
+func (UnimplementedCollectionServiceServer) GetCollection(context.Context, *GetCollectionRequest) (*Collection, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetCollection not implemented")
+}
+func (UnimplementedCollectionServiceServer) UpdateCollection(context.Context, *UpdateCollectionRequest) (*Collection, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateCollection not implemented")
+}
+func (UnimplementedCollectionServiceServer) PatchCollectionData(context.Context, *PatchCollectionDataRequest) (*Collection, error) {
+	return nil, status.Error(codes.Unimplemented, "method PatchCollectionData not implemented")
+}
+func (UnimplementedCollectionServiceServer) DeleteCollection(context.Context, *DeleteCollectionRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteCollection not implemented")
+}
+func (UnimplementedCollectionServiceServer) CopyCollection(context.Context, *CopyCollectionRequest) (*Collection, error) {
+	return nil, status.Error(codes.Unimplemented, "method CopyCollection not implemented")
+}
+func (UnimplementedCollectionServiceServer) UploadCollectionData(grpc.ClientStreamingServer[UploadCollectionDataRequest, CollectionPayload]) error {
+	return status.Error(codes.Unimplemented, "method UploadCollectionData not implemented")
+}
+func (UnimplementedCollectionServiceServer) DownloadCollectionData(*DownloadCollectionDataRequest, grpc.ServerStreamingServer[DownloadCollectionDataResponse]) error {
+	return status.Error(codes.Unimplemented, "method DownloadCollectionData not implemented")
+}
+func (UnimplementedCollectionServiceServer) WatchCollection(*WatchCollectionRequest, grpc.ServerStreamingServer[CollectionEvent]) error {
+	return status.Error(codes.Unimplemented, "method WatchCollection not implemented")
+}
+func (UnimplementedCollectionServiceServer) WatchOrganizationCollections(*WatchOrganizationCollectionsRequest, grpc.ServerStreamingServer[CollectionEvent]) error {
+	return status.Error(codes.Unimplemented, "method WatchOrganizationCollections not implemented")
+}
+func (UnimplementedCollectionServiceServer) ImportCollections(grpc.ClientStreamingServer[ImportCollectionsRequest, ImportCollectionsResponse]) error {
+	return status.Error(codes.Unimplemented, "method ImportCollections not implemented")
+}
+func (UnimplementedCollectionServiceServer) ExportCollections(*ExportCollectionsRequest, grpc.ServerStreamingServer[ExportCollectionsResponse]) error {
+	return status.Error(codes.Unimplemented, "method ExportCollections not implemented")
+}
+func (UnimplementedCollectionServiceServer) SearchCollections(context.Context, *SearchCollectionsRequest) (*SearchCollectionsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SearchCollections not implemented")
+}
+func (UnimplementedCollectionServiceServer) RegisterCollectionSchema(context.Context, *RegisterCollectionSchemaRequest) (*CollectionSchema, error) {
+	return nil, status.Error(codes.Unimplemented, "method RegisterCollectionSchema not implemented")
+}
+func (UnimplementedCollectionServiceServer) ListCollectionSchemas(context.Context, *ListCollectionSchemasRequest) (*ListCollectionSchemasResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListCollectionSchemas not implemented")
+}
+func (UnimplementedCollectionServiceServer) DeleteCollectionSchema(context.Context, *DeleteCollectionSchemaRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteCollectionSchema not implemented")
+}
+func (UnimplementedCollectionServiceServer) ListTrash(context.Context, *ListTrashRequest) (*ListTrashResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListTrash not implemented")
+}
+func (UnimplementedCollectionServiceServer) UndeleteCollection(context.Context, *UndeleteCollectionRequest) (*Collection, error) {
+	return nil, status.Error(codes.Unimplemented, "method UndeleteCollection not implemented")
+}
+func (UnimplementedCollectionServiceServer) ListCollectionVersions(context.Context, *ListCollectionVersionsRequest) (*ListCollectionVersionsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListCollectionVersions not implemented")
+}
+func (UnimplementedCollectionServiceServer) GetCollectionVersion(context.Context, *GetCollectionVersionRequest) (*CollectionVersion, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetCollectionVersion not implemented")
+}
+func (UnimplementedCollectionServiceServer) RestoreCollectionVersion(context.Context, *RestoreCollectionVersionRequest) (*Collection, error) {
+	return nil, status.Error(codes.Unimplemented, "method RestoreCollectionVersion not implemented")
+}
+func (UnimplementedCollectionServiceServer) TransferCollection(context.Context, *TransferCollectionRequest) (*CollectionTransfer, error) {
+	return nil, status.Error(codes.Unimplemented, "method TransferCollection not implemented")
+}
+func (UnimplementedCollectionServiceServer) AcceptCollectionTransfer(context.Context, *AcceptCollectionTransferRequest) (*CollectionTransfer, error) {
+	return nil, status.Error(codes.Unimplemented, "method AcceptCollectionTransfer not implemented")
+}
+func (UnimplementedCollectionServiceServer) DeclineCollectionTransfer(context.Context, *DeclineCollectionTransferRequest) (*CollectionTransfer, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeclineCollectionTransfer not implemented")
+}
+func (UnimplementedCollectionServiceServer) ListCollectionTransfers(context.Context, *ListCollectionTransfersRequest) (*ListCollectionTransfersResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListCollectionTransfers not implemented")
+}
+func (UnimplementedCollectionServiceServer) GetQuotaUsage(context.Context, *GetQuotaUsageRequest) (*QuotaUsage, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetQuotaUsage not implemented")
+}
+func (UnimplementedCollectionServiceServer) CreateWebhookSubscription(context.Context, *CreateWebhookSubscriptionRequest) (*WebhookSubscription, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateWebhookSubscription not implemented")
+}
+func (UnimplementedCollectionServiceServer) ListWebhookSubscriptions(context.Context, *ListWebhookSubscriptionsRequest) (*ListWebhookSubscriptionsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListWebhookSubscriptions not implemented")
+}
+func (UnimplementedCollectionServiceServer) DeleteWebhookSubscription(context.Context, *DeleteWebhookSubscriptionRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteWebhookSubscription not implemented")
+}
+func (UnimplementedCollectionServiceServer) ListWebhookDeliveries(context.Context, *ListWebhookDeliveriesRequest) (*ListWebhookDeliveriesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListWebhookDeliveries not implemented")
+}
+func (UnimplementedCollectionServiceServer) RetryWebhookDelivery(context.Context, *RetryWebhookDeliveryRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method RetryWebhookDelivery not implemented")
+}
+func (UnimplementedCollectionServiceServer) CreateShareToken(context.Context, *CreateShareTokenRequest) (*ShareToken, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateShareToken not implemented")
+}
+func (UnimplementedCollectionServiceServer) GetSharedCollection(context.Context, *GetSharedCollectionRequest) (*SharedCollectionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetSharedCollection not implemented")
+}
+func (UnimplementedCollectionServiceServer) WatchSharedCollection(*WatchSharedCollectionRequest, grpc.ServerStreamingServer[SharedCollectionResponse]) error {
+	return status.Error(codes.Unimplemented, "method WatchSharedCollection not implemented")
+}
+func (UnimplementedCollectionServiceServer) RevokeShareToken(context.Context, *RevokeShareTokenRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method RevokeShareToken not implemented")
+}
+func (UnimplementedCollectionServiceServer) UpdateShareToken(context.Context, *UpdateShareTokenRequest) (*ShareToken, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateShareToken not implemented")
+}
+func (UnimplementedCollectionServiceServer) SuspendShareToken(context.Context, *SuspendShareTokenRequest) (*ShareToken, error) {
+	return nil, status.Error(codes.Unimplemented, "method SuspendShareToken not implemented")
+}
+func (UnimplementedCollectionServiceServer) ResumeShareToken(context.Context, *ResumeShareTokenRequest) (*ShareToken, error) {
+	return nil, status.Error(codes.Unimplemented, "method ResumeShareToken not implemented")
+}
+func (UnimplementedCollectionServiceServer) mustEmbedUnimplementedCollectionServiceServer() {}
+func (UnimplementedCollectionServiceServer) testEmbeddedByValue()                           {}
+
+// UnsafeCollectionServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CollectionServiceServer will
+// result in compilation errors.
+type UnsafeCollectionServiceServer interface {
+	mustEmbedUnimplementedCollectionServiceServer()
+}
+
+func RegisterCollectionServiceServer(s grpc.ServiceRegistrar, srv CollectionServiceServer) {
+	// If the following call panics, it indicates UnimplementedCollectionServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&CollectionService_ServiceDesc, srv)
+}
+
+func _CollectionService_Login_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoginRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CollectionServiceServer).Login(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CollectionService_Login_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CollectionServiceServer).Login(ctx, req.(*LoginRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CollectionService_CreateCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CollectionServiceServer).CreateCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CollectionService_CreateCollection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CollectionServiceServer).CreateCollection(ctx, req.(*CreateCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CollectionService_GetCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CollectionServiceServer).GetCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CollectionService_GetCollection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CollectionServiceServer).GetCollection(ctx, req.(*GetCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CollectionService_UpdateCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CollectionServiceServer).UpdateCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CollectionService_UpdateCollection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CollectionServiceServer).UpdateCollection(ctx, req.(*UpdateCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CollectionService_PatchCollectionData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PatchCollectionDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CollectionServiceServer).PatchCollectionData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CollectionService_PatchCollectionData_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CollectionServiceServer).PatchCollectionData(ctx, req.(*PatchCollectionDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CollectionService_DeleteCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CollectionServiceServer).DeleteCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CollectionService_DeleteCollection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CollectionServiceServer).DeleteCollection(ctx, req.(*DeleteCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CollectionService_CopyCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CopyCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CollectionServiceServer).CopyCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CollectionService_CopyCollection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CollectionServiceServer).CopyCollection(ctx, req.(*CopyCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CollectionService_UploadCollectionData_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(CollectionServiceServer).UploadCollectionData(&grpc.GenericServerStream[UploadCollectionDataRequest, CollectionPayload]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type CollectionService_UploadCollectionDataServer = grpc.ClientStreamingServer[UploadCollectionDataRequest, CollectionPayload]
+
+func _CollectionService_DownloadCollectionData_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(DownloadCollectionDataRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(CollectionServiceServer).DownloadCollectionData(m, &grpc.GenericServerStream[DownloadCollectionDataRequest, DownloadCollectionDataResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type CollectionService_DownloadCollectionDataServer = grpc.ServerStreamingServer[DownloadCollectionDataResponse]
+
+func _CollectionService_WatchCollection_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchCollectionRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(CollectionServiceServer).WatchCollection(m, &grpc.GenericServerStream[WatchCollectionRequest, CollectionEvent]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type CollectionService_WatchCollectionServer = grpc.ServerStreamingServer[CollectionEvent]
+
+func _CollectionService_WatchOrganizationCollections_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchOrganizationCollectionsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(CollectionServiceServer).WatchOrganizationCollections(m, &grpc.GenericServerStream[WatchOrganizationCollectionsRequest, CollectionEvent]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type CollectionService_WatchOrganizationCollectionsServer = grpc.ServerStreamingServer[CollectionEvent]
+
+func _CollectionService_ImportCollections_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(CollectionServiceServer).ImportCollections(&grpc.GenericServerStream[ImportCollectionsRequest, ImportCollectionsResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type CollectionService_ImportCollectionsServer = grpc.ClientStreamingServer[ImportCollectionsRequest, ImportCollectionsResponse]
+
+func _CollectionService_ExportCollections_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportCollectionsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(CollectionServiceServer).ExportCollections(m, &grpc.GenericServerStream[ExportCollectionsRequest, ExportCollectionsResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type CollectionService_ExportCollectionsServer = grpc.ServerStreamingServer[ExportCollectionsResponse]
+
+func _CollectionService_SearchCollections_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchCollectionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CollectionServiceServer).SearchCollections(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CollectionService_SearchCollections_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CollectionServiceServer).SearchCollections(ctx, req.(*SearchCollectionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CollectionService_RegisterCollectionSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterCollectionSchemaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CollectionServiceServer).RegisterCollectionSchema(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CollectionService_RegisterCollectionSchema_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CollectionServiceServer).RegisterCollectionSchema(ctx, req.(*RegisterCollectionSchemaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CollectionService_ListCollectionSchemas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCollectionSchemasRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CollectionServiceServer).ListCollectionSchemas(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CollectionService_ListCollectionSchemas_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CollectionServiceServer).ListCollectionSchemas(ctx, req.(*ListCollectionSchemasRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CollectionService_DeleteCollectionSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCollectionSchemaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CollectionServiceServer).DeleteCollectionSchema(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CollectionService_DeleteCollectionSchema_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CollectionServiceServer).DeleteCollectionSchema(ctx, req.(*DeleteCollectionSchemaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CollectionService_ListTrash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTrashRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CollectionServiceServer).ListTrash(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CollectionService_ListTrash_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CollectionServiceServer).ListTrash(ctx, req.(*ListTrashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CollectionService_UndeleteCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UndeleteCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CollectionServiceServer).UndeleteCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CollectionService_UndeleteCollection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CollectionServiceServer).UndeleteCollection(ctx, req.(*UndeleteCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CollectionService_ListCollectionVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCollectionVersionsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CollectionServiceServer).GetCollection(ctx, in)
+		return srv.(CollectionServiceServer).ListCollectionVersions(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CollectionService_GetCollection_FullMethodName,
+		FullMethod: CollectionService_ListCollectionVersions_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CollectionServiceServer).GetCollection(ctx, req.(*GetCollectionRequest))
+		return srv.(CollectionServiceServer).ListCollectionVersions(ctx, req.(*ListCollectionVersionsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CollectionService_UpdateCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateCollectionRequest)
+func _CollectionService_GetCollectionVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCollectionVersionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CollectionServiceServer).UpdateCollection(ctx, in)
+		return srv.(CollectionServiceServer).GetCollectionVersion(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CollectionService_UpdateCollection_FullMethodName,
+		FullMethod: CollectionService_GetCollectionVersion_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CollectionServiceServer).UpdateCollection(ctx, req.(*UpdateCollectionRequest))
+		return srv.(CollectionServiceServer).GetCollectionVersion(ctx, req.(*GetCollectionVersionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CollectionService_DeleteCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteCollectionRequest)
+func _CollectionService_RestoreCollectionVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreCollectionVersionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CollectionServiceServer).DeleteCollection(ctx, in)
+		return srv.(CollectionServiceServer).RestoreCollectionVersion(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CollectionService_DeleteCollection_FullMethodName,
+		FullMethod: CollectionService_RestoreCollectionVersion_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CollectionServiceServer).DeleteCollection(ctx, req.(*DeleteCollectionRequest))
+		return srv.(CollectionServiceServer).RestoreCollectionVersion(ctx, req.(*RestoreCollectionVersionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CollectionService_TransferCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransferCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CollectionServiceServer).TransferCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CollectionService_TransferCollection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CollectionServiceServer).TransferCollection(ctx, req.(*TransferCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CollectionService_AcceptCollectionTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcceptCollectionTransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CollectionServiceServer).AcceptCollectionTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CollectionService_AcceptCollectionTransfer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CollectionServiceServer).AcceptCollectionTransfer(ctx, req.(*AcceptCollectionTransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CollectionService_DeclineCollectionTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeclineCollectionTransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CollectionServiceServer).DeclineCollectionTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CollectionService_DeclineCollectionTransfer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CollectionServiceServer).DeclineCollectionTransfer(ctx, req.(*DeclineCollectionTransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CollectionService_ListCollectionTransfers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCollectionTransfersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CollectionServiceServer).ListCollectionTransfers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CollectionService_ListCollectionTransfers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CollectionServiceServer).ListCollectionTransfers(ctx, req.(*ListCollectionTransfersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CollectionService_GetQuotaUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetQuotaUsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CollectionServiceServer).GetQuotaUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CollectionService_GetQuotaUsage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CollectionServiceServer).GetQuotaUsage(ctx, req.(*GetQuotaUsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CollectionService_CreateWebhookSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateWebhookSubscriptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CollectionServiceServer).CreateWebhookSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CollectionService_CreateWebhookSubscription_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CollectionServiceServer).CreateWebhookSubscription(ctx, req.(*CreateWebhookSubscriptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CollectionService_ListWebhookSubscriptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWebhookSubscriptionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CollectionServiceServer).ListWebhookSubscriptions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CollectionService_ListWebhookSubscriptions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CollectionServiceServer).ListWebhookSubscriptions(ctx, req.(*ListWebhookSubscriptionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CollectionService_DeleteWebhookSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteWebhookSubscriptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CollectionServiceServer).DeleteWebhookSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CollectionService_DeleteWebhookSubscription_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CollectionServiceServer).DeleteWebhookSubscription(ctx, req.(*DeleteWebhookSubscriptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CollectionService_ListWebhookDeliveries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWebhookDeliveriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CollectionServiceServer).ListWebhookDeliveries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CollectionService_ListWebhookDeliveries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CollectionServiceServer).ListWebhookDeliveries(ctx, req.(*ListWebhookDeliveriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CollectionService_RetryWebhookDelivery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RetryWebhookDeliveryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CollectionServiceServer).RetryWebhookDelivery(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CollectionService_RetryWebhookDelivery_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CollectionServiceServer).RetryWebhookDelivery(ctx, req.(*RetryWebhookDeliveryRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -503,6 +1680,17 @@ func _CollectionService_GetSharedCollection_Handler(srv interface{}, ctx context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CollectionService_WatchSharedCollection_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchSharedCollectionRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(CollectionServiceServer).WatchSharedCollection(m, &grpc.GenericServerStream[WatchSharedCollectionRequest, SharedCollectionResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type CollectionService_WatchSharedCollectionServer = grpc.ServerStreamingServer[SharedCollectionResponse]
+
 func _CollectionService_RevokeShareToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(RevokeShareTokenRequest)
 	if err := dec(in); err != nil {
@@ -521,6 +1709,60 @@ func _CollectionService_RevokeShareToken_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CollectionService_UpdateShareToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateShareTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CollectionServiceServer).UpdateShareToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CollectionService_UpdateShareToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CollectionServiceServer).UpdateShareToken(ctx, req.(*UpdateShareTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CollectionService_SuspendShareToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SuspendShareTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CollectionServiceServer).SuspendShareToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CollectionService_SuspendShareToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CollectionServiceServer).SuspendShareToken(ctx, req.(*SuspendShareTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CollectionService_ResumeShareToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResumeShareTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CollectionServiceServer).ResumeShareToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CollectionService_ResumeShareToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CollectionServiceServer).ResumeShareToken(ctx, req.(*ResumeShareTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // CollectionService_ServiceDesc is the grpc.ServiceDesc for CollectionService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -544,10 +1786,94 @@ var CollectionService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateCollection",
 			Handler:    _CollectionService_UpdateCollection_Handler,
 		},
+		{
+			MethodName: "PatchCollectionData",
+			Handler:    _CollectionService_PatchCollectionData_Handler,
+		},
 		{
 			MethodName: "DeleteCollection",
 			Handler:    _CollectionService_DeleteCollection_Handler,
 		},
+		{
+			MethodName: "CopyCollection",
+			Handler:    _CollectionService_CopyCollection_Handler,
+		},
+		{
+			MethodName: "SearchCollections",
+			Handler:    _CollectionService_SearchCollections_Handler,
+		},
+		{
+			MethodName: "RegisterCollectionSchema",
+			Handler:    _CollectionService_RegisterCollectionSchema_Handler,
+		},
+		{
+			MethodName: "ListCollectionSchemas",
+			Handler:    _CollectionService_ListCollectionSchemas_Handler,
+		},
+		{
+			MethodName: "DeleteCollectionSchema",
+			Handler:    _CollectionService_DeleteCollectionSchema_Handler,
+		},
+		{
+			MethodName: "ListTrash",
+			Handler:    _CollectionService_ListTrash_Handler,
+		},
+		{
+			MethodName: "UndeleteCollection",
+			Handler:    _CollectionService_UndeleteCollection_Handler,
+		},
+		{
+			MethodName: "ListCollectionVersions",
+			Handler:    _CollectionService_ListCollectionVersions_Handler,
+		},
+		{
+			MethodName: "GetCollectionVersion",
+			Handler:    _CollectionService_GetCollectionVersion_Handler,
+		},
+		{
+			MethodName: "RestoreCollectionVersion",
+			Handler:    _CollectionService_RestoreCollectionVersion_Handler,
+		},
+		{
+			MethodName: "TransferCollection",
+			Handler:    _CollectionService_TransferCollection_Handler,
+		},
+		{
+			MethodName: "AcceptCollectionTransfer",
+			Handler:    _CollectionService_AcceptCollectionTransfer_Handler,
+		},
+		{
+			MethodName: "DeclineCollectionTransfer",
+			Handler:    _CollectionService_DeclineCollectionTransfer_Handler,
+		},
+		{
+			MethodName: "ListCollectionTransfers",
+			Handler:    _CollectionService_ListCollectionTransfers_Handler,
+		},
+		{
+			MethodName: "GetQuotaUsage",
+			Handler:    _CollectionService_GetQuotaUsage_Handler,
+		},
+		{
+			MethodName: "CreateWebhookSubscription",
+			Handler:    _CollectionService_CreateWebhookSubscription_Handler,
+		},
+		{
+			MethodName: "ListWebhookSubscriptions",
+			Handler:    _CollectionService_ListWebhookSubscriptions_Handler,
+		},
+		{
+			MethodName: "DeleteWebhookSubscription",
+			Handler:    _CollectionService_DeleteWebhookSubscription_Handler,
+		},
+		{
+			MethodName: "ListWebhookDeliveries",
+			Handler:    _CollectionService_ListWebhookDeliveries_Handler,
+		},
+		{
+			MethodName: "RetryWebhookDelivery",
+			Handler:    _CollectionService_RetryWebhookDelivery_Handler,
+		},
 		{
 			MethodName: "CreateShareToken",
 			Handler:    _CollectionService_CreateShareToken_Handler,
@@ -560,7 +1886,55 @@ var CollectionService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RevokeShareToken",
 			Handler:    _CollectionService_RevokeShareToken_Handler,
 		},
+		{
+			MethodName: "UpdateShareToken",
+			Handler:    _CollectionService_UpdateShareToken_Handler,
+		},
+		{
+			MethodName: "SuspendShareToken",
+			Handler:    _CollectionService_SuspendShareToken_Handler,
+		},
+		{
+			MethodName: "ResumeShareToken",
+			Handler:    _CollectionService_ResumeShareToken_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "UploadCollectionData",
+			Handler:       _CollectionService_UploadCollectionData_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "DownloadCollectionData",
+			Handler:       _CollectionService_DownloadCollectionData_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WatchCollection",
+			Handler:       _CollectionService_WatchCollection_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WatchOrganizationCollections",
+			Handler:       _CollectionService_WatchOrganizationCollections_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ImportCollections",
+			Handler:       _CollectionService_ImportCollections_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "ExportCollections",
+			Handler:       _CollectionService_ExportCollections_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WatchSharedCollection",
+			Handler:       _CollectionService_WatchSharedCollection_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/service.proto",
 }
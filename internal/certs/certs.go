@@ -0,0 +1,172 @@
+// Package certs keeps the server's TLS certificate and client CA in sync with the files on disk, so rotated
+// certificates are served without a restart.
+package certs
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"sync"
+	"time"
+)
+
+type Reloader struct {
+	certFile     string
+	keyFile      string
+	clientCAFile string
+
+	mu        sync.RWMutex
+	cert      *tls.Certificate
+	clientCAs *x509.CertPool
+	modTimes  []time.Time
+}
+
+// NewReloader loads the key pair and, when clientCAFile is set, the CAs client certificates are verified
+// against. An empty clientCAFile turns client certificates off.
+func NewReloader(certFile, keyFile, clientCAFile string) (*Reloader, error) {
+	r := &Reloader{certFile: certFile, keyFile: keyFile, clientCAFile: clientCAFile}
+	if err := r.Reload(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// Reload reads the files again. If any of them is invalid the current certificates are kept.
+func (r *Reloader) Reload() error {
+	modTimes, err := r.fileModTimes()
+	if err != nil {
+		return err
+	}
+
+	cert, err := tls.LoadX509KeyPair(r.certFile, r.keyFile)
+	if err != nil {
+		return fmt.Errorf("failed to load key pair: %w", err)
+	}
+
+	var clientCAs *x509.CertPool
+	if r.clientCAFile != "" {
+		pem, err := os.ReadFile(r.clientCAFile)
+		if err != nil {
+			return fmt.Errorf("failed to read client CA file: %w", err)
+		}
+		clientCAs = x509.NewCertPool()
+		if !clientCAs.AppendCertsFromPEM(pem) {
+			return errors.New("client CA file contains no certificates")
+		}
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.cert = &cert
+	r.clientCAs = clientCAs
+	r.modTimes = modTimes
+	return nil
+}
+
+// Run reloads the files whenever one of them changes, checking every interval until ctx is cancelled.
+func (r *Reloader) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		modTimes, err := r.fileModTimes()
+		if err != nil {
+			log.Printf("Failed to check TLS files: %v", err)
+			continue
+		}
+		r.mu.RLock()
+		changed := !equalTimes(modTimes, r.modTimes)
+		r.mu.RUnlock()
+		if !changed {
+			continue
+		}
+
+		if err := r.Reload(); err != nil {
+			log.Printf("TLS reload failed, keeping current certificates: %v", err)
+			continue
+		}
+		log.Println("TLS certificates reloaded")
+	}
+}
+
+// ServerConfig returns a config that picks up the current certificates on every handshake. With a client
+// CA, client certificates are verified when presented but not required, callers without one fall back to
+// bearer tokens.
+func (r *Reloader) ServerConfig() *tls.Config {
+	return &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		GetConfigForClient: func(*tls.ClientHelloInfo) (*tls.Config, error) {
+			r.mu.RLock()
+			defer r.mu.RUnlock()
+
+			config := &tls.Config{
+				MinVersion:   tls.VersionTLS12,
+				Certificates: []tls.Certificate{*r.cert},
+				// replaces the config the HTTP server set up, so it has to offer HTTP/2 itself
+				NextProtos: []string{"h2", "http/1.1"},
+			}
+			if r.clientCAs != nil {
+				config.ClientCAs = r.clientCAs
+				config.ClientAuth = tls.VerifyClientCertIfGiven
+			}
+			return config, nil
+		},
+	}
+}
+
+// LoopbackConfig is for this process calling its own listener. The server's certificate is rarely issued
+// for localhost, so instead of checking the host name the connection is pinned to the certificate
+// currently being served.
+func (r *Reloader) LoopbackConfig() *tls.Config {
+	return &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		// VerifyPeerCertificate below does the verification
+		InsecureSkipVerify: true,
+		VerifyPeerCertificate: func(rawCerts [][]byte, _ [][]*x509.Certificate) error {
+			r.mu.RLock()
+			defer r.mu.RUnlock()
+			if len(rawCerts) == 0 || !bytes.Equal(rawCerts[0], r.cert.Certificate[0]) {
+				return errors.New("loopback peer is not serving this process's certificate")
+			}
+			return nil
+		},
+	}
+}
+
+func (r *Reloader) fileModTimes() ([]time.Time, error) {
+	var modTimes []time.Time
+	for _, file := range []string{r.certFile, r.keyFile, r.clientCAFile} {
+		if file == "" {
+			continue
+		}
+		info, err := os.Stat(file)
+		if err != nil {
+			return nil, err
+		}
+		modTimes = append(modTimes, info.ModTime())
+	}
+	return modTimes, nil
+}
+
+func equalTimes(a, b []time.Time) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !a[i].Equal(b[i]) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,224 @@
+package certs
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+type testCert struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+	der  []byte
+}
+
+// newCert issues a certificate signed by parent, or a self signed CA when parent is nil.
+func newCert(t *testing.T, name string, parent *testCert, usage x509.ExtKeyUsage) *testCert {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: name},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		ExtKeyUsage:  []x509.ExtKeyUsage{usage},
+		DNSNames:     []string{name},
+	}
+
+	signer, signerKey := template, key
+	if parent == nil {
+		template.IsCA = true
+		template.BasicConstraintsValid = true
+		template.KeyUsage = x509.KeyUsageCertSign
+	} else {
+		signer, signerKey = parent.cert, parent.key
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, signer, &key.PublicKey, signerKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &testCert{cert: cert, key: key, der: der}
+}
+
+func (c *testCert) write(t *testing.T, certFile, keyFile string) {
+	t.Helper()
+
+	if err := os.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: c.der}), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if keyFile == "" {
+		return
+	}
+	keyDER, err := x509.MarshalECPrivateKey(c.key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}), 0o600); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func (c *testCert) tlsCertificate() tls.Certificate {
+	return tls.Certificate{Certificate: [][]byte{c.der}, PrivateKey: c.key}
+}
+
+// handshake connects client to a server using config and returns what each side saw.
+func handshake(t *testing.T, server, client *tls.Config) (tls.ConnectionState, tls.ConnectionState, error) {
+	t.Helper()
+
+	// a real connection rather than net.Pipe, which blocks a failing server on writing its alert
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer lis.Close()
+
+	clientConn, err := net.Dial("tcp", lis.Addr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer clientConn.Close()
+	serverConn, err := lis.Accept()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer serverConn.Close()
+
+	serverTLS := tls.Server(serverConn, server)
+	clientTLS := tls.Client(clientConn, client)
+
+	errs := make(chan error, 1)
+	go func() { errs <- serverTLS.Handshake() }()
+	clientErr := clientTLS.Handshake()
+	serverErr := <-errs
+	if clientErr != nil {
+		return tls.ConnectionState{}, tls.ConnectionState{}, clientErr
+	}
+	if serverErr != nil {
+		return tls.ConnectionState{}, tls.ConnectionState{}, serverErr
+	}
+	return serverTLS.ConnectionState(), clientTLS.ConnectionState(), nil
+}
+
+func TestReloader_PicksUpRotatedCertificate(t *testing.T) {
+	dir := t.TempDir()
+	certFile, keyFile := filepath.Join(dir, "server.crt"), filepath.Join(dir, "server.key")
+
+	ca := newCert(t, "ca", nil, x509.ExtKeyUsageServerAuth)
+	first := newCert(t, "first.example.com", ca, x509.ExtKeyUsageServerAuth)
+	first.write(t, certFile, keyFile)
+
+	r, err := NewReloader(certFile, keyFile, "")
+	if err != nil {
+		t.Fatalf("failed to load: %v", err)
+	}
+
+	_, state, err := handshake(t, r.ServerConfig(), r.LoopbackConfig())
+	if err != nil {
+		t.Fatalf("loopback handshake failed: %v", err)
+	}
+	if got := state.PeerCertificates[0].Subject.CommonName; got != "first.example.com" {
+		t.Fatalf("unexpected certificate %s", got)
+	}
+
+	second := newCert(t, "second.example.com", ca, x509.ExtKeyUsageServerAuth)
+	second.write(t, certFile, keyFile)
+	if err := r.Reload(); err != nil {
+		t.Fatalf("reload failed: %v", err)
+	}
+
+	_, state, err = handshake(t, r.ServerConfig(), r.LoopbackConfig())
+	if err != nil {
+		t.Fatalf("handshake after reload failed: %v", err)
+	}
+	if got := state.PeerCertificates[0].Subject.CommonName; got != "second.example.com" {
+		t.Fatalf("expected the rotated certificate, got %s", got)
+	}
+}
+
+func TestReloader_KeepsCertificateWhenReloadFails(t *testing.T) {
+	dir := t.TempDir()
+	certFile, keyFile := filepath.Join(dir, "server.crt"), filepath.Join(dir, "server.key")
+
+	ca := newCert(t, "ca", nil, x509.ExtKeyUsageServerAuth)
+	newCert(t, "server.example.com", ca, x509.ExtKeyUsageServerAuth).write(t, certFile, keyFile)
+
+	r, err := NewReloader(certFile, keyFile, "")
+	if err != nil {
+		t.Fatalf("failed to load: %v", err)
+	}
+
+	// a half written rotation, the new certificate does not match the old key
+	newCert(t, "other.example.com", ca, x509.ExtKeyUsageServerAuth).write(t, certFile, "")
+	if err := r.Reload(); err == nil {
+		t.Fatal("a mismatched key pair should fail to reload")
+	}
+
+	_, state, err := handshake(t, r.ServerConfig(), r.LoopbackConfig())
+	if err != nil {
+		t.Fatalf("handshake failed: %v", err)
+	}
+	if got := state.PeerCertificates[0].Subject.CommonName; got != "server.example.com" {
+		t.Fatalf("expected the previous certificate, got %s", got)
+	}
+}
+
+func TestReloader_VerifiesClientCertificates(t *testing.T) {
+	dir := t.TempDir()
+	certFile, keyFile, caFile := filepath.Join(dir, "server.crt"), filepath.Join(dir, "server.key"), filepath.Join(dir, "clients.crt")
+
+	serverCA := newCert(t, "server-ca", nil, x509.ExtKeyUsageServerAuth)
+	newCert(t, "server.example.com", serverCA, x509.ExtKeyUsageServerAuth).write(t, certFile, keyFile)
+	clientCA := newCert(t, "client-ca", nil, x509.ExtKeyUsageClientAuth)
+	clientCA.write(t, caFile, "")
+
+	r, err := NewReloader(certFile, keyFile, caFile)
+	if err != nil {
+		t.Fatalf("failed to load: %v", err)
+	}
+
+	client := r.LoopbackConfig()
+	client.Certificates = []tls.Certificate{newCert(t, "admin-bot", clientCA, x509.ExtKeyUsageClientAuth).tlsCertificate()}
+	state, _, err := handshake(t, r.ServerConfig(), client)
+	if err != nil {
+		t.Fatalf("handshake with a client certificate failed: %v", err)
+	}
+	if len(state.VerifiedChains) == 0 || state.VerifiedChains[0][0].Subject.CommonName != "admin-bot" {
+		t.Fatal("the client certificate should be verified")
+	}
+
+	state, _, err = handshake(t, r.ServerConfig(), r.LoopbackConfig())
+	if err != nil {
+		t.Fatalf("client certificates should be optional: %v", err)
+	}
+	if len(state.VerifiedChains) != 0 {
+		t.Fatal("no client certificate was presented")
+	}
+
+	untrusted := r.LoopbackConfig()
+	forged := newCert(t, "admin-bot", newCert(t, "other-ca", nil, x509.ExtKeyUsageClientAuth), x509.ExtKeyUsageClientAuth).tlsCertificate()
+	// Go clients skip certificates the server's CA list does not cover, send it anyway
+	untrusted.GetClientCertificate = func(*tls.CertificateRequestInfo) (*tls.Certificate, error) { return &forged, nil }
+	if _, _, err := handshake(t, r.ServerConfig(), untrusted); err == nil {
+		t.Fatal("a client certificate from an unknown CA should be rejected")
+	}
+}
@@ -0,0 +1,112 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func writeConfig(t *testing.T, contents string) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	if err := os.WriteFile(path, []byte(contents), 0o600); err != nil {
+		t.Fatalf("failed to write config: %v", err)
+	}
+	return path
+}
+
+func TestLoad_DefaultsWithoutFile(t *testing.T) {
+	cfg, err := Load("")
+	if err != nil {
+		t.Fatalf("defaults should be valid: %v", err)
+	}
+
+	if cfg.RateLimit.Limit != 1000 || cfg.RateLimit.Window != 5*time.Minute {
+		t.Fatalf("unexpected default rate limit: %+v", cfg.RateLimit)
+	}
+}
+
+func TestLoad_FileOverridesDefaults(t *testing.T) {
+	path := writeConfig(t, `
+port: "6000"
+rate_limit:
+  limit: 50
+  window: 30s
+abuse:
+  auto_suspend: true
+`)
+
+	cfg, err := Load(path)
+	if err != nil {
+		t.Fatalf("config should load: %v", err)
+	}
+
+	if cfg.Port != "6000" {
+		t.Fatalf("expected port 6000, got %q", cfg.Port)
+	}
+	if cfg.RateLimit.Limit != 50 || cfg.RateLimit.Window != 30*time.Second {
+		t.Fatalf("unexpected rate limit: %+v", cfg.RateLimit)
+	}
+	if cfg.RateLimit.OverrideCacheTTL != 30*time.Second {
+		t.Fatalf("unset values should keep their default, got %v", cfg.RateLimit.OverrideCacheTTL)
+	}
+	if !cfg.Abuse.AutoSuspend {
+		t.Fatal("expected auto_suspend to be enabled")
+	}
+}
+
+func TestLoad_EnvOverridesFile(t *testing.T) {
+	path := writeConfig(t, "rate_limit:\n  limit: 50\n")
+	t.Setenv("RATE_LIMIT", "75")
+	t.Setenv("JWT_SECRET", "from-env")
+
+	cfg, err := Load(path)
+	if err != nil {
+		t.Fatalf("config should load: %v", err)
+	}
+
+	if cfg.RateLimit.Limit != 75 {
+		t.Fatalf("expected env limit of 75, got %d", cfg.RateLimit.Limit)
+	}
+	if cfg.JWTSecret != "from-env" {
+		t.Fatalf("expected env jwt secret, got %q", cfg.JWTSecret)
+	}
+}
+
+func TestLoad_RejectsInvalidValues(t *testing.T) {
+	path := writeConfig(t, `
+port: "not-a-port"
+tls:
+  cert_file: server.crt
+http:
+  purge_url: "varnish:6081"
+web:
+  allowed_origins: ["app.example.com"]
+rate_limit:
+  limit: 0
+  max_override: -1
+quotas:
+  max_data_bytes: -1
+collections:
+  orphan_policy: ignore
+webhooks:
+  max_attempts: 0
+health:
+  check_interval: 0s
+  shutdown_delay: 30s
+`)
+
+	_, err := Load(path)
+	if err == nil {
+		t.Fatal("invalid config should be rejected")
+	}
+
+	for _, want := range []string{"port", "tls.cert_file", "http.purge_url", "web.allowed_origins", "rate_limit.limit", "rate_limit.max_override", "quotas", "collections.orphan_policy", "webhooks.max_attempts", "health.check_interval", "health.shutdown_delay"} {
+		if !strings.Contains(err.Error(), want) {
+			t.Fatalf("expected error to mention %q, got %v", want, err)
+		}
+	}
+}
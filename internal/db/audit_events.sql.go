@@ -0,0 +1,34 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: audit_events.sql
+
+package db
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const createAuditEvent = `-- name: CreateAuditEvent :exec
+INSERT INTO audit_events (actor_id, action, collection_uid, details)
+VALUES ($1, $2, $3, $4)
+`
+
+type CreateAuditEventParams struct {
+	ActorID       pgtype.Int4
+	Action        string
+	CollectionUid pgtype.UUID
+	Details       []byte
+}
+
+func (q *Queries) CreateAuditEvent(ctx context.Context, arg CreateAuditEventParams) error {
+	_, err := q.db.Exec(ctx, createAuditEvent,
+		arg.ActorID,
+		arg.Action,
+		arg.CollectionUid,
+		arg.Details,
+	)
+	return err
+}
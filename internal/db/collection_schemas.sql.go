@@ -0,0 +1,126 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: collection_schemas.sql
+
+package db
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const deleteCollectionSchema = `-- name: DeleteCollectionSchema :execrows
+DELETE FROM collection_schemas
+WHERE organization_id = $1 AND type = $2
+`
+
+type DeleteCollectionSchemaParams struct {
+	OrganizationID int32
+	Type           string
+}
+
+func (q *Queries) DeleteCollectionSchema(ctx context.Context, arg DeleteCollectionSchemaParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteCollectionSchema, arg.OrganizationID, arg.Type)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const getCollectionSchema = `-- name: GetCollectionSchema :one
+SELECT id, organization_id, type, schema, created_by, created_at, updated_at
+FROM collection_schemas
+WHERE organization_id = $1 AND type = $2
+`
+
+type GetCollectionSchemaParams struct {
+	OrganizationID int32
+	Type           string
+}
+
+func (q *Queries) GetCollectionSchema(ctx context.Context, arg GetCollectionSchemaParams) (CollectionSchema, error) {
+	row := q.db.QueryRow(ctx, getCollectionSchema, arg.OrganizationID, arg.Type)
+	var i CollectionSchema
+	err := row.Scan(
+		&i.ID,
+		&i.OrganizationID,
+		&i.Type,
+		&i.Schema,
+		&i.CreatedBy,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const listCollectionSchemas = `-- name: ListCollectionSchemas :many
+SELECT id, organization_id, type, schema, created_by, created_at, updated_at
+FROM collection_schemas
+WHERE organization_id = $1
+ORDER BY type
+`
+
+func (q *Queries) ListCollectionSchemas(ctx context.Context, organizationID int32) ([]CollectionSchema, error) {
+	rows, err := q.db.Query(ctx, listCollectionSchemas, organizationID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []CollectionSchema
+	for rows.Next() {
+		var i CollectionSchema
+		if err := rows.Scan(
+			&i.ID,
+			&i.OrganizationID,
+			&i.Type,
+			&i.Schema,
+			&i.CreatedBy,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const upsertCollectionSchema = `-- name: UpsertCollectionSchema :one
+INSERT INTO collection_schemas (organization_id, type, schema, created_by)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (organization_id, type) DO UPDATE
+SET schema = EXCLUDED.schema, updated_at = now()
+RETURNING id, organization_id, type, schema, created_by, created_at, updated_at
+`
+
+type UpsertCollectionSchemaParams struct {
+	OrganizationID int32
+	Type           string
+	Schema         []byte
+	CreatedBy      pgtype.Int4
+}
+
+func (q *Queries) UpsertCollectionSchema(ctx context.Context, arg UpsertCollectionSchemaParams) (CollectionSchema, error) {
+	row := q.db.QueryRow(ctx, upsertCollectionSchema,
+		arg.OrganizationID,
+		arg.Type,
+		arg.Schema,
+		arg.CreatedBy,
+	)
+	var i CollectionSchema
+	err := row.Scan(
+		&i.ID,
+		&i.OrganizationID,
+		&i.Type,
+		&i.Schema,
+		&i.CreatedBy,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
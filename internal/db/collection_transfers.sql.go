@@ -0,0 +1,191 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: collection_transfers.sql
+
+package db
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const createCollectionTransfer = `-- name: CreateCollectionTransfer :one
+INSERT INTO collection_transfers (collection_id, to_user_id, to_organization_id, requested_by)
+VALUES ($1, $2, $3, $4)
+RETURNING id, uid, collection_id, to_user_id, to_organization_id, status, requested_by, resolved_by, created_at, resolved_at
+`
+
+type CreateCollectionTransferParams struct {
+	CollectionID     int32
+	ToUserID         pgtype.Int4
+	ToOrganizationID pgtype.Int4
+	RequestedBy      pgtype.Int4
+}
+
+func (q *Queries) CreateCollectionTransfer(ctx context.Context, arg CreateCollectionTransferParams) (CollectionTransfer, error) {
+	row := q.db.QueryRow(ctx, createCollectionTransfer,
+		arg.CollectionID,
+		arg.ToUserID,
+		arg.ToOrganizationID,
+		arg.RequestedBy,
+	)
+	var i CollectionTransfer
+	err := row.Scan(
+		&i.ID,
+		&i.Uid,
+		&i.CollectionID,
+		&i.ToUserID,
+		&i.ToOrganizationID,
+		&i.Status,
+		&i.RequestedBy,
+		&i.ResolvedBy,
+		&i.CreatedAt,
+		&i.ResolvedAt,
+	)
+	return i, err
+}
+
+const getCollectionTransferByUIDForUpdate = `-- name: GetCollectionTransferByUIDForUpdate :one
+SELECT id, uid, collection_id, to_user_id, to_organization_id, status, requested_by, resolved_by, created_at, resolved_at
+FROM collection_transfers
+WHERE uid = $1
+FOR UPDATE
+`
+
+func (q *Queries) GetCollectionTransferByUIDForUpdate(ctx context.Context, uid pgtype.UUID) (CollectionTransfer, error) {
+	row := q.db.QueryRow(ctx, getCollectionTransferByUIDForUpdate, uid)
+	var i CollectionTransfer
+	err := row.Scan(
+		&i.ID,
+		&i.Uid,
+		&i.CollectionID,
+		&i.ToUserID,
+		&i.ToOrganizationID,
+		&i.Status,
+		&i.RequestedBy,
+		&i.ResolvedBy,
+		&i.CreatedAt,
+		&i.ResolvedAt,
+	)
+	return i, err
+}
+
+const listPendingTransfersForUser = `-- name: ListPendingTransfersForUser :many
+SELECT t.id, t.uid, t.collection_id, t.to_user_id, t.to_organization_id, t.status, t.requested_by, t.resolved_by, t.created_at, t.resolved_at
+FROM collection_transfers t
+WHERE t.status = 'pending'
+  AND (
+    t.to_user_id = $1
+    OR t.to_organization_id IN (
+        SELECT om.organization_id FROM organization_members om WHERE om.user_id = $1 AND om.role = 'admin'
+    )
+  )
+ORDER BY t.id DESC
+`
+
+// Transfers the user can accept, either addressed to them or to an organization they administer.
+func (q *Queries) ListPendingTransfersForUser(ctx context.Context, userID pgtype.Int4) ([]CollectionTransfer, error) {
+	rows, err := q.db.Query(ctx, listPendingTransfersForUser, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []CollectionTransfer
+	for rows.Next() {
+		var i CollectionTransfer
+		if err := rows.Scan(
+			&i.ID,
+			&i.Uid,
+			&i.CollectionID,
+			&i.ToUserID,
+			&i.ToOrganizationID,
+			&i.Status,
+			&i.RequestedBy,
+			&i.ResolvedBy,
+			&i.CreatedAt,
+			&i.ResolvedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const resolveCollectionTransfer = `-- name: ResolveCollectionTransfer :one
+UPDATE collection_transfers
+SET status = $2, resolved_by = $3, resolved_at = now()
+WHERE id = $1 AND status = 'pending'
+RETURNING id, uid, collection_id, to_user_id, to_organization_id, status, requested_by, resolved_by, created_at, resolved_at
+`
+
+type ResolveCollectionTransferParams struct {
+	ID         int32
+	Status     TransferStatus
+	ResolvedBy pgtype.Int4
+}
+
+func (q *Queries) ResolveCollectionTransfer(ctx context.Context, arg ResolveCollectionTransferParams) (CollectionTransfer, error) {
+	row := q.db.QueryRow(ctx, resolveCollectionTransfer, arg.ID, arg.Status, arg.ResolvedBy)
+	var i CollectionTransfer
+	err := row.Scan(
+		&i.ID,
+		&i.Uid,
+		&i.CollectionID,
+		&i.ToUserID,
+		&i.ToOrganizationID,
+		&i.Status,
+		&i.RequestedBy,
+		&i.ResolvedBy,
+		&i.CreatedAt,
+		&i.ResolvedAt,
+	)
+	return i, err
+}
+
+const transferCollectionOwnership = `-- name: TransferCollectionOwnership :one
+UPDATE collections
+SET owner_id = $2, organization_id = $3, access_level = $4, updated_at = now(), revision = revision + 1
+WHERE id = $1
+RETURNING id, uid, name, data, access_level, owner_id, organization_id, created_at, updated_at, revision, version_retention, deleted_at, labels, source_collection_uid, quarantined_at
+`
+
+type TransferCollectionOwnershipParams struct {
+	ID             int32
+	OwnerID        pgtype.Int4
+	OrganizationID pgtype.Int4
+	AccessLevel    AccessLevel
+}
+
+func (q *Queries) TransferCollectionOwnership(ctx context.Context, arg TransferCollectionOwnershipParams) (Collection, error) {
+	row := q.db.QueryRow(ctx, transferCollectionOwnership,
+		arg.ID,
+		arg.OwnerID,
+		arg.OrganizationID,
+		arg.AccessLevel,
+	)
+	var i Collection
+	err := row.Scan(
+		&i.ID,
+		&i.Uid,
+		&i.Name,
+		&i.Data,
+		&i.AccessLevel,
+		&i.OwnerID,
+		&i.OrganizationID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Revision,
+		&i.VersionRetention,
+		&i.DeletedAt,
+		&i.Labels,
+		&i.SourceCollectionUid,
+		&i.QuarantinedAt,
+	)
+	return i, err
+}
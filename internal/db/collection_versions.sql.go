@@ -0,0 +1,125 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: collection_versions.sql
+
+package db
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const createCollectionVersion = `-- name: CreateCollectionVersion :exec
+INSERT INTO collection_versions (collection_id, revision, name, data, superseded_by)
+VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (collection_id, revision) DO NOTHING
+`
+
+type CreateCollectionVersionParams struct {
+	CollectionID int32
+	Revision     int64
+	Name         string
+	Data         []byte
+	SupersededBy pgtype.Int4
+}
+
+func (q *Queries) CreateCollectionVersion(ctx context.Context, arg CreateCollectionVersionParams) error {
+	_, err := q.db.Exec(ctx, createCollectionVersion,
+		arg.CollectionID,
+		arg.Revision,
+		arg.Name,
+		arg.Data,
+		arg.SupersededBy,
+	)
+	return err
+}
+
+const getCollectionVersion = `-- name: GetCollectionVersion :one
+SELECT id, collection_id, revision, name, data, superseded_by, created_at
+FROM collection_versions
+WHERE collection_id = $1 AND revision = $2
+`
+
+type GetCollectionVersionParams struct {
+	CollectionID int32
+	Revision     int64
+}
+
+func (q *Queries) GetCollectionVersion(ctx context.Context, arg GetCollectionVersionParams) (CollectionVersion, error) {
+	row := q.db.QueryRow(ctx, getCollectionVersion, arg.CollectionID, arg.Revision)
+	var i CollectionVersion
+	err := row.Scan(
+		&i.ID,
+		&i.CollectionID,
+		&i.Revision,
+		&i.Name,
+		&i.Data,
+		&i.SupersededBy,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const listCollectionVersions = `-- name: ListCollectionVersions :many
+SELECT id, collection_id, revision, name, data, superseded_by, created_at
+FROM collection_versions
+WHERE collection_id = $1 AND ($3::bigint IS NULL OR revision < $3)
+ORDER BY revision DESC
+LIMIT $2
+`
+
+type ListCollectionVersionsParams struct {
+	CollectionID   int32
+	Limit          int32
+	BeforeRevision pgtype.Int8
+}
+
+func (q *Queries) ListCollectionVersions(ctx context.Context, arg ListCollectionVersionsParams) ([]CollectionVersion, error) {
+	rows, err := q.db.Query(ctx, listCollectionVersions, arg.CollectionID, arg.Limit, arg.BeforeRevision)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []CollectionVersion
+	for rows.Next() {
+		var i CollectionVersion
+		if err := rows.Scan(
+			&i.ID,
+			&i.CollectionID,
+			&i.Revision,
+			&i.Name,
+			&i.Data,
+			&i.SupersededBy,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const pruneCollectionVersions = `-- name: PruneCollectionVersions :exec
+DELETE FROM collection_versions
+WHERE collection_versions.collection_id = $1 AND collection_versions.revision NOT IN (
+    SELECT cv.revision FROM collection_versions cv
+    WHERE cv.collection_id = $1
+    ORDER BY cv.revision DESC
+    LIMIT $2
+)
+`
+
+type PruneCollectionVersionsParams struct {
+	CollectionID int32
+	Limit        int32
+}
+
+func (q *Queries) PruneCollectionVersions(ctx context.Context, arg PruneCollectionVersionsParams) error {
+	_, err := q.db.Exec(ctx, pruneCollectionVersions, arg.CollectionID, arg.Limit)
+	return err
+}
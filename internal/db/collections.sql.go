@@ -14,7 +14,7 @@ import (
 const checkOrgOwnsCollection = `-- name: CheckOrgOwnsCollection :one
 SELECT c.id FROM collections c
 JOIN organization_members om ON om.organization_id = c.organization_id
-WHERE c.id = $1 AND om.user_id = $2 AND c.access_level = 'organization'
+WHERE c.id = $1 AND om.user_id = $2 AND c.access_level = 'organization' AND c.deleted_at IS NULL
 `
 
 type CheckOrgOwnsCollectionParams struct {
@@ -31,7 +31,7 @@ func (q *Queries) CheckOrgOwnsCollection(ctx context.Context, arg CheckOrgOwnsCo
 
 const checkUserOwnsCollection = `-- name: CheckUserOwnsCollection :one
 SELECT id FROM collections
-WHERE id = $1 AND owner_id = $2
+WHERE id = $1 AND owner_id = $2 AND deleted_at IS NULL
 `
 
 type CheckUserOwnsCollectionParams struct {
@@ -47,17 +47,19 @@ func (q *Queries) CheckUserOwnsCollection(ctx context.Context, arg CheckUserOwns
 }
 
 const createCollection = `-- name: CreateCollection :one
-INSERT INTO collections (name, data, access_level, owner_id, organization_id)
-VALUES ($1, $2, $3, $4, $5)
-RETURNING id, uid, name, data, access_level, owner_id, organization_id, created_at, updated_at
+INSERT INTO collections (name, data, access_level, owner_id, organization_id, labels, source_collection_uid)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+RETURNING id, uid, name, data, access_level, owner_id, organization_id, created_at, updated_at, revision, version_retention, deleted_at, labels, source_collection_uid, quarantined_at
 `
 
 type CreateCollectionParams struct {
-	Name           string
-	Data           []byte
-	AccessLevel    AccessLevel
-	OwnerID        pgtype.Int4
-	OrganizationID pgtype.Int4
+	Name                string
+	Data                []byte
+	AccessLevel         AccessLevel
+	OwnerID             pgtype.Int4
+	OrganizationID      pgtype.Int4
+	Labels              []byte
+	SourceCollectionUid pgtype.UUID
 }
 
 func (q *Queries) CreateCollection(ctx context.Context, arg CreateCollectionParams) (Collection, error) {
@@ -67,6 +69,8 @@ func (q *Queries) CreateCollection(ctx context.Context, arg CreateCollectionPara
 		arg.AccessLevel,
 		arg.OwnerID,
 		arg.OrganizationID,
+		arg.Labels,
+		arg.SourceCollectionUid,
 	)
 	var i Collection
 	err := row.Scan(
@@ -79,28 +83,54 @@ func (q *Queries) CreateCollection(ctx context.Context, arg CreateCollectionPara
 		&i.OrganizationID,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Revision,
+		&i.VersionRetention,
+		&i.DeletedAt,
+		&i.Labels,
+		&i.SourceCollectionUid,
+		&i.QuarantinedAt,
 	)
 	return i, err
 }
 
-const deleteCollection = `-- name: DeleteCollection :exec
-DELETE FROM collections
-WHERE id = $1
+const getCollectionByID = `-- name: GetCollectionByID :one
+SELECT id, uid, name, data, access_level, owner_id, organization_id, created_at, updated_at, revision, version_retention, deleted_at, labels, source_collection_uid, quarantined_at
+FROM collections
+WHERE id = $1 AND deleted_at IS NULL
 `
 
-func (q *Queries) DeleteCollection(ctx context.Context, id int32) error {
-	_, err := q.db.Exec(ctx, deleteCollection, id)
-	return err
+func (q *Queries) GetCollectionByID(ctx context.Context, id int32) (Collection, error) {
+	row := q.db.QueryRow(ctx, getCollectionByID, id)
+	var i Collection
+	err := row.Scan(
+		&i.ID,
+		&i.Uid,
+		&i.Name,
+		&i.Data,
+		&i.AccessLevel,
+		&i.OwnerID,
+		&i.OrganizationID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Revision,
+		&i.VersionRetention,
+		&i.DeletedAt,
+		&i.Labels,
+		&i.SourceCollectionUid,
+		&i.QuarantinedAt,
+	)
+	return i, err
 }
 
-const getCollectionByID = `-- name: GetCollectionByID :one
-SELECT id, uid, name, data, access_level, owner_id, organization_id, created_at, updated_at
+const getCollectionByIDForUpdate = `-- name: GetCollectionByIDForUpdate :one
+SELECT id, uid, name, data, access_level, owner_id, organization_id, created_at, updated_at, revision, version_retention, deleted_at, labels, source_collection_uid, quarantined_at
 FROM collections
-WHERE id = $1
+WHERE id = $1 AND deleted_at IS NULL
+FOR UPDATE
 `
 
-func (q *Queries) GetCollectionByID(ctx context.Context, id int32) (Collection, error) {
-	row := q.db.QueryRow(ctx, getCollectionByID, id)
+func (q *Queries) GetCollectionByIDForUpdate(ctx context.Context, id int32) (Collection, error) {
+	row := q.db.QueryRow(ctx, getCollectionByIDForUpdate, id)
 	var i Collection
 	err := row.Scan(
 		&i.ID,
@@ -112,14 +142,20 @@ func (q *Queries) GetCollectionByID(ctx context.Context, id int32) (Collection,
 		&i.OrganizationID,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Revision,
+		&i.VersionRetention,
+		&i.DeletedAt,
+		&i.Labels,
+		&i.SourceCollectionUid,
+		&i.QuarantinedAt,
 	)
 	return i, err
 }
 
 const getCollectionByUID = `-- name: GetCollectionByUID :one
-SELECT id, uid, name, data, access_level, owner_id, organization_id, created_at, updated_at
+SELECT id, uid, name, data, access_level, owner_id, organization_id, created_at, updated_at, revision, version_retention, deleted_at, labels, source_collection_uid, quarantined_at
 FROM collections
-WHERE uid = $1
+WHERE uid = $1 AND deleted_at IS NULL
 `
 
 func (q *Queries) GetCollectionByUID(ctx context.Context, uid pgtype.UUID) (Collection, error) {
@@ -135,23 +171,331 @@ func (q *Queries) GetCollectionByUID(ctx context.Context, uid pgtype.UUID) (Coll
 		&i.OrganizationID,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Revision,
+		&i.VersionRetention,
+		&i.DeletedAt,
+		&i.Labels,
+		&i.SourceCollectionUid,
+		&i.QuarantinedAt,
+	)
+	return i, err
+}
+
+const getCollectionUIDByID = `-- name: GetCollectionUIDByID :one
+SELECT uid FROM collections
+WHERE id = $1
+`
+
+// Includes collections in the trash, for showing references to them.
+func (q *Queries) GetCollectionUIDByID(ctx context.Context, id int32) (pgtype.UUID, error) {
+	row := q.db.QueryRow(ctx, getCollectionUIDByID, id)
+	var uid pgtype.UUID
+	err := row.Scan(&uid)
+	return uid, err
+}
+
+const getDeletedCollectionByUID = `-- name: GetDeletedCollectionByUID :one
+SELECT id, uid, name, data, access_level, owner_id, organization_id, created_at, updated_at, revision, version_retention, deleted_at, labels, source_collection_uid, quarantined_at
+FROM collections
+WHERE uid = $1 AND deleted_at IS NOT NULL
+`
+
+func (q *Queries) GetDeletedCollectionByUID(ctx context.Context, uid pgtype.UUID) (Collection, error) {
+	row := q.db.QueryRow(ctx, getDeletedCollectionByUID, uid)
+	var i Collection
+	err := row.Scan(
+		&i.ID,
+		&i.Uid,
+		&i.Name,
+		&i.Data,
+		&i.AccessLevel,
+		&i.OwnerID,
+		&i.OrganizationID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Revision,
+		&i.VersionRetention,
+		&i.DeletedAt,
+		&i.Labels,
+		&i.SourceCollectionUid,
+		&i.QuarantinedAt,
+	)
+	return i, err
+}
+
+const listDeletedCollectionsForUser = `-- name: ListDeletedCollectionsForUser :many
+SELECT id, uid, name, data, access_level, owner_id, organization_id, created_at, updated_at, revision, version_retention, deleted_at, labels, source_collection_uid, quarantined_at
+FROM collections c
+WHERE c.deleted_at IS NOT NULL
+  AND (
+    c.owner_id = $1
+    OR (c.access_level = 'organization' AND c.organization_id IN (
+        SELECT om.organization_id FROM organization_members om WHERE om.user_id = $1
+    ))
+  )
+  AND ($2::jsonb IS NULL OR c.labels @> $2)
+  AND ($3::jsonb IS NULL OR NOT EXISTS (
+    SELECT 1 FROM jsonb_array_elements($3) ne WHERE c.labels @> ne.value
+  ))
+  AND ($4::text[] IS NULL OR c.labels ?& $4)
+  AND ($5::text[] IS NULL OR NOT c.labels ?| $5)
+  AND ($6::int IS NULL OR c.id < $6)
+ORDER BY c.id DESC
+LIMIT $7
+`
+
+type ListDeletedCollectionsForUserParams struct {
+	UserID         pgtype.Int4
+	LabelEquals    []byte
+	LabelNotEquals []byte
+	LabelExists    []string
+	LabelMissing   []string
+	BeforeID       pgtype.Int4
+	PageSize       int32
+}
+
+func (q *Queries) ListDeletedCollectionsForUser(ctx context.Context, arg ListDeletedCollectionsForUserParams) ([]Collection, error) {
+	rows, err := q.db.Query(ctx, listDeletedCollectionsForUser,
+		arg.UserID,
+		arg.LabelEquals,
+		arg.LabelNotEquals,
+		arg.LabelExists,
+		arg.LabelMissing,
+		arg.BeforeID,
+		arg.PageSize,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Collection
+	for rows.Next() {
+		var i Collection
+		if err := rows.Scan(
+			&i.ID,
+			&i.Uid,
+			&i.Name,
+			&i.Data,
+			&i.AccessLevel,
+			&i.OwnerID,
+			&i.OrganizationID,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.Revision,
+			&i.VersionRetention,
+			&i.DeletedAt,
+			&i.Labels,
+			&i.SourceCollectionUid,
+			&i.QuarantinedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const purgeDeletedCollections = `-- name: PurgeDeletedCollections :execrows
+DELETE FROM collections
+WHERE deleted_at IS NOT NULL AND deleted_at < $1
+`
+
+func (q *Queries) PurgeDeletedCollections(ctx context.Context, deletedAt pgtype.Timestamptz) (int64, error) {
+	result, err := q.db.Exec(ctx, purgeDeletedCollections, deletedAt)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const searchCollectionsForUser = `-- name: SearchCollectionsForUser :many
+SELECT id, uid, name, data, access_level, owner_id, organization_id, created_at, updated_at, revision, version_retention, deleted_at, labels, source_collection_uid, quarantined_at
+FROM collections c
+WHERE c.deleted_at IS NULL
+  AND (
+    c.owner_id = $1
+    OR (c.access_level = 'organization' AND c.organization_id IN (
+        SELECT om.organization_id FROM organization_members om WHERE om.user_id = $1
+    ))
+  )
+  AND ($2::text IS NULL OR to_tsvector('simple', c.name) @@ websearch_to_tsquery('simple', $2))
+  AND ($3::jsonb IS NULL OR c.data @> $3)
+  AND ($4::text IS NULL OR c.data @@ $4::text::jsonpath)
+  AND ($5::jsonb IS NULL OR c.labels @> $5)
+  AND ($6::jsonb IS NULL OR NOT EXISTS (
+    SELECT 1 FROM jsonb_array_elements($6) ne WHERE c.labels @> ne.value
+  ))
+  AND ($7::text[] IS NULL OR c.labels ?& $7)
+  AND ($8::text[] IS NULL OR NOT c.labels ?| $8)
+  AND ($9::int IS NULL OR c.id < $9)
+ORDER BY c.id DESC
+LIMIT $10
+`
+
+type SearchCollectionsForUserParams struct {
+	UserID         pgtype.Int4
+	Query          pgtype.Text
+	DataContains   []byte
+	DataPath       pgtype.Text
+	LabelEquals    []byte
+	LabelNotEquals []byte
+	LabelExists    []string
+	LabelMissing   []string
+	BeforeID       pgtype.Int4
+	PageSize       int32
+}
+
+func (q *Queries) SearchCollectionsForUser(ctx context.Context, arg SearchCollectionsForUserParams) ([]Collection, error) {
+	rows, err := q.db.Query(ctx, searchCollectionsForUser,
+		arg.UserID,
+		arg.Query,
+		arg.DataContains,
+		arg.DataPath,
+		arg.LabelEquals,
+		arg.LabelNotEquals,
+		arg.LabelExists,
+		arg.LabelMissing,
+		arg.BeforeID,
+		arg.PageSize,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Collection
+	for rows.Next() {
+		var i Collection
+		if err := rows.Scan(
+			&i.ID,
+			&i.Uid,
+			&i.Name,
+			&i.Data,
+			&i.AccessLevel,
+			&i.OwnerID,
+			&i.OrganizationID,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.Revision,
+			&i.VersionRetention,
+			&i.DeletedAt,
+			&i.Labels,
+			&i.SourceCollectionUid,
+			&i.QuarantinedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const softDeleteCollection = `-- name: SoftDeleteCollection :execrows
+UPDATE collections
+SET deleted_at = now()
+WHERE id = $1 AND deleted_at IS NULL AND ($2::bigint IS NULL OR revision = $2)
+`
+
+type SoftDeleteCollectionParams struct {
+	ID               int32
+	ExpectedRevision pgtype.Int8
+}
+
+func (q *Queries) SoftDeleteCollection(ctx context.Context, arg SoftDeleteCollectionParams) (int64, error) {
+	result, err := q.db.Exec(ctx, softDeleteCollection, arg.ID, arg.ExpectedRevision)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const touchCollection = `-- name: TouchCollection :one
+UPDATE collections
+SET updated_at = now(), revision = revision + 1
+WHERE id = $1 AND ($2::bigint IS NULL OR revision = $2)
+RETURNING id, uid, name, data, access_level, owner_id, organization_id, created_at, updated_at, revision, version_retention, deleted_at, labels, source_collection_uid, quarantined_at
+`
+
+type TouchCollectionParams struct {
+	ID               int32
+	ExpectedRevision pgtype.Int8
+}
+
+// Bumps the revision for changes stored outside the collections row, such as the payload.
+func (q *Queries) TouchCollection(ctx context.Context, arg TouchCollectionParams) (Collection, error) {
+	row := q.db.QueryRow(ctx, touchCollection, arg.ID, arg.ExpectedRevision)
+	var i Collection
+	err := row.Scan(
+		&i.ID,
+		&i.Uid,
+		&i.Name,
+		&i.Data,
+		&i.AccessLevel,
+		&i.OwnerID,
+		&i.OrganizationID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Revision,
+		&i.VersionRetention,
+		&i.DeletedAt,
+		&i.Labels,
+		&i.SourceCollectionUid,
+		&i.QuarantinedAt,
+	)
+	return i, err
+}
+
+const undeleteCollection = `-- name: UndeleteCollection :one
+UPDATE collections
+SET deleted_at = NULL
+WHERE id = $1 AND deleted_at IS NOT NULL
+RETURNING id, uid, name, data, access_level, owner_id, organization_id, created_at, updated_at, revision, version_retention, deleted_at, labels, source_collection_uid, quarantined_at
+`
+
+func (q *Queries) UndeleteCollection(ctx context.Context, id int32) (Collection, error) {
+	row := q.db.QueryRow(ctx, undeleteCollection, id)
+	var i Collection
+	err := row.Scan(
+		&i.ID,
+		&i.Uid,
+		&i.Name,
+		&i.Data,
+		&i.AccessLevel,
+		&i.OwnerID,
+		&i.OrganizationID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Revision,
+		&i.VersionRetention,
+		&i.DeletedAt,
+		&i.Labels,
+		&i.SourceCollectionUid,
+		&i.QuarantinedAt,
 	)
 	return i, err
 }
 
 const updateCollection = `-- name: UpdateCollection :one
 UPDATE collections
-SET name = $2, data = $3, access_level = $4, organization_id = $5, updated_at = now()
-WHERE id = $1
-RETURNING id, uid, name, data, access_level, owner_id, organization_id, created_at, updated_at
+SET name = $2, data = $3, access_level = $4, organization_id = $5, version_retention = $6, labels = $7, updated_at = now(), revision = revision + 1
+WHERE id = $1 AND ($8::bigint IS NULL OR revision = $8)
+RETURNING id, uid, name, data, access_level, owner_id, organization_id, created_at, updated_at, revision, version_retention, deleted_at, labels, source_collection_uid, quarantined_at
 `
 
 type UpdateCollectionParams struct {
-	ID             int32
-	Name           string
-	Data           []byte
-	AccessLevel    AccessLevel
-	OrganizationID pgtype.Int4
+	ID               int32
+	Name             string
+	Data             []byte
+	AccessLevel      AccessLevel
+	OrganizationID   pgtype.Int4
+	VersionRetention pgtype.Int4
+	Labels           []byte
+	ExpectedRevision pgtype.Int8
 }
 
 func (q *Queries) UpdateCollection(ctx context.Context, arg UpdateCollectionParams) (Collection, error) {
@@ -161,6 +505,9 @@ func (q *Queries) UpdateCollection(ctx context.Context, arg UpdateCollectionPara
 		arg.Data,
 		arg.AccessLevel,
 		arg.OrganizationID,
+		arg.VersionRetention,
+		arg.Labels,
+		arg.ExpectedRevision,
 	)
 	var i Collection
 	err := row.Scan(
@@ -173,6 +520,12 @@ func (q *Queries) UpdateCollection(ctx context.Context, arg UpdateCollectionPara
 		&i.OrganizationID,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Revision,
+		&i.VersionRetention,
+		&i.DeletedAt,
+		&i.Labels,
+		&i.SourceCollectionUid,
+		&i.QuarantinedAt,
 	)
 	return i, err
 }
@@ -54,18 +54,215 @@ func (ns NullAccessLevel) Value() (driver.Value, error) {
 	return string(ns.AccessLevel), nil
 }
 
+type OrganizationRole string
+
+const (
+	OrganizationRoleMember OrganizationRole = "member"
+	OrganizationRoleAdmin  OrganizationRole = "admin"
+)
+
+func (e *OrganizationRole) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = OrganizationRole(s)
+	case string:
+		*e = OrganizationRole(s)
+	default:
+		return fmt.Errorf("unsupported scan type for OrganizationRole: %T", src)
+	}
+	return nil
+}
+
+type NullOrganizationRole struct {
+	OrganizationRole OrganizationRole
+	Valid            bool // Valid is true if OrganizationRole is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullOrganizationRole) Scan(value interface{}) error {
+	if value == nil {
+		ns.OrganizationRole, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.OrganizationRole.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullOrganizationRole) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.OrganizationRole), nil
+}
+
+type TransferStatus string
+
+const (
+	TransferStatusPending  TransferStatus = "pending"
+	TransferStatusAccepted TransferStatus = "accepted"
+	TransferStatusDeclined TransferStatus = "declined"
+)
+
+func (e *TransferStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = TransferStatus(s)
+	case string:
+		*e = TransferStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for TransferStatus: %T", src)
+	}
+	return nil
+}
+
+type NullTransferStatus struct {
+	TransferStatus TransferStatus
+	Valid          bool // Valid is true if TransferStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullTransferStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.TransferStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.TransferStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullTransferStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.TransferStatus), nil
+}
+
+type WebhookDeliveryStatus string
+
+const (
+	WebhookDeliveryStatusPending   WebhookDeliveryStatus = "pending"
+	WebhookDeliveryStatusDelivered WebhookDeliveryStatus = "delivered"
+	WebhookDeliveryStatusDead      WebhookDeliveryStatus = "dead"
+)
+
+func (e *WebhookDeliveryStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = WebhookDeliveryStatus(s)
+	case string:
+		*e = WebhookDeliveryStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for WebhookDeliveryStatus: %T", src)
+	}
+	return nil
+}
+
+type NullWebhookDeliveryStatus struct {
+	WebhookDeliveryStatus WebhookDeliveryStatus
+	Valid                 bool // Valid is true if WebhookDeliveryStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullWebhookDeliveryStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.WebhookDeliveryStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.WebhookDeliveryStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullWebhookDeliveryStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.WebhookDeliveryStatus), nil
+}
+
+type AuditEvent struct {
+	ID            int64
+	ActorID       pgtype.Int4
+	Action        string
+	CollectionUid pgtype.UUID
+	Details       []byte
+	CreatedAt     pgtype.Timestamptz
+}
+
+type BlobChunk struct {
+	Hash      []byte
+	Data      []byte
+	CreatedAt pgtype.Timestamptz
+}
+
 type Collection struct {
+	ID                  int32
+	Uid                 pgtype.UUID
+	Name                string
+	Data                []byte
+	AccessLevel         AccessLevel
+	OwnerID             pgtype.Int4
+	OrganizationID      pgtype.Int4
+	CreatedAt           pgtype.Timestamptz
+	UpdatedAt           pgtype.Timestamptz
+	Revision            int64
+	VersionRetention    pgtype.Int4
+	DeletedAt           pgtype.Timestamptz
+	Labels              []byte
+	SourceCollectionUid pgtype.UUID
+	QuarantinedAt       pgtype.Timestamptz
+}
+
+type CollectionPayload struct {
+	CollectionID int32
+	ContentType  string
+	Size         int64
+	Sha256       string
+	ChunkSize    int32
+	UpdatedAt    pgtype.Timestamptz
+}
+
+type CollectionPayloadChunk struct {
+	CollectionID int32
+	Seq          int32
+	ChunkHash    []byte
+}
+
+type CollectionSchema struct {
 	ID             int32
-	Uid            pgtype.UUID
-	Name           string
-	Data           []byte
-	AccessLevel    AccessLevel
-	OwnerID        pgtype.Int4
-	OrganizationID pgtype.Int4
+	OrganizationID int32
+	Type           string
+	Schema         []byte
+	CreatedBy      pgtype.Int4
 	CreatedAt      pgtype.Timestamptz
 	UpdatedAt      pgtype.Timestamptz
 }
 
+type CollectionTransfer struct {
+	ID               int32
+	Uid              pgtype.UUID
+	CollectionID     int32
+	ToUserID         pgtype.Int4
+	ToOrganizationID pgtype.Int4
+	Status           TransferStatus
+	RequestedBy      pgtype.Int4
+	ResolvedBy       pgtype.Int4
+	CreatedAt        pgtype.Timestamptz
+	ResolvedAt       pgtype.Timestamptz
+}
+
+type CollectionVersion struct {
+	ID           int32
+	CollectionID int32
+	Revision     int64
+	Name         string
+	Data         []byte
+	SupersededBy pgtype.Int4
+	CreatedAt    pgtype.Timestamptz
+}
+
 type Organization struct {
 	ID        int32
 	Uid       pgtype.UUID
@@ -79,17 +276,45 @@ type OrganizationMember struct {
 	UserID         int32
 	OrganizationID int32
 	CreatedAt      pgtype.Timestamptz
+	Role           OrganizationRole
 }
 
-type ShareLink struct {
+type OutboxEvent struct {
+	ID             int64
+	Uid            pgtype.UUID
+	EventType      string
+	OrganizationID pgtype.Int4
+	Payload        []byte
+	CreatedAt      pgtype.Timestamptz
+	DispatchedAt   pgtype.Timestamptz
+}
+
+type PayloadUpload struct {
 	ID           int32
-	Token        string
 	CollectionID int32
-	AccessCount  int32
-	CreatedBy    int32
 	CreatedAt    pgtype.Timestamptz
 }
 
+type PayloadUploadChunk struct {
+	UploadID  int32
+	ChunkHash []byte
+}
+
+type ShareLink struct {
+	ID                     int32
+	Token                  string
+	CollectionID           int32
+	AccessCount            int32
+	CreatedBy              int32
+	CreatedAt              pgtype.Timestamptz
+	RateLimit              pgtype.Int4
+	RateLimitWindowSeconds pgtype.Int4
+	SuspendedAt            pgtype.Timestamptz
+	SuspendedReason        pgtype.Text
+	CacheMaxAgeSeconds     pgtype.Int4
+	ExpiresAt              pgtype.Timestamptz
+}
+
 type User struct {
 	ID        int32
 	Uid       pgtype.UUID
@@ -97,3 +322,26 @@ type User struct {
 	CreatedAt pgtype.Timestamptz
 	UpdatedAt pgtype.Timestamptz
 }
+
+type WebhookDelivery struct {
+	ID             int32
+	SubscriptionID int32
+	EventID        int64
+	Status         WebhookDeliveryStatus
+	Attempts       int32
+	NextAttemptAt  pgtype.Timestamptz
+	LastError      pgtype.Text
+	DeliveredAt    pgtype.Timestamptz
+	CreatedAt      pgtype.Timestamptz
+}
+
+type WebhookSubscription struct {
+	ID             int32
+	Uid            pgtype.UUID
+	OrganizationID int32
+	Url            string
+	Secret         string
+	EventTypes     []string
+	CreatedBy      pgtype.Int4
+	CreatedAt      pgtype.Timestamptz
+}
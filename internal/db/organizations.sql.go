@@ -12,17 +12,18 @@ import (
 )
 
 const addOrganizationMember = `-- name: AddOrganizationMember :exec
-INSERT INTO organization_members (user_id, organization_id)
-VALUES ($1, $2)
+INSERT INTO organization_members (user_id, organization_id, role)
+VALUES ($1, $2, $3)
 `
 
 type AddOrganizationMemberParams struct {
 	UserID         int32
 	OrganizationID int32
+	Role           OrganizationRole
 }
 
 func (q *Queries) AddOrganizationMember(ctx context.Context, arg AddOrganizationMemberParams) error {
-	_, err := q.db.Exec(ctx, addOrganizationMember, arg.UserID, arg.OrganizationID)
+	_, err := q.db.Exec(ctx, addOrganizationMember, arg.UserID, arg.OrganizationID, arg.Role)
 	return err
 }
 
@@ -45,6 +46,38 @@ func (q *Queries) CreateOrganization(ctx context.Context, name string) (Organiza
 	return i, err
 }
 
+const deleteOrganization = `-- name: DeleteOrganization :execrows
+DELETE FROM organizations
+WHERE id = $1
+`
+
+func (q *Queries) DeleteOrganization(ctx context.Context, id int32) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteOrganization, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const getOrganizationByID = `-- name: GetOrganizationByID :one
+SELECT id, uid, name, created_at, updated_at
+FROM organizations
+WHERE id = $1
+`
+
+func (q *Queries) GetOrganizationByID(ctx context.Context, id int32) (Organization, error) {
+	row := q.db.QueryRow(ctx, getOrganizationByID, id)
+	var i Organization
+	err := row.Scan(
+		&i.ID,
+		&i.Uid,
+		&i.Name,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
 const getOrganizationByUID = `-- name: GetOrganizationByUID :one
 SELECT id, uid, name, created_at, updated_at
 FROM organizations
@@ -64,6 +97,23 @@ func (q *Queries) GetOrganizationByUID(ctx context.Context, uid pgtype.UUID) (Or
 	return i, err
 }
 
+const getOrganizationMemberRole = `-- name: GetOrganizationMemberRole :one
+SELECT role FROM organization_members
+WHERE user_id = $1 AND organization_id = $2
+`
+
+type GetOrganizationMemberRoleParams struct {
+	UserID         int32
+	OrganizationID int32
+}
+
+func (q *Queries) GetOrganizationMemberRole(ctx context.Context, arg GetOrganizationMemberRoleParams) (OrganizationRole, error) {
+	row := q.db.QueryRow(ctx, getOrganizationMemberRole, arg.UserID, arg.OrganizationID)
+	var role OrganizationRole
+	err := row.Scan(&role)
+	return role, err
+}
+
 const isUserInOrganization = `-- name: IsUserInOrganization :one
 SELECT id FROM organization_members
 WHERE user_id = $1 AND organization_id = $2
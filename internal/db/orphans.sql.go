@@ -0,0 +1,248 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: orphans.sql
+
+package db
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const deleteCollectionsByID = `-- name: DeleteCollectionsByID :execrows
+DELETE FROM collections
+WHERE id = ANY($1::int[])
+`
+
+func (q *Queries) DeleteCollectionsByID(ctx context.Context, ids []int32) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteCollectionsByID, ids)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const getOrganizationAdmin = `-- name: GetOrganizationAdmin :one
+SELECT user_id FROM organization_members
+WHERE organization_id = $1 AND role = 'admin' AND user_id <> $2
+ORDER BY id
+LIMIT 1
+`
+
+type GetOrganizationAdminParams struct {
+	OrganizationID int32
+	ExcludeUserID  int32
+}
+
+func (q *Queries) GetOrganizationAdmin(ctx context.Context, arg GetOrganizationAdminParams) (int32, error) {
+	row := q.db.QueryRow(ctx, getOrganizationAdmin, arg.OrganizationID, arg.ExcludeUserID)
+	var user_id int32
+	err := row.Scan(&user_id)
+	return user_id, err
+}
+
+const listOrphanedCollectionIDsForOrganization = `-- name: ListOrphanedCollectionIDsForOrganization :many
+SELECT id FROM collections
+WHERE organization_id = $1 AND owner_id IS NULL
+`
+
+func (q *Queries) ListOrphanedCollectionIDsForOrganization(ctx context.Context, organizationID pgtype.Int4) ([]int32, error) {
+	rows, err := q.db.Query(ctx, listOrphanedCollectionIDsForOrganization, organizationID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []int32
+	for rows.Next() {
+		var id int32
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listOrphanedCollectionsForUser = `-- name: ListOrphanedCollectionsForUser :many
+SELECT id, organization_id FROM collections
+WHERE owner_id = $1
+`
+
+type ListOrphanedCollectionsForUserRow struct {
+	ID             int32
+	OrganizationID pgtype.Int4
+}
+
+// Everything the user still owns once organization collections are released.
+func (q *Queries) ListOrphanedCollectionsForUser(ctx context.Context, ownerID pgtype.Int4) ([]ListOrphanedCollectionsForUserRow, error) {
+	rows, err := q.db.Query(ctx, listOrphanedCollectionsForUser, ownerID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListOrphanedCollectionsForUserRow
+	for rows.Next() {
+		var i ListOrphanedCollectionsForUserRow
+		if err := rows.Scan(&i.ID, &i.OrganizationID); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listQuarantinedCollections = `-- name: ListQuarantinedCollections :many
+SELECT id, uid, name, data, access_level, owner_id, organization_id, created_at, updated_at, revision, version_retention, deleted_at, labels, source_collection_uid, quarantined_at
+FROM collections
+WHERE quarantined_at IS NOT NULL AND ($1::int IS NULL OR id < $1)
+ORDER BY id DESC
+LIMIT $2
+`
+
+type ListQuarantinedCollectionsParams struct {
+	BeforeID pgtype.Int4
+	PageSize int32
+}
+
+func (q *Queries) ListQuarantinedCollections(ctx context.Context, arg ListQuarantinedCollectionsParams) ([]Collection, error) {
+	rows, err := q.db.Query(ctx, listQuarantinedCollections, arg.BeforeID, arg.PageSize)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Collection
+	for rows.Next() {
+		var i Collection
+		if err := rows.Scan(
+			&i.ID,
+			&i.Uid,
+			&i.Name,
+			&i.Data,
+			&i.AccessLevel,
+			&i.OwnerID,
+			&i.OrganizationID,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.Revision,
+			&i.VersionRetention,
+			&i.DeletedAt,
+			&i.Labels,
+			&i.SourceCollectionUid,
+			&i.QuarantinedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const quarantineCollections = `-- name: QuarantineCollections :execrows
+UPDATE collections
+SET owner_id = NULL, organization_id = NULL, quarantined_at = now(), updated_at = now(), revision = revision + 1
+WHERE id = ANY($1::int[])
+`
+
+func (q *Queries) QuarantineCollections(ctx context.Context, ids []int32) (int64, error) {
+	result, err := q.db.Exec(ctx, quarantineCollections, ids)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const reassignCollections = `-- name: ReassignCollections :execrows
+UPDATE collections
+SET owner_id = $1, organization_id = NULL, quarantined_at = NULL,
+    access_level = CASE WHEN access_level = 'organization' THEN 'private'::access_level ELSE access_level END,
+    updated_at = now(), revision = revision + 1
+WHERE id = ANY($2::int[])
+`
+
+type ReassignCollectionsParams struct {
+	OwnerID pgtype.Int4
+	Ids     []int32
+}
+
+func (q *Queries) ReassignCollections(ctx context.Context, arg ReassignCollectionsParams) (int64, error) {
+	result, err := q.db.Exec(ctx, reassignCollections, arg.OwnerID, arg.Ids)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const reassignQuarantinedCollection = `-- name: ReassignQuarantinedCollection :one
+UPDATE collections
+SET owner_id = $2, quarantined_at = NULL,
+    access_level = CASE WHEN access_level = 'organization' THEN 'private'::access_level ELSE access_level END,
+    updated_at = now(), revision = revision + 1
+WHERE uid = $1 AND quarantined_at IS NOT NULL
+RETURNING id, uid, name, data, access_level, owner_id, organization_id, created_at, updated_at, revision, version_retention, deleted_at, labels, source_collection_uid, quarantined_at
+`
+
+type ReassignQuarantinedCollectionParams struct {
+	Uid     pgtype.UUID
+	OwnerID pgtype.Int4
+}
+
+func (q *Queries) ReassignQuarantinedCollection(ctx context.Context, arg ReassignQuarantinedCollectionParams) (Collection, error) {
+	row := q.db.QueryRow(ctx, reassignQuarantinedCollection, arg.Uid, arg.OwnerID)
+	var i Collection
+	err := row.Scan(
+		&i.ID,
+		&i.Uid,
+		&i.Name,
+		&i.Data,
+		&i.AccessLevel,
+		&i.OwnerID,
+		&i.OrganizationID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Revision,
+		&i.VersionRetention,
+		&i.DeletedAt,
+		&i.Labels,
+		&i.SourceCollectionUid,
+		&i.QuarantinedAt,
+	)
+	return i, err
+}
+
+const releaseOrganizationFromOwnedCollections = `-- name: ReleaseOrganizationFromOwnedCollections :exec
+UPDATE collections
+SET organization_id = NULL,
+    access_level = CASE WHEN access_level = 'organization' THEN 'private'::access_level ELSE access_level END,
+    updated_at = now(), revision = revision + 1
+WHERE organization_id = $1 AND owner_id IS NOT NULL
+`
+
+// Collections in the organization that also have an owner go back to being the owner's.
+func (q *Queries) ReleaseOrganizationFromOwnedCollections(ctx context.Context, organizationID pgtype.Int4) error {
+	_, err := q.db.Exec(ctx, releaseOrganizationFromOwnedCollections, organizationID)
+	return err
+}
+
+const releaseUserFromOrganizationCollections = `-- name: ReleaseUserFromOrganizationCollections :exec
+UPDATE collections
+SET owner_id = NULL, updated_at = now(), revision = revision + 1
+WHERE owner_id = $1 AND organization_id IS NOT NULL AND access_level = 'organization'
+`
+
+// Collections the user shared with an organization stay with the organization. Private and shared ones
+// that only carry an organization_id are orphans, the organization could never see them.
+func (q *Queries) ReleaseUserFromOrganizationCollections(ctx context.Context, ownerID pgtype.Int4) error {
+	_, err := q.db.Exec(ctx, releaseUserFromOrganizationCollections, ownerID)
+	return err
+}
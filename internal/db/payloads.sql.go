@@ -0,0 +1,220 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: payloads.sql
+
+package db
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const addCollectionPayloadChunks = `-- name: AddCollectionPayloadChunks :exec
+INSERT INTO collection_payload_chunks (collection_id, seq, chunk_hash)
+SELECT $1::int, (t.ord - 1)::int, t.hash
+FROM unnest($2::bytea[]) WITH ORDINALITY AS t(hash, ord)
+`
+
+type AddCollectionPayloadChunksParams struct {
+	CollectionID int32
+	Hashes       [][]byte
+}
+
+func (q *Queries) AddCollectionPayloadChunks(ctx context.Context, arg AddCollectionPayloadChunksParams) error {
+	_, err := q.db.Exec(ctx, addCollectionPayloadChunks, arg.CollectionID, arg.Hashes)
+	return err
+}
+
+const createCollectionPayload = `-- name: CreateCollectionPayload :one
+INSERT INTO collection_payloads (collection_id, content_type, size, sha256, chunk_size)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING collection_id, content_type, size, sha256, chunk_size, updated_at
+`
+
+type CreateCollectionPayloadParams struct {
+	CollectionID int32
+	ContentType  string
+	Size         int64
+	Sha256       string
+	ChunkSize    int32
+}
+
+func (q *Queries) CreateCollectionPayload(ctx context.Context, arg CreateCollectionPayloadParams) (CollectionPayload, error) {
+	row := q.db.QueryRow(ctx, createCollectionPayload,
+		arg.CollectionID,
+		arg.ContentType,
+		arg.Size,
+		arg.Sha256,
+		arg.ChunkSize,
+	)
+	var i CollectionPayload
+	err := row.Scan(
+		&i.CollectionID,
+		&i.ContentType,
+		&i.Size,
+		&i.Sha256,
+		&i.ChunkSize,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const createPayloadUpload = `-- name: CreatePayloadUpload :one
+INSERT INTO payload_uploads (collection_id)
+VALUES ($1)
+RETURNING id
+`
+
+func (q *Queries) CreatePayloadUpload(ctx context.Context, collectionID int32) (int32, error) {
+	row := q.db.QueryRow(ctx, createPayloadUpload, collectionID)
+	var id int32
+	err := row.Scan(&id)
+	return id, err
+}
+
+const deleteCollectionPayload = `-- name: DeleteCollectionPayload :exec
+DELETE FROM collection_payloads
+WHERE collection_id = $1
+`
+
+func (q *Queries) DeleteCollectionPayload(ctx context.Context, collectionID int32) error {
+	_, err := q.db.Exec(ctx, deleteCollectionPayload, collectionID)
+	return err
+}
+
+const deletePayloadUpload = `-- name: DeletePayloadUpload :execrows
+DELETE FROM payload_uploads
+WHERE id = $1
+`
+
+func (q *Queries) DeletePayloadUpload(ctx context.Context, id int32) (int64, error) {
+	result, err := q.db.Exec(ctx, deletePayloadUpload, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteStalePayloadUploads = `-- name: DeleteStalePayloadUploads :execrows
+DELETE FROM payload_uploads
+WHERE created_at < $1
+`
+
+// Uploads started before the cutoff belong to a server that stopped before finishing or cleaning them up.
+func (q *Queries) DeleteStalePayloadUploads(ctx context.Context, createdAt pgtype.Timestamptz) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteStalePayloadUploads, createdAt)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteUnreferencedBlobChunks = `-- name: DeleteUnreferencedBlobChunks :execrows
+DELETE FROM blob_chunks
+WHERE hash IN (
+    SELECT b.hash FROM blob_chunks b
+    WHERE ($1::bytea[] IS NULL OR b.hash = ANY($1::bytea[]))
+      AND NOT EXISTS (SELECT 1 FROM collection_payload_chunks c WHERE c.chunk_hash = b.hash)
+      AND NOT EXISTS (SELECT 1 FROM payload_upload_chunks u WHERE u.chunk_hash = b.hash)
+    FOR UPDATE SKIP LOCKED
+)
+`
+
+// Without hashes every unreferenced chunk is removed. Chunks an upload in flight has stored are marked in
+// payload_upload_chunks and left alone, the upload is about to reference them.
+func (q *Queries) DeleteUnreferencedBlobChunks(ctx context.Context, hashes [][]byte) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteUnreferencedBlobChunks, hashes)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const getCollectionPayload = `-- name: GetCollectionPayload :one
+SELECT collection_id, content_type, size, sha256, chunk_size, updated_at
+FROM collection_payloads
+WHERE collection_id = $1
+`
+
+func (q *Queries) GetCollectionPayload(ctx context.Context, collectionID int32) (CollectionPayload, error) {
+	row := q.db.QueryRow(ctx, getCollectionPayload, collectionID)
+	var i CollectionPayload
+	err := row.Scan(
+		&i.CollectionID,
+		&i.ContentType,
+		&i.Size,
+		&i.Sha256,
+		&i.ChunkSize,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getCollectionPayloadChunk = `-- name: GetCollectionPayloadChunk :one
+SELECT b.data FROM collection_payload_chunks c
+JOIN blob_chunks b ON b.hash = c.chunk_hash
+WHERE c.collection_id = $1 AND c.seq = $2
+`
+
+type GetCollectionPayloadChunkParams struct {
+	CollectionID int32
+	Seq          int32
+}
+
+func (q *Queries) GetCollectionPayloadChunk(ctx context.Context, arg GetCollectionPayloadChunkParams) ([]byte, error) {
+	row := q.db.QueryRow(ctx, getCollectionPayloadChunk, arg.CollectionID, arg.Seq)
+	var data []byte
+	err := row.Scan(&data)
+	return data, err
+}
+
+const listCollectionPayloadChunkHashes = `-- name: ListCollectionPayloadChunkHashes :many
+SELECT chunk_hash FROM collection_payload_chunks
+WHERE collection_id = $1
+ORDER BY seq
+`
+
+func (q *Queries) ListCollectionPayloadChunkHashes(ctx context.Context, collectionID int32) ([][]byte, error) {
+	rows, err := q.db.Query(ctx, listCollectionPayloadChunkHashes, collectionID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items [][]byte
+	for rows.Next() {
+		var chunk_hash []byte
+		if err := rows.Scan(&chunk_hash); err != nil {
+			return nil, err
+		}
+		items = append(items, chunk_hash)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const stageBlobChunk = `-- name: StageBlobChunk :exec
+WITH chunk AS (
+    INSERT INTO blob_chunks (hash, data)
+    VALUES ($2, $3)
+    ON CONFLICT (hash) DO UPDATE SET created_at = blob_chunks.created_at
+)
+INSERT INTO payload_upload_chunks (upload_id, chunk_hash)
+VALUES ($1, $2)
+ON CONFLICT DO NOTHING
+`
+
+type StageBlobChunkParams struct {
+	UploadID int32
+	Hash     []byte
+	Data     []byte
+}
+
+// Stores a chunk and marks it as the upload's in one statement, so it is never unreferenced and unmarked.
+func (q *Queries) StageBlobChunk(ctx context.Context, arg StageBlobChunkParams) error {
+	_, err := q.db.Exec(ctx, stageBlobChunk, arg.UploadID, arg.Hash, arg.Data)
+	return err
+}
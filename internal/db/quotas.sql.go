@@ -0,0 +1,65 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: quotas.sql
+
+package db
+
+import (
+	"context"
+)
+
+const getDataSize = `-- name: GetDataSize :one
+SELECT octet_length($1::jsonb::text)::bigint
+`
+
+// Measures data the way usage does, as the text of the jsonb value rather than the JSON it was written as.
+func (q *Queries) GetDataSize(ctx context.Context, data []byte) (int64, error) {
+	row := q.db.QueryRow(ctx, getDataSize, data)
+	var column_1 int64
+	err := row.Scan(&column_1)
+	return column_1, err
+}
+
+const getOrganizationUsage = `-- name: GetOrganizationUsage :one
+SELECT
+    (SELECT count(*) FROM collections c WHERE c.organization_id = $1::int AND c.deleted_at IS NULL)::bigint AS collections,
+    (SELECT coalesce(sum(octet_length(c.data::text)), 0) + coalesce(sum(p.size), 0) FROM collections c LEFT JOIN collection_payloads p ON p.collection_id = c.id WHERE c.organization_id = $1::int AND c.deleted_at IS NULL)::bigint AS storage_bytes,
+    (SELECT count(*) FROM share_links sl JOIN collections c ON c.id = sl.collection_id WHERE c.organization_id = $1::int)::bigint AS share_tokens
+`
+
+type GetOrganizationUsageRow struct {
+	Collections  int64
+	StorageBytes int64
+	ShareTokens  int64
+}
+
+func (q *Queries) GetOrganizationUsage(ctx context.Context, organizationID int32) (GetOrganizationUsageRow, error) {
+	row := q.db.QueryRow(ctx, getOrganizationUsage, organizationID)
+	var i GetOrganizationUsageRow
+	err := row.Scan(&i.Collections, &i.StorageBytes, &i.ShareTokens)
+	return i, err
+}
+
+const getUserUsage = `-- name: GetUserUsage :one
+
+SELECT
+    (SELECT count(*) FROM collections c WHERE c.owner_id = $1::int AND c.deleted_at IS NULL)::bigint AS collections,
+    (SELECT coalesce(sum(octet_length(c.data::text)), 0) + coalesce(sum(p.size), 0) FROM collections c LEFT JOIN collection_payloads p ON p.collection_id = c.id WHERE c.owner_id = $1::int AND c.deleted_at IS NULL)::bigint AS storage_bytes,
+    (SELECT count(*) FROM share_links sl WHERE sl.created_by = $1::int)::bigint AS share_tokens
+`
+
+type GetUserUsageRow struct {
+	Collections  int64
+	StorageBytes int64
+	ShareTokens  int64
+}
+
+// Usage excludes collections in the trash, storage is measured as the size of the stored JSON text plus
+// the size of any payload.
+func (q *Queries) GetUserUsage(ctx context.Context, userID int32) (GetUserUsageRow, error) {
+	row := q.db.QueryRow(ctx, getUserUsage, userID)
+	var i GetUserUsageRow
+	err := row.Scan(&i.Collections, &i.StorageBytes, &i.ShareTokens)
+	return i, err
+}
@@ -7,22 +7,36 @@ package db
 
 import (
 	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
 )
 
 const createShareLink = `-- name: CreateShareLink :one
-INSERT INTO share_links (token, collection_id, created_by)
-VALUES ($1, $2, $3)
-RETURNING id, token, collection_id, access_count, created_by, created_at
+INSERT INTO share_links (token, collection_id, created_by, rate_limit, rate_limit_window_seconds, cache_max_age_seconds, expires_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+RETURNING id, token, collection_id, access_count, created_by, created_at, rate_limit, rate_limit_window_seconds, suspended_at, suspended_reason, cache_max_age_seconds, expires_at
 `
 
 type CreateShareLinkParams struct {
-	Token        string
-	CollectionID int32
-	CreatedBy    int32
+	Token                  string
+	CollectionID           int32
+	CreatedBy              int32
+	RateLimit              pgtype.Int4
+	RateLimitWindowSeconds pgtype.Int4
+	CacheMaxAgeSeconds     pgtype.Int4
+	ExpiresAt              pgtype.Timestamptz
 }
 
 func (q *Queries) CreateShareLink(ctx context.Context, arg CreateShareLinkParams) (ShareLink, error) {
-	row := q.db.QueryRow(ctx, createShareLink, arg.Token, arg.CollectionID, arg.CreatedBy)
+	row := q.db.QueryRow(ctx, createShareLink,
+		arg.Token,
+		arg.CollectionID,
+		arg.CreatedBy,
+		arg.RateLimit,
+		arg.RateLimitWindowSeconds,
+		arg.CacheMaxAgeSeconds,
+		arg.ExpiresAt,
+	)
 	var i ShareLink
 	err := row.Scan(
 		&i.ID,
@@ -31,6 +45,12 @@ func (q *Queries) CreateShareLink(ctx context.Context, arg CreateShareLinkParams
 		&i.AccessCount,
 		&i.CreatedBy,
 		&i.CreatedAt,
+		&i.RateLimit,
+		&i.RateLimitWindowSeconds,
+		&i.SuspendedAt,
+		&i.SuspendedReason,
+		&i.CacheMaxAgeSeconds,
+		&i.ExpiresAt,
 	)
 	return i, err
 }
@@ -46,7 +66,7 @@ func (q *Queries) DeleteShareLinkByToken(ctx context.Context, token string) erro
 }
 
 const getShareLinkByToken = `-- name: GetShareLinkByToken :one
-SELECT id, token, collection_id, access_count, created_by, created_at
+SELECT id, token, collection_id, access_count, created_by, created_at, rate_limit, rate_limit_window_seconds, suspended_at, suspended_reason, cache_max_age_seconds, expires_at
 FROM share_links
 WHERE token = $1
 `
@@ -61,12 +81,36 @@ func (q *Queries) GetShareLinkByToken(ctx context.Context, token string) (ShareL
 		&i.AccessCount,
 		&i.CreatedBy,
 		&i.CreatedAt,
+		&i.RateLimit,
+		&i.RateLimitWindowSeconds,
+		&i.SuspendedAt,
+		&i.SuspendedReason,
+		&i.CacheMaxAgeSeconds,
+		&i.ExpiresAt,
 	)
 	return i, err
 }
 
+const getShareLinkRateLimit = `-- name: GetShareLinkRateLimit :one
+SELECT rate_limit, rate_limit_window_seconds
+FROM share_links
+WHERE token = $1
+`
+
+type GetShareLinkRateLimitRow struct {
+	RateLimit              pgtype.Int4
+	RateLimitWindowSeconds pgtype.Int4
+}
+
+func (q *Queries) GetShareLinkRateLimit(ctx context.Context, token string) (GetShareLinkRateLimitRow, error) {
+	row := q.db.QueryRow(ctx, getShareLinkRateLimit, token)
+	var i GetShareLinkRateLimitRow
+	err := row.Scan(&i.RateLimit, &i.RateLimitWindowSeconds)
+	return i, err
+}
+
 const getShareLinksByCollectionID = `-- name: GetShareLinksByCollectionID :many
-SELECT id, token, collection_id, access_count, created_by, created_at
+SELECT id, token, collection_id, access_count, created_by, created_at, rate_limit, rate_limit_window_seconds, suspended_at, suspended_reason, cache_max_age_seconds, expires_at
 FROM share_links
 WHERE collection_id = $1
 `
@@ -87,6 +131,12 @@ func (q *Queries) GetShareLinksByCollectionID(ctx context.Context, collectionID
 			&i.AccessCount,
 			&i.CreatedBy,
 			&i.CreatedAt,
+			&i.RateLimit,
+			&i.RateLimitWindowSeconds,
+			&i.SuspendedAt,
+			&i.SuspendedReason,
+			&i.CacheMaxAgeSeconds,
+			&i.ExpiresAt,
 		); err != nil {
 			return nil, err
 		}
@@ -98,13 +148,38 @@ func (q *Queries) GetShareLinksByCollectionID(ctx context.Context, collectionID
 	return items, nil
 }
 
+const getSharedCollectionRevision = `-- name: GetSharedCollectionRevision :one
+SELECT c.uid AS collection_uid, c.revision, sl.cache_max_age_seconds
+FROM share_links sl
+JOIN collections c ON c.id = sl.collection_id
+WHERE sl.token = $1 AND sl.suspended_at IS NULL AND (sl.expires_at IS NULL OR sl.expires_at > now())
+  AND c.deleted_at IS NULL AND c.access_level = 'shared'
+`
+
+type GetSharedCollectionRevisionRow struct {
+	CollectionUid      pgtype.UUID
+	Revision           int64
+	CacheMaxAgeSeconds pgtype.Int4
+}
+
+// Revalidating a cached share link only needs to know the token still works and which revision it shows.
+func (q *Queries) GetSharedCollectionRevision(ctx context.Context, token string) (GetSharedCollectionRevisionRow, error) {
+	row := q.db.QueryRow(ctx, getSharedCollectionRevision, token)
+	var i GetSharedCollectionRevisionRow
+	err := row.Scan(&i.CollectionUid, &i.Revision, &i.CacheMaxAgeSeconds)
+	return i, err
+}
+
 const incrementAccessCount = `-- name: IncrementAccessCount :one
 UPDATE share_links
 SET access_count = access_count + 1
-WHERE token = $1
-RETURNING id, token, collection_id, access_count, created_by, created_at
+WHERE token = $1 AND suspended_at IS NULL AND (expires_at IS NULL OR expires_at > now())
+  AND collection_id IN (SELECT id FROM collections WHERE deleted_at IS NULL AND access_level = 'shared')
+RETURNING id, token, collection_id, access_count, created_by, created_at, rate_limit, rate_limit_window_seconds, suspended_at, suspended_reason, cache_max_age_seconds, expires_at
 `
 
+// A link only serves a shared collection that is not in the trash, hits on anything else are not counted.
+// GetSharedCollectionRevision applies the same rule.
 func (q *Queries) IncrementAccessCount(ctx context.Context, token string) (ShareLink, error) {
 	row := q.db.QueryRow(ctx, incrementAccessCount, token)
 	var i ShareLink
@@ -115,6 +190,110 @@ func (q *Queries) IncrementAccessCount(ctx context.Context, token string) (Share
 		&i.AccessCount,
 		&i.CreatedBy,
 		&i.CreatedAt,
+		&i.RateLimit,
+		&i.RateLimitWindowSeconds,
+		&i.SuspendedAt,
+		&i.SuspendedReason,
+		&i.CacheMaxAgeSeconds,
+		&i.ExpiresAt,
+	)
+	return i, err
+}
+
+const resumeShareLink = `-- name: ResumeShareLink :one
+UPDATE share_links
+SET suspended_at = NULL, suspended_reason = NULL
+WHERE token = $1
+RETURNING id, token, collection_id, access_count, created_by, created_at, rate_limit, rate_limit_window_seconds, suspended_at, suspended_reason, cache_max_age_seconds, expires_at
+`
+
+func (q *Queries) ResumeShareLink(ctx context.Context, token string) (ShareLink, error) {
+	row := q.db.QueryRow(ctx, resumeShareLink, token)
+	var i ShareLink
+	err := row.Scan(
+		&i.ID,
+		&i.Token,
+		&i.CollectionID,
+		&i.AccessCount,
+		&i.CreatedBy,
+		&i.CreatedAt,
+		&i.RateLimit,
+		&i.RateLimitWindowSeconds,
+		&i.SuspendedAt,
+		&i.SuspendedReason,
+		&i.CacheMaxAgeSeconds,
+		&i.ExpiresAt,
+	)
+	return i, err
+}
+
+const suspendShareLink = `-- name: SuspendShareLink :one
+UPDATE share_links
+SET suspended_at = now(), suspended_reason = $2
+WHERE token = $1
+RETURNING id, token, collection_id, access_count, created_by, created_at, rate_limit, rate_limit_window_seconds, suspended_at, suspended_reason, cache_max_age_seconds, expires_at
+`
+
+type SuspendShareLinkParams struct {
+	Token           string
+	SuspendedReason pgtype.Text
+}
+
+func (q *Queries) SuspendShareLink(ctx context.Context, arg SuspendShareLinkParams) (ShareLink, error) {
+	row := q.db.QueryRow(ctx, suspendShareLink, arg.Token, arg.SuspendedReason)
+	var i ShareLink
+	err := row.Scan(
+		&i.ID,
+		&i.Token,
+		&i.CollectionID,
+		&i.AccessCount,
+		&i.CreatedBy,
+		&i.CreatedAt,
+		&i.RateLimit,
+		&i.RateLimitWindowSeconds,
+		&i.SuspendedAt,
+		&i.SuspendedReason,
+		&i.CacheMaxAgeSeconds,
+		&i.ExpiresAt,
+	)
+	return i, err
+}
+
+const updateShareLinkOverrides = `-- name: UpdateShareLinkOverrides :one
+UPDATE share_links
+SET rate_limit = $2, rate_limit_window_seconds = $3, cache_max_age_seconds = $4
+WHERE token = $1
+RETURNING id, token, collection_id, access_count, created_by, created_at, rate_limit, rate_limit_window_seconds, suspended_at, suspended_reason, cache_max_age_seconds, expires_at
+`
+
+type UpdateShareLinkOverridesParams struct {
+	Token                  string
+	RateLimit              pgtype.Int4
+	RateLimitWindowSeconds pgtype.Int4
+	CacheMaxAgeSeconds     pgtype.Int4
+}
+
+func (q *Queries) UpdateShareLinkOverrides(ctx context.Context, arg UpdateShareLinkOverridesParams) (ShareLink, error) {
+	row := q.db.QueryRow(ctx, updateShareLinkOverrides,
+		arg.Token,
+		arg.RateLimit,
+		arg.RateLimitWindowSeconds,
+		arg.CacheMaxAgeSeconds,
+	)
+	var i ShareLink
+	err := row.Scan(
+		&i.ID,
+		&i.Token,
+		&i.CollectionID,
+		&i.AccessCount,
+		&i.CreatedBy,
+		&i.CreatedAt,
+		&i.RateLimit,
+		&i.RateLimitWindowSeconds,
+		&i.SuspendedAt,
+		&i.SuspendedReason,
+		&i.CacheMaxAgeSeconds,
+		&i.ExpiresAt,
 	)
 	return i, err
 }
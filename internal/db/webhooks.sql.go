@@ -0,0 +1,354 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: webhooks.sql
+
+package db
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const claimDueWebhookDeliveries = `-- name: ClaimDueWebhookDeliveries :many
+WITH claimed AS (
+    UPDATE webhook_deliveries d
+    SET next_attempt_at = now() + make_interval(secs => $1::int)
+    WHERE d.id IN (
+        SELECT id FROM webhook_deliveries
+        WHERE status = 'pending' AND next_attempt_at <= now()
+        ORDER BY next_attempt_at
+        LIMIT $2
+        FOR UPDATE SKIP LOCKED
+    )
+    RETURNING d.id, d.subscription_id, d.event_id, d.attempts
+)
+SELECT c.id, c.attempts, s.url, s.secret, e.uid AS event_uid, e.event_type, e.payload, e.created_at AS event_created_at,
+    o.uid AS organization_uid
+FROM claimed c
+JOIN webhook_subscriptions s ON s.id = c.subscription_id
+JOIN outbox_events e ON e.id = c.event_id
+JOIN organizations o ON o.id = e.organization_id
+`
+
+type ClaimDueWebhookDeliveriesParams struct {
+	LeaseSeconds int32
+	BatchSize    int32
+}
+
+type ClaimDueWebhookDeliveriesRow struct {
+	ID              int32
+	Attempts        int32
+	Url             string
+	Secret          string
+	EventUid        pgtype.UUID
+	EventType       string
+	Payload         []byte
+	EventCreatedAt  pgtype.Timestamptz
+	OrganizationUid pgtype.UUID
+}
+
+// Pushes next_attempt_at out by the lease so no other dispatcher picks the delivery up while it is in flight.
+func (q *Queries) ClaimDueWebhookDeliveries(ctx context.Context, arg ClaimDueWebhookDeliveriesParams) ([]ClaimDueWebhookDeliveriesRow, error) {
+	rows, err := q.db.Query(ctx, claimDueWebhookDeliveries, arg.LeaseSeconds, arg.BatchSize)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ClaimDueWebhookDeliveriesRow
+	for rows.Next() {
+		var i ClaimDueWebhookDeliveriesRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Attempts,
+			&i.Url,
+			&i.Secret,
+			&i.EventUid,
+			&i.EventType,
+			&i.Payload,
+			&i.EventCreatedAt,
+			&i.OrganizationUid,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const createWebhookSubscription = `-- name: CreateWebhookSubscription :one
+INSERT INTO webhook_subscriptions (organization_id, url, secret, event_types, created_by)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING id, uid, organization_id, url, secret, event_types, created_by, created_at
+`
+
+type CreateWebhookSubscriptionParams struct {
+	OrganizationID int32
+	Url            string
+	Secret         string
+	EventTypes     []string
+	CreatedBy      pgtype.Int4
+}
+
+func (q *Queries) CreateWebhookSubscription(ctx context.Context, arg CreateWebhookSubscriptionParams) (WebhookSubscription, error) {
+	row := q.db.QueryRow(ctx, createWebhookSubscription,
+		arg.OrganizationID,
+		arg.Url,
+		arg.Secret,
+		arg.EventTypes,
+		arg.CreatedBy,
+	)
+	var i WebhookSubscription
+	err := row.Scan(
+		&i.ID,
+		&i.Uid,
+		&i.OrganizationID,
+		&i.Url,
+		&i.Secret,
+		&i.EventTypes,
+		&i.CreatedBy,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const deleteDeliveredOutboxEvents = `-- name: DeleteDeliveredOutboxEvents :execrows
+DELETE FROM outbox_events e
+WHERE e.dispatched_at < $1
+  AND NOT EXISTS (
+    SELECT 1 FROM webhook_deliveries d
+    WHERE d.event_id = e.id AND d.status <> 'delivered'
+  )
+`
+
+// Events are kept while any delivery is pending or dead so the dead letter list can be retried.
+func (q *Queries) DeleteDeliveredOutboxEvents(ctx context.Context, cutoff pgtype.Timestamptz) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteDeliveredOutboxEvents, cutoff)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteWebhookSubscription = `-- name: DeleteWebhookSubscription :exec
+DELETE FROM webhook_subscriptions
+WHERE id = $1
+`
+
+func (q *Queries) DeleteWebhookSubscription(ctx context.Context, id int32) error {
+	_, err := q.db.Exec(ctx, deleteWebhookSubscription, id)
+	return err
+}
+
+const fanOutOutboxEvents = `-- name: FanOutOutboxEvents :execrows
+WITH events AS (
+    SELECT id, event_type, organization_id FROM outbox_events
+    WHERE dispatched_at IS NULL
+    ORDER BY id
+    LIMIT $1
+    FOR UPDATE SKIP LOCKED
+), deliveries AS (
+    INSERT INTO webhook_deliveries (subscription_id, event_id)
+    SELECT s.id, e.id FROM events e
+    JOIN webhook_subscriptions s ON s.organization_id = e.organization_id
+    WHERE cardinality(s.event_types) = 0 OR e.event_type = ANY(s.event_types)
+    ON CONFLICT (subscription_id, event_id) DO NOTHING
+)
+UPDATE outbox_events SET dispatched_at = now()
+WHERE id IN (SELECT id FROM events)
+`
+
+// Creates a delivery for every subscription interested in the oldest undispatched events and marks them
+// dispatched. SKIP LOCKED lets every replica run the dispatcher.
+func (q *Queries) FanOutOutboxEvents(ctx context.Context, batchSize int32) (int64, error) {
+	result, err := q.db.Exec(ctx, fanOutOutboxEvents, batchSize)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const getWebhookSubscriptionByUID = `-- name: GetWebhookSubscriptionByUID :one
+SELECT id, uid, organization_id, url, secret, event_types, created_by, created_at
+FROM webhook_subscriptions
+WHERE uid = $1
+`
+
+func (q *Queries) GetWebhookSubscriptionByUID(ctx context.Context, uid pgtype.UUID) (WebhookSubscription, error) {
+	row := q.db.QueryRow(ctx, getWebhookSubscriptionByUID, uid)
+	var i WebhookSubscription
+	err := row.Scan(
+		&i.ID,
+		&i.Uid,
+		&i.OrganizationID,
+		&i.Url,
+		&i.Secret,
+		&i.EventTypes,
+		&i.CreatedBy,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const listWebhookDeliveries = `-- name: ListWebhookDeliveries :many
+SELECT d.id, d.status, d.attempts, d.next_attempt_at, d.last_error, d.delivered_at, d.created_at,
+    e.uid AS event_uid, e.event_type
+FROM webhook_deliveries d
+JOIN outbox_events e ON e.id = d.event_id
+WHERE d.subscription_id = $1
+  AND ($2::webhook_delivery_status IS NULL OR d.status = $2)
+  AND ($3::int IS NULL OR d.id < $3)
+ORDER BY d.id DESC
+LIMIT $4
+`
+
+type ListWebhookDeliveriesParams struct {
+	SubscriptionID int32
+	Status         NullWebhookDeliveryStatus
+	BeforeID       pgtype.Int4
+	PageSize       int32
+}
+
+type ListWebhookDeliveriesRow struct {
+	ID            int32
+	Status        WebhookDeliveryStatus
+	Attempts      int32
+	NextAttemptAt pgtype.Timestamptz
+	LastError     pgtype.Text
+	DeliveredAt   pgtype.Timestamptz
+	CreatedAt     pgtype.Timestamptz
+	EventUid      pgtype.UUID
+	EventType     string
+}
+
+func (q *Queries) ListWebhookDeliveries(ctx context.Context, arg ListWebhookDeliveriesParams) ([]ListWebhookDeliveriesRow, error) {
+	rows, err := q.db.Query(ctx, listWebhookDeliveries,
+		arg.SubscriptionID,
+		arg.Status,
+		arg.BeforeID,
+		arg.PageSize,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListWebhookDeliveriesRow
+	for rows.Next() {
+		var i ListWebhookDeliveriesRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Status,
+			&i.Attempts,
+			&i.NextAttemptAt,
+			&i.LastError,
+			&i.DeliveredAt,
+			&i.CreatedAt,
+			&i.EventUid,
+			&i.EventType,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listWebhookSubscriptions = `-- name: ListWebhookSubscriptions :many
+SELECT id, uid, organization_id, url, secret, event_types, created_by, created_at
+FROM webhook_subscriptions
+WHERE organization_id = $1
+ORDER BY id
+`
+
+func (q *Queries) ListWebhookSubscriptions(ctx context.Context, organizationID int32) ([]WebhookSubscription, error) {
+	rows, err := q.db.Query(ctx, listWebhookSubscriptions, organizationID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []WebhookSubscription
+	for rows.Next() {
+		var i WebhookSubscription
+		if err := rows.Scan(
+			&i.ID,
+			&i.Uid,
+			&i.OrganizationID,
+			&i.Url,
+			&i.Secret,
+			&i.EventTypes,
+			&i.CreatedBy,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const markWebhookDelivered = `-- name: MarkWebhookDelivered :exec
+UPDATE webhook_deliveries
+SET status = 'delivered', attempts = attempts + 1, delivered_at = now(), last_error = NULL
+WHERE id = $1
+`
+
+func (q *Queries) MarkWebhookDelivered(ctx context.Context, id int32) error {
+	_, err := q.db.Exec(ctx, markWebhookDelivered, id)
+	return err
+}
+
+const markWebhookDeliveryFailed = `-- name: MarkWebhookDeliveryFailed :exec
+UPDATE webhook_deliveries
+SET attempts = attempts + 1,
+    last_error = $1,
+    next_attempt_at = $2,
+    status = CASE WHEN attempts + 1 >= $3::int THEN 'dead'::webhook_delivery_status ELSE 'pending'::webhook_delivery_status END
+WHERE id = $4
+`
+
+type MarkWebhookDeliveryFailedParams struct {
+	LastError     pgtype.Text
+	NextAttemptAt pgtype.Timestamptz
+	MaxAttempts   int32
+	ID            int32
+}
+
+// Schedules another attempt, or moves the delivery to the dead letter list once max_attempts is reached.
+func (q *Queries) MarkWebhookDeliveryFailed(ctx context.Context, arg MarkWebhookDeliveryFailedParams) error {
+	_, err := q.db.Exec(ctx, markWebhookDeliveryFailed,
+		arg.LastError,
+		arg.NextAttemptAt,
+		arg.MaxAttempts,
+		arg.ID,
+	)
+	return err
+}
+
+const retryWebhookDelivery = `-- name: RetryWebhookDelivery :execrows
+UPDATE webhook_deliveries
+SET status = 'pending', attempts = 0, next_attempt_at = now(), last_error = NULL
+WHERE id = $1 AND subscription_id = $2 AND status = 'dead'
+`
+
+type RetryWebhookDeliveryParams struct {
+	ID             int32
+	SubscriptionID int32
+}
+
+func (q *Queries) RetryWebhookDelivery(ctx context.Context, arg RetryWebhookDeliveryParams) (int64, error) {
+	result, err := q.db.Exec(ctx, retryWebhookDelivery, arg.ID, arg.SubscriptionID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
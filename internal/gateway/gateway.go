@@ -0,0 +1,96 @@
+// Package gateway serves CollectionService as HTTP/JSON, following the google.api.http annotations in
+// proto/service.proto, plus short public share link URLs.
+//
+// Requests are forwarded to the gRPC server over a client connection rather than calling the
+// implementation directly, so they go through the same rate limiting, abuse detection and auth
+// interceptors as native gRPC calls.
+package gateway
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/ajscimone/censys-challenge/gen/proto"
+	"github.com/ajscimone/censys-challenge/internal/httpcache"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc"
+)
+
+type Config struct {
+	// ShareMaxAge is how long shared collections served from /s/{token} may be cached, unless the token
+	// overrides it.
+	ShareMaxAge time.Duration
+}
+
+// New returns the HTTP handler for the gateway. conn should point at this process's gRPC listener.
+func New(ctx context.Context, conn *grpc.ClientConn, config Config) (http.Handler, error) {
+	mux := runtime.NewServeMux()
+
+	client := censysv1.NewCollectionServiceClient(conn)
+	if err := censysv1.RegisterCollectionServiceHandlerClient(ctx, mux, client); err != nil {
+		return nil, fmt.Errorf("failed to register collection service: %w", err)
+	}
+
+	if err := mux.HandlePath(http.MethodGet, "/s/{token}", shareHandler(mux, client, config)); err != nil {
+		return nil, fmt.Errorf("failed to register share links: %w", err)
+	}
+
+	return mux, nil
+}
+
+// shareHandler serves a shared collection as a plain JSON document so share links work from a browser.
+// The document is the collection alone, the token's access count would change it on every request. Responses
+// carry a strong etag for conditional requests and surrogate keys so caches can be purged by collection or
+// token, see httpcache.
+func shareHandler(mux *runtime.ServeMux, client censysv1.CollectionServiceClient, config Config) runtime.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+		_, outbound := runtime.MarshalerForRequest(mux, r)
+
+		// carries the caller's address (X-Forwarded-For) to the abuse detector like the generated handlers do
+		ctx, err := runtime.AnnotateContext(r.Context(), mux, r, censysv1.CollectionService_GetSharedCollection_FullMethodName,
+			runtime.WithHTTPPathPattern("/s/{token}"))
+		if err != nil {
+			runtime.HTTPError(r.Context(), mux, outbound, w, r, err)
+			return
+		}
+
+		token := pathParams["token"]
+		resp, err := client.GetSharedCollection(ctx, &censysv1.GetSharedCollectionRequest{
+			Token:       token,
+			IfNoneMatch: r.Header.Get("If-None-Match"),
+		})
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outbound, w, r, err)
+			return
+		}
+
+		maxAge := config.ShareMaxAge
+		if resp.CacheMaxAgeSeconds > 0 {
+			maxAge = time.Duration(resp.CacheMaxAgeSeconds) * time.Second
+		}
+		keys := httpcache.ShareLinkKey(httpcache.TokenHash(token))
+		if resp.Collection != nil {
+			keys += " " + httpcache.CollectionKey(resp.Collection.Uid)
+		}
+
+		w.Header().Set("Cache-Control", "public, max-age="+strconv.Itoa(int(maxAge/time.Second)))
+		w.Header().Set("ETag", strconv.Quote(resp.Etag))
+		w.Header().Set(httpcache.SurrogateKeyHeader, keys)
+		if resp.NotModified {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+
+		body, err := outbound.Marshal(resp.Collection)
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outbound, w, r, err)
+			return
+		}
+
+		w.Header().Set("Content-Type", outbound.ContentType(resp.Collection))
+		w.Write(body)
+	}
+}
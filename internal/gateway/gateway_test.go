@@ -0,0 +1,123 @@
+package gateway
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	censysv1 "github.com/ajscimone/censys-challenge/gen/proto"
+	"github.com/ajscimone/censys-challenge/internal/httpcache"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type fakeClient struct {
+	censysv1.CollectionServiceClient
+	shared map[string]*censysv1.SharedCollectionResponse
+}
+
+func (c fakeClient) GetSharedCollection(ctx context.Context, req *censysv1.GetSharedCollectionRequest, opts ...grpc.CallOption) (*censysv1.SharedCollectionResponse, error) {
+	resp, ok := c.shared[req.Token]
+	if !ok {
+		return nil, status.Error(codes.NotFound, "invalid or revoked token")
+	}
+	if req.IfNoneMatch != "" && httpcache.ETagMatches(req.IfNoneMatch, resp.Etag) {
+		return &censysv1.SharedCollectionResponse{Etag: resp.Etag, CacheMaxAgeSeconds: resp.CacheMaxAgeSeconds, NotModified: true}, nil
+	}
+	return resp, nil
+}
+
+func newShareServer(t *testing.T) http.Handler {
+	t.Helper()
+
+	mux := runtime.NewServeMux()
+	client := fakeClient{shared: map[string]*censysv1.SharedCollectionResponse{
+		"abc123": {Collection: &censysv1.Collection{Uid: "c1", Name: "Shared", Etag: "7"}, AccessCount: 3, Etag: "7"},
+		"long":   {Collection: &censysv1.Collection{Uid: "c2", Name: "Viral", Etag: "2"}, Etag: "2", CacheMaxAgeSeconds: 3600},
+	}}
+	if err := mux.HandlePath(http.MethodGet, "/s/{token}", shareHandler(mux, client, Config{ShareMaxAge: time.Minute})); err != nil {
+		t.Fatalf("failed to register handler: %v", err)
+	}
+	return mux
+}
+
+func TestShareHandler_ServesCollectionWithCacheHeaders(t *testing.T) {
+	rec := httptest.NewRecorder()
+	newShareServer(t).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/s/abc123", nil))
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d: %s", rec.Code, rec.Body)
+	}
+	if got := rec.Header().Get("Cache-Control"); got != "public, max-age=60" {
+		t.Fatalf("unexpected Cache-Control %q", got)
+	}
+	if got := rec.Header().Get("ETag"); got != `"7"` {
+		t.Fatalf("unexpected ETag %q", got)
+	}
+	wantKeys := httpcache.ShareLinkKey(httpcache.TokenHash("abc123")) + " " + httpcache.CollectionKey("c1")
+	if got := rec.Header().Get(httpcache.SurrogateKeyHeader); got != wantKeys {
+		t.Fatalf("unexpected Surrogate-Key %q", got)
+	}
+
+	var body map[string]any
+	if err := json.Unmarshal(rec.Body.Bytes(), &body); err != nil {
+		t.Fatalf("response should be JSON: %v", err)
+	}
+	if body["name"] != "Shared" {
+		t.Fatalf("unexpected body %s", rec.Body)
+	}
+	if _, ok := body["accessCount"]; ok {
+		t.Fatal("the access count changes every request and must not be part of the cached document")
+	}
+}
+
+func TestShareHandler_UsesTokenMaxAge(t *testing.T) {
+	rec := httptest.NewRecorder()
+	newShareServer(t).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/s/long", nil))
+
+	if got := rec.Header().Get("Cache-Control"); got != "public, max-age=3600" {
+		t.Fatalf("unexpected Cache-Control %q", got)
+	}
+}
+
+func TestShareHandler_NotModified(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/s/abc123", nil)
+	req.Header.Set("If-None-Match", `"7"`)
+	rec := httptest.NewRecorder()
+	newShareServer(t).ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusNotModified {
+		t.Fatalf("expected 304, got %d", rec.Code)
+	}
+	if rec.Body.Len() != 0 {
+		t.Fatalf("304 must not have a body, got %s", rec.Body)
+	}
+	if got := rec.Header().Get("ETag"); got != `"7"` {
+		t.Fatalf("unexpected ETag %q", got)
+	}
+
+	stale := httptest.NewRequest(http.MethodGet, "/s/abc123", nil)
+	stale.Header.Set("If-None-Match", `"6"`)
+	rec = httptest.NewRecorder()
+	newShareServer(t).ServeHTTP(rec, stale)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected 200 for a stale etag, got %d", rec.Code)
+	}
+}
+
+func TestShareHandler_MapsErrors(t *testing.T) {
+	rec := httptest.NewRecorder()
+	newShareServer(t).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/s/revoked", nil))
+
+	if rec.Code != http.StatusNotFound {
+		t.Fatalf("expected 404, got %d", rec.Code)
+	}
+	if rec.Header().Get("Cache-Control") != "" {
+		t.Fatal("errors should not be cacheable")
+	}
+}
@@ -0,0 +1,159 @@
+// Package healthcheck reports whether the server can take traffic, over the standard grpc.health.v1 service
+// and as HTTP /healthz and /readyz probes. The server is ready while Postgres answers and the schema is at
+// least at the newest migration the binary was built with.
+package healthcheck
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// Database is the part of pgxpool.Pool the checks use.
+type Database interface {
+	Ping(ctx context.Context) error
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
+}
+
+type Checker struct {
+	db              Database
+	latestMigration uint
+	timeout         time.Duration
+	services        []string
+	server          *health.Server
+
+	mu           sync.Mutex
+	err          error
+	shuttingDown bool
+}
+
+// NewChecker reports services, and the server as a whole, as NOT_SERVING until the first check passes.
+func NewChecker(db Database, latestMigration uint, timeout time.Duration, services ...string) *Checker {
+	c := &Checker{
+		db:              db,
+		latestMigration: latestMigration,
+		timeout:         timeout,
+		services:        services,
+		server:          health.NewServer(),
+		err:             errors.New("not checked yet"),
+	}
+	c.setStatus(healthpb.HealthCheckResponse_NOT_SERVING)
+	return c
+}
+
+// Server is the grpc.health.v1 implementation to register on the gRPC server.
+func (c *Checker) Server() *health.Server {
+	return c.server
+}
+
+// Check pings the database and compares its migration version with the one the server needs.
+func (c *Checker) Check(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	if err := c.db.Ping(ctx); err != nil {
+		return fmt.Errorf("database unreachable: %w", err)
+	}
+
+	var version int64
+	var dirty bool
+	if err := c.db.QueryRow(ctx, "SELECT version, dirty FROM schema_migrations").Scan(&version, &dirty); err != nil {
+		return fmt.Errorf("failed to read migration version: %w", err)
+	}
+	if dirty {
+		return fmt.Errorf("migration %d did not finish", version)
+	}
+	if version < int64(c.latestMigration) {
+		return fmt.Errorf("migrations are behind, database is at %d and the server needs %d", version, c.latestMigration)
+	}
+	return nil
+}
+
+// Run checks every interval until ctx is cancelled.
+func (c *Checker) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		c.update(c.Check(ctx))
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (c *Checker) update(err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.shuttingDown {
+		return
+	}
+	if err != nil && c.err == nil {
+		log.Printf("Not ready: %v", err)
+	} else if err == nil && c.err != nil {
+		log.Println("Ready")
+	}
+	c.err = err
+
+	if err != nil {
+		c.setStatus(healthpb.HealthCheckResponse_NOT_SERVING)
+	} else {
+		c.setStatus(healthpb.HealthCheckResponse_SERVING)
+	}
+}
+
+func (c *Checker) setStatus(status healthpb.HealthCheckResponse_ServingStatus) {
+	c.server.SetServingStatus("", status)
+	for _, service := range c.services {
+		c.server.SetServingStatus(service, status)
+	}
+}
+
+// Shutdown reports NOT_SERVING for good. It is called as graceful shutdown starts, so load balancers
+// stop sending new calls while the ones in flight finish.
+func (c *Checker) Shutdown() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.shuttingDown = true
+	c.err = errors.New("shutting down")
+	c.server.Shutdown()
+}
+
+// Ready returns why the server is not ready, or nil when it is.
+func (c *Checker) Ready() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.err
+}
+
+// Handler serves /healthz and /readyz in front of next. /healthz only says the process is up, /readyz
+// answers 503 while the server is not ready. The reason is logged rather than returned, the probes are
+// unauthenticated.
+func (c *Checker) Handler(next http.Handler) http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /healthz", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("ok\n"))
+	})
+	mux.HandleFunc("GET /readyz", func(w http.ResponseWriter, r *http.Request) {
+		if c.Ready() != nil {
+			http.Error(w, "not ready", http.StatusServiceUnavailable)
+			return
+		}
+		w.Write([]byte("ok\n"))
+	})
+	mux.Handle("/", next)
+	return mux
+}
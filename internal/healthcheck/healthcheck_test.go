@@ -0,0 +1,135 @@
+package healthcheck
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// fakeDB answers the checks with a fixed ping error and migration state.
+type fakeDB struct {
+	pingErr  error
+	queryErr error
+	version  int64
+	dirty    bool
+}
+
+func (db *fakeDB) Ping(ctx context.Context) error {
+	return db.pingErr
+}
+
+func (db *fakeDB) QueryRow(ctx context.Context, sql string, args ...any) pgx.Row {
+	return fakeRow{db}
+}
+
+type fakeRow struct{ db *fakeDB }
+
+func (r fakeRow) Scan(dest ...any) error {
+	if r.db.queryErr != nil {
+		return r.db.queryErr
+	}
+	*dest[0].(*int64) = r.db.version
+	*dest[1].(*bool) = r.db.dirty
+	return nil
+}
+
+const collectionService = "censys.v1.CollectionService"
+
+func status(t *testing.T, c *Checker, service string) healthpb.HealthCheckResponse_ServingStatus {
+	t.Helper()
+
+	resp, err := c.Server().Check(context.Background(), &healthpb.HealthCheckRequest{Service: service})
+	if err != nil {
+		t.Fatalf("check failed: %v", err)
+	}
+	return resp.Status
+}
+
+func readyz(c *Checker) int {
+	rec := httptest.NewRecorder()
+	c.Handler(http.NotFoundHandler()).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+	return rec.Code
+}
+
+func TestChecker_NotServingUntilChecked(t *testing.T) {
+	c := NewChecker(&fakeDB{version: 3}, 3, time.Second, collectionService)
+
+	if got := status(t, c, collectionService); got != healthpb.HealthCheckResponse_NOT_SERVING {
+		t.Fatalf("expected NOT_SERVING before the first check, got %v", got)
+	}
+	if code := readyz(c); code != http.StatusServiceUnavailable {
+		t.Fatalf("expected 503, got %d", code)
+	}
+
+	c.update(c.Check(context.Background()))
+	for _, service := range []string{"", collectionService} {
+		if got := status(t, c, service); got != healthpb.HealthCheckResponse_SERVING {
+			t.Fatalf("expected %q to be SERVING, got %v", service, got)
+		}
+	}
+	if code := readyz(c); code != http.StatusOK {
+		t.Fatalf("expected 200, got %d", code)
+	}
+}
+
+func TestChecker_Check(t *testing.T) {
+	tests := map[string]struct {
+		db    *fakeDB
+		ready bool
+	}{
+		"up to date":          {db: &fakeDB{version: 3}, ready: true},
+		"ahead":               {db: &fakeDB{version: 4}, ready: true},
+		"unreachable":         {db: &fakeDB{pingErr: errors.New("connection refused")}},
+		"no migrations table": {db: &fakeDB{queryErr: errors.New(`relation "schema_migrations" does not exist`)}},
+		"behind":              {db: &fakeDB{version: 2}},
+		"dirty":               {db: &fakeDB{version: 3, dirty: true}},
+	}
+
+	for name, tt := range tests {
+		c := NewChecker(tt.db, 3, time.Second)
+		if err := c.Check(context.Background()); (err == nil) != tt.ready {
+			t.Errorf("%s: expected ready %v, got %v", name, tt.ready, err)
+		}
+	}
+}
+
+func TestChecker_ShutdownStaysNotServing(t *testing.T) {
+	c := NewChecker(&fakeDB{version: 3}, 3, time.Second, collectionService)
+	c.update(nil)
+
+	c.Shutdown()
+	// a check finishing after shutdown started must not flip it back
+	c.update(nil)
+
+	if got := status(t, c, collectionService); got != healthpb.HealthCheckResponse_NOT_SERVING {
+		t.Fatalf("expected NOT_SERVING after shutdown, got %v", got)
+	}
+	if code := readyz(c); code != http.StatusServiceUnavailable {
+		t.Fatalf("expected 503, got %d", code)
+	}
+}
+
+func TestHandler_LivenessAndPassthrough(t *testing.T) {
+	c := NewChecker(&fakeDB{pingErr: errors.New("down")}, 3, time.Second)
+	handler := c.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusTeapot)
+	}))
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/healthz", nil))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("liveness should not depend on the database, got %d", rec.Code)
+	}
+
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/censys.v1.CollectionService/Login", nil))
+	if rec.Code != http.StatusTeapot {
+		t.Fatalf("other paths should reach the wrapped handler, got %d", rec.Code)
+	}
+}
@@ -0,0 +1,81 @@
+// Package httpcache holds the HTTP caching rules for public share links: etag matching, the surrogate keys
+// responses are tagged with, and purging those keys from a CDN or reverse proxy.
+//
+// Purges are sent as a POST to the configured URL with the keys space separated in the Surrogate-Key
+// header, the batch purge request Fastly and most surrogate key aware proxies accept.
+package httpcache
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const SurrogateKeyHeader = "Surrogate-Key"
+
+// CollectionKey tags every cached response showing the collection.
+func CollectionKey(collectionUID string) string {
+	return "collection-" + collectionUID
+}
+
+// ShareLinkKey tags the responses for one share token. It is built from the token hash so the token
+// itself never ends up in cache metadata or purge requests.
+func ShareLinkKey(tokenHash string) string {
+	return "share-" + tokenHash
+}
+
+// TokenHash is the hex SHA-256 of a share token, the same value the share link triggers publish.
+func TokenHash(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// ETagMatches reports whether an If-None-Match header value matches etag. As RFC 9110 requires for
+// If-None-Match the comparison is weak, so W/ prefixes are ignored.
+func ETagMatches(ifNoneMatch, etag string) bool {
+	for _, candidate := range strings.Split(ifNoneMatch, ",") {
+		candidate = strings.TrimSpace(candidate)
+		if candidate == "*" {
+			return true
+		}
+		if strings.Trim(strings.TrimPrefix(candidate, "W/"), `"`) == etag {
+			return true
+		}
+	}
+	return false
+}
+
+type Purger struct {
+	url    string
+	client *http.Client
+}
+
+func NewPurger(url string, timeout time.Duration) *Purger {
+	return &Purger{url: url, client: &http.Client{Timeout: timeout}}
+}
+
+// Purge invalidates every cached response tagged with one of keys.
+func (p *Purger) Purge(ctx context.Context, keys []string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.url, nil)
+	if err != nil {
+		return fmt.Errorf("failed to build purge request: %w", err)
+	}
+	req.Header.Set(SurrogateKeyHeader, strings.Join(keys, " "))
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("unexpected status %s", resp.Status)
+	}
+	return nil
+}
@@ -0,0 +1,69 @@
+package httpcache
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestETagMatches(t *testing.T) {
+	tests := []struct {
+		ifNoneMatch string
+		want        bool
+	}{
+		{`"7"`, true},
+		{`W/"7"`, true},
+		{`"6", "7"`, true},
+		{`*`, true},
+		{`"6"`, false},
+		{`"70"`, false},
+		{``, false},
+	}
+
+	for _, tt := range tests {
+		if got := ETagMatches(tt.ifNoneMatch, "7"); got != tt.want {
+			t.Errorf("ETagMatches(%q, 7) = %v, want %v", tt.ifNoneMatch, got, tt.want)
+		}
+	}
+}
+
+func TestTokenHash(t *testing.T) {
+	// sha256("abc"), matches encode(sha256(convert_to('abc', 'UTF8')), 'hex') in Postgres
+	want := "ba7816bf8f01cfea414140de5dae2223b00361a396177a9cb410ff61f20015ad"
+	if got := TokenHash("abc"); got != want {
+		t.Fatalf("TokenHash(abc) = %s, want %s", got, want)
+	}
+	if got := ShareLinkKey(TokenHash("abc")); got != "share-"+want {
+		t.Fatalf("unexpected share link key %s", got)
+	}
+}
+
+func TestPurger_SendsSurrogateKeys(t *testing.T) {
+	var method, keys string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		method = r.Method
+		keys = r.Header.Get(SurrogateKeyHeader)
+	}))
+	defer srv.Close()
+
+	err := NewPurger(srv.URL, time.Second).Purge(context.Background(), []string{CollectionKey("c1"), ShareLinkKey("ab")})
+	if err != nil {
+		t.Fatalf("purge failed: %v", err)
+	}
+	if method != http.MethodPost || keys != "collection-c1 share-ab" {
+		t.Fatalf("unexpected purge request %s %q", method, keys)
+	}
+}
+
+func TestPurger_FailsOnErrorStatus(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer srv.Close()
+
+	if err := NewPurger(srv.URL, time.Second).Purge(context.Background(), []string{"collection-c1"}); err == nil {
+		t.Fatal("expected an error for a rejected purge")
+	}
+}
@@ -0,0 +1,108 @@
+// Package labels validates collection labels and parses label selectors such as "env=prod,team!=infra".
+package labels
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+const (
+	MaxLabels      = 64
+	maxKeyLength   = 63
+	maxValueLength = 63
+)
+
+var (
+	keyPattern   = regexp.MustCompile(`^[A-Za-z0-9]([A-Za-z0-9._/-]*[A-Za-z0-9])?$`)
+	valuePattern = regexp.MustCompile(`^([A-Za-z0-9]([A-Za-z0-9._-]*[A-Za-z0-9])?)?$`)
+)
+
+// Validate checks the label count and that every key and value is a short alphanumeric string that may
+// contain '-', '_' and '.' (and '/' in keys) in the middle. Values may be empty.
+func Validate(labels map[string]string) error {
+	if len(labels) > MaxLabels {
+		return fmt.Errorf("at most %d labels are allowed", MaxLabels)
+	}
+
+	for key, value := range labels {
+		if err := validateKey(key); err != nil {
+			return err
+		}
+		if len(value) > maxValueLength || !valuePattern.MatchString(value) {
+			return fmt.Errorf("invalid value %q for label %q", value, key)
+		}
+	}
+	return nil
+}
+
+func validateKey(key string) error {
+	if len(key) == 0 || len(key) > maxKeyLength || !keyPattern.MatchString(key) {
+		return fmt.Errorf("invalid label key %q", key)
+	}
+	return nil
+}
+
+type Operator int
+
+const (
+	Equals Operator = iota
+	NotEquals
+	Exists
+	DoesNotExist
+)
+
+type Requirement struct {
+	Key      string
+	Operator Operator
+	// Value is empty for Exists and DoesNotExist
+	Value string
+}
+
+// Selector matches labels that satisfy every requirement. Matching happens in the database, see
+// parseLabelSelector in the server package.
+type Selector []Requirement
+
+// ParseSelector reads a comma separated list of requirements: "key=value" (or "=="), "key!=value",
+// "key" and "!key". A key that is missing entirely satisfies "key!=value". An empty string selects everything.
+func ParseSelector(raw string) (Selector, error) {
+	var selector Selector
+	if strings.TrimSpace(raw) == "" {
+		return selector, nil
+	}
+
+	for _, part := range strings.Split(raw, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			return nil, errors.New("empty requirement in label selector")
+		}
+
+		var req Requirement
+		switch {
+		case strings.Contains(part, "!="):
+			key, value, _ := strings.Cut(part, "!=")
+			req = Requirement{Key: strings.TrimSpace(key), Operator: NotEquals, Value: strings.TrimSpace(value)}
+		case strings.Contains(part, "=="):
+			key, value, _ := strings.Cut(part, "==")
+			req = Requirement{Key: strings.TrimSpace(key), Operator: Equals, Value: strings.TrimSpace(value)}
+		case strings.Contains(part, "="):
+			key, value, _ := strings.Cut(part, "=")
+			req = Requirement{Key: strings.TrimSpace(key), Operator: Equals, Value: strings.TrimSpace(value)}
+		case strings.HasPrefix(part, "!"):
+			req = Requirement{Key: strings.TrimSpace(part[1:]), Operator: DoesNotExist}
+		default:
+			req = Requirement{Key: part, Operator: Exists}
+		}
+
+		if err := validateKey(req.Key); err != nil {
+			return nil, fmt.Errorf("label selector %q: %w", part, err)
+		}
+		if len(req.Value) > maxValueLength || !valuePattern.MatchString(req.Value) {
+			return nil, fmt.Errorf("label selector %q: invalid value %q", part, req.Value)
+		}
+		selector = append(selector, req)
+	}
+
+	return selector, nil
+}
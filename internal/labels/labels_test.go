@@ -0,0 +1,64 @@
+package labels
+
+import (
+	"testing"
+)
+
+func TestValidate(t *testing.T) {
+	valid := map[string]string{"env": "prod", "team": "search-infra", "example.com/tier": "1", "empty": ""}
+	if err := Validate(valid); err != nil {
+		t.Fatalf("labels should be valid: %v", err)
+	}
+
+	for _, invalid := range []map[string]string{
+		{"": "prod"},
+		{"env": "has space"},
+		{"-env": "prod"},
+		{"env": "prod/1"},
+	} {
+		if err := Validate(invalid); err == nil {
+			t.Fatalf("labels %v should be rejected", invalid)
+		}
+	}
+}
+
+func TestParseSelector(t *testing.T) {
+	selector, err := ParseSelector("env=prod, team!=infra,tier==1,owner,!deprecated")
+	if err != nil {
+		t.Fatalf("selector should parse: %v", err)
+	}
+
+	want := Selector{
+		{Key: "env", Operator: Equals, Value: "prod"},
+		{Key: "team", Operator: NotEquals, Value: "infra"},
+		{Key: "tier", Operator: Equals, Value: "1"},
+		{Key: "owner", Operator: Exists},
+		{Key: "deprecated", Operator: DoesNotExist},
+	}
+	if len(selector) != len(want) {
+		t.Fatalf("expected %d requirements, got %+v", len(want), selector)
+	}
+	for i := range want {
+		if selector[i] != want[i] {
+			t.Fatalf("requirement %d: expected %+v, got %+v", i, want[i], selector[i])
+		}
+	}
+}
+
+func TestParseSelector_Empty(t *testing.T) {
+	selector, err := ParseSelector("  ")
+	if err != nil {
+		t.Fatalf("empty selector should parse: %v", err)
+	}
+	if len(selector) != 0 {
+		t.Fatalf("empty selector should have no requirements, got %+v", selector)
+	}
+}
+
+func TestParseSelector_RejectsInvalid(t *testing.T) {
+	for _, raw := range []string{"env=prod,", "=prod", "env=a=b", "!", "env in (a,b)"} {
+		if _, err := ParseSelector(raw); err == nil {
+			t.Fatalf("selector %q should be rejected", raw)
+		}
+	}
+}
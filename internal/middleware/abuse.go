@@ -0,0 +1,254 @@
+package middleware
+
+import (
+	"container/list"
+	"context"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+// RequestCounter is satisfied by SlidingWindowRateLimiter so the detector reuses the limiter's counts.
+type RequestCounter interface {
+	Count(key string) int
+}
+
+type AbuseEvent struct {
+	Token       string
+	Reason      string
+	Requests    int
+	DistinctIPs int
+	Baseline    float64
+	DetectedAt  time.Time
+}
+
+// AbuseHandler is called once per detection, e.g. to suspend the token and notify its owner.
+type AbuseHandler func(ctx context.Context, event AbuseEvent)
+
+type AbuseDetectorConfig struct {
+	// Window is how long distinct IPs are remembered and how often the baseline is sampled.
+	Window time.Duration
+	// MaxDistinctIPs flags a token seen from more addresses than this within Window. Zero disables the check.
+	MaxDistinctIPs int
+	// SpikeFactor flags a token whose request count exceeds this multiple of its baseline. Zero disables the check.
+	SpikeFactor float64
+	// MinRequests stops tokens with very little traffic from being flagged as a spike.
+	MinRequests int
+}
+
+// maxTrackedAbuseKeys bounds how many tokens the detector remembers, the least recently seen one is
+// dropped past it.
+const maxTrackedAbuseKeys = 10000
+
+type abuseState struct {
+	key string
+	// ips stops growing once it holds one address more than MaxDistinctIPs, that is enough to flag the
+	// token. Addresses older than the window are dropped by Run rather than on every request.
+	ips       map[string]time.Time
+	baseline  float64
+	sampledAt time.Time
+	flaggedAt time.Time
+}
+
+type AbuseDetector struct {
+	mu      sync.Mutex
+	counter RequestCounter
+	config  AbuseDetectorConfig
+	handler AbuseHandler
+	keys    map[string]*list.Element
+	// lru holds *abuseState, most recently seen first
+	lru *list.List
+}
+
+func NewAbuseDetector(counter RequestCounter, config AbuseDetectorConfig, handler AbuseHandler) *AbuseDetector {
+	return &AbuseDetector{
+		counter: counter,
+		config:  config,
+		handler: handler,
+		keys:    make(map[string]*list.Element),
+		lru:     list.New(),
+	}
+}
+
+// SetConfig replaces the thresholds, e.g. on a config reload. Per token history is kept.
+func (d *AbuseDetector) SetConfig(config AbuseDetectorConfig) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.config = config
+}
+
+// Observe records a request and returns the event if it tipped the token into looking abusive.
+// A token is only reported once per window so the handler is not called on every request. Keys that
+// cannot be share tokens are ignored, no share link has one.
+func (d *AbuseDetector) Observe(key, ip string) (AbuseEvent, bool) {
+	if !wellFormedShareToken(key) {
+		return AbuseEvent{}, false
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	now := time.Now()
+	cutoff := now.Add(-d.config.Window)
+
+	state := d.state(key, now)
+	if _, seen := state.ips[ip]; ip != "" && (seen || len(state.ips) <= d.config.MaxDistinctIPs) {
+		state.ips[ip] = now
+	}
+
+	count := d.counter.Count(key)
+
+	// sample the baseline once per window as a moving average so slow growth is not treated as a spike
+	if now.Sub(state.sampledAt) >= d.config.Window {
+		if state.baseline == 0 {
+			state.baseline = float64(count)
+		} else {
+			state.baseline = 0.8*state.baseline + 0.2*float64(count)
+		}
+		state.sampledAt = now
+	}
+
+	if state.flaggedAt.After(cutoff) {
+		return AbuseEvent{}, false
+	}
+
+	event := AbuseEvent{
+		Token:       key,
+		Requests:    count,
+		DistinctIPs: len(state.ips),
+		Baseline:    state.baseline,
+		DetectedAt:  now,
+	}
+
+	switch {
+	case d.config.MaxDistinctIPs > 0 && len(state.ips) > d.config.MaxDistinctIPs:
+		event.Reason = "too many distinct client addresses"
+	case d.config.SpikeFactor > 0 && state.baseline > 0 && count >= d.config.MinRequests && float64(count) > d.config.SpikeFactor*state.baseline:
+		event.Reason = "traffic spike above baseline"
+	default:
+		return AbuseEvent{}, false
+	}
+
+	state.flaggedAt = now
+	return event, true
+}
+
+// state returns key's history, evicting the least recently seen token when full. d.mu must be held.
+func (d *AbuseDetector) state(key string, now time.Time) *abuseState {
+	if element, exists := d.keys[key]; exists {
+		d.lru.MoveToFront(element)
+		return element.Value.(*abuseState)
+	}
+
+	state := &abuseState{key: key, ips: make(map[string]time.Time), sampledAt: now}
+	d.keys[key] = d.lru.PushFront(state)
+	if d.lru.Len() > maxTrackedAbuseKeys {
+		oldest := d.lru.Back()
+		d.lru.Remove(oldest)
+		delete(d.keys, oldest.Value.(*abuseState).key)
+	}
+	return state
+}
+
+// Run forgets addresses not seen within the window, once per window until ctx is done. Until then an
+// address can count towards MaxDistinctIPs for up to two windows.
+func (d *AbuseDetector) Run(ctx context.Context) {
+	for {
+		d.mu.Lock()
+		window := d.config.Window
+		d.mu.Unlock()
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(window):
+		}
+		d.pruneIPs(time.Now())
+	}
+}
+
+func (d *AbuseDetector) pruneIPs(now time.Time) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	cutoff := now.Add(-d.config.Window)
+	for _, element := range d.keys {
+		state := element.Value.(*abuseState)
+		for addr, seen := range state.ips {
+			if seen.Before(cutoff) {
+				delete(state.ips, addr)
+			}
+		}
+	}
+}
+
+// AbuseDetectionInterceptor feeds shared collection requests, including copies made from a share link, into
+// the detector. It should run before the rate limiter so requests the limiter rejects are still seen, a
+// throttled scrape keeps spreading across addresses. The limiter's count it reads does not include the current request yet.
+func AbuseDetectionInterceptor(detector *AbuseDetector) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		token, ok := requestShareToken(req)
+		if !ok {
+			return handler(ctx, req)
+		}
+
+		if event, flagged := detector.Observe(token, peerIP(ctx)); flagged && detector.handler != nil {
+			// the suspension should still happen if this client goes away
+			detector.handler(context.WithoutCancel(ctx), event)
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// AbuseDetectionStreamInterceptor feeds WatchSharedCollection streams into the detector when they are opened.
+// Like AbuseDetectionInterceptor it goes before the rate limiter in the chain.
+func AbuseDetectionStreamInterceptor(detector *AbuseDetector) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return handler(srv, &sharedTokenStream{
+			ServerStream: ss,
+			check: func(token string) error {
+				if event, flagged := detector.Observe(token, peerIP(ss.Context())); flagged && detector.handler != nil {
+					detector.handler(context.WithoutCancel(ss.Context()), event)
+				}
+				return nil
+			},
+		})
+	}
+}
+
+// peerIP is the caller's address. Calls from loopback come through the HTTP gateway in this process, which
+// appends the real client address to x-forwarded-for, so the last entry is used instead. Earlier entries
+// are whatever the client sent and are not trusted.
+func peerIP(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return ""
+	}
+
+	host, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		host = p.Addr.String()
+	}
+
+	if ip := net.ParseIP(host); ip != nil && ip.IsLoopback() {
+		if forwarded := metadata.ValueFromIncomingContext(ctx, "x-forwarded-for"); len(forwarded) > 0 {
+			hops := strings.Split(forwarded[len(forwarded)-1], ",")
+			if last := strings.TrimSpace(hops[len(hops)-1]); last != "" {
+				return last
+			}
+		}
+	}
+	return host
+}
@@ -0,0 +1,229 @@
+package middleware
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	censysv1 "github.com/ajscimone/censys-challenge/gen/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+var tokenA = strings.Repeat("a", shareTokenLength)
+
+type fakeCounter map[string]int
+
+func (c fakeCounter) Count(key string) int {
+	return c[key]
+}
+
+func TestAbuseDetector_FlagsDistinctIPs(t *testing.T) {
+	detector := NewAbuseDetector(fakeCounter{}, AbuseDetectorConfig{
+		Window:         time.Minute,
+		MaxDistinctIPs: 3,
+	}, nil)
+
+	for i := 0; i < 3; i++ {
+		if _, flagged := detector.Observe(tokenA, fmt.Sprintf("10.0.0.%d", i)); flagged {
+			t.Fatalf("request from address %d should not be flagged", i+1)
+		}
+	}
+
+	event, flagged := detector.Observe(tokenA, "10.0.0.99")
+	if !flagged {
+		t.Fatal("fourth distinct address should be flagged")
+	}
+	if event.DistinctIPs != 4 {
+		t.Fatalf("expected 4 distinct ips, got %d", event.DistinctIPs)
+	}
+
+	if _, flagged := detector.Observe(tokenA, "10.0.0.100"); flagged {
+		t.Fatal("token should only be reported once per window")
+	}
+}
+
+func TestAbuseDetector_FlagsSpikeAboveBaseline(t *testing.T) {
+	counter := fakeCounter{tokenA: 10}
+	detector := NewAbuseDetector(counter, AbuseDetectorConfig{
+		Window:      20 * time.Millisecond,
+		SpikeFactor: 5,
+		MinRequests: 20,
+	}, nil)
+
+	detector.Observe(tokenA, "10.0.0.1")
+	time.Sleep(25 * time.Millisecond)
+	if _, flagged := detector.Observe(tokenA, "10.0.0.1"); flagged {
+		t.Fatal("steady traffic should not be flagged")
+	}
+
+	counter[tokenA] = 100
+	event, flagged := detector.Observe(tokenA, "10.0.0.1")
+	if !flagged {
+		t.Fatal("spike should be flagged")
+	}
+	if event.Baseline != 10 {
+		t.Fatalf("expected baseline of 10, got %v", event.Baseline)
+	}
+}
+
+func TestAbuseDetector_IgnoresMalformedTokens(t *testing.T) {
+	detector := NewAbuseDetector(fakeCounter{}, AbuseDetectorConfig{Window: time.Minute}, nil)
+
+	for _, key := range []string{"", "abc123", strings.Repeat("z", shareTokenLength)} {
+		detector.Observe(key, "10.0.0.1")
+	}
+	if len(detector.keys) != 0 {
+		t.Fatalf("malformed tokens should not be tracked, got %d", len(detector.keys))
+	}
+}
+
+func TestAbuseDetector_CapsDistinctIPs(t *testing.T) {
+	detector := NewAbuseDetector(fakeCounter{}, AbuseDetectorConfig{
+		Window:         time.Minute,
+		MaxDistinctIPs: 3,
+	}, nil)
+
+	for i := 0; i < 100; i++ {
+		detector.Observe(tokenA, fmt.Sprintf("10.0.0.%d", i))
+	}
+	if ips := len(detector.keys[tokenA].Value.(*abuseState).ips); ips != 4 {
+		t.Fatalf("expected addresses to stop at one past the limit, got %d", ips)
+	}
+
+	detector.pruneIPs(time.Now().Add(2 * time.Minute))
+	if ips := len(detector.keys[tokenA].Value.(*abuseState).ips); ips != 0 {
+		t.Fatalf("addresses older than the window should be pruned, got %d", ips)
+	}
+}
+
+func TestAbuseDetector_EvictsLeastRecentlySeen(t *testing.T) {
+	detector := NewAbuseDetector(fakeCounter{}, AbuseDetectorConfig{Window: time.Minute}, nil)
+
+	token := func(i int) string {
+		return fmt.Sprintf("%064x", i)
+	}
+	for i := 0; i < maxTrackedAbuseKeys; i++ {
+		detector.Observe(token(i), "")
+	}
+	// seeing the oldest token again keeps it, the next oldest goes instead
+	detector.Observe(token(0), "")
+	detector.Observe(token(maxTrackedAbuseKeys), "")
+
+	if len(detector.keys) != maxTrackedAbuseKeys {
+		t.Fatalf("expected %d tracked tokens, got %d", maxTrackedAbuseKeys, len(detector.keys))
+	}
+	if _, ok := detector.keys[token(0)]; !ok {
+		t.Fatal("a recently seen token should not be evicted")
+	}
+	if _, ok := detector.keys[token(1)]; ok {
+		t.Fatal("the least recently seen token should be evicted")
+	}
+}
+
+func TestAbuseDetectionInterceptor_CallsHandler(t *testing.T) {
+	var events []AbuseEvent
+	detector := NewAbuseDetector(fakeCounter{}, AbuseDetectorConfig{
+		Window:         time.Minute,
+		MaxDistinctIPs: 1,
+	}, func(ctx context.Context, event AbuseEvent) {
+		events = append(events, event)
+	})
+
+	interceptor := AbuseDetectionInterceptor(detector)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return &censysv1.Collection{}, nil
+	}
+	req := &censysv1.GetSharedCollectionRequest{Token: tokenA}
+
+	for _, ip := range []string{"10.0.0.1", "10.0.0.2"} {
+		ctx := peer.NewContext(context.Background(), &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP(ip), Port: 4000}})
+		if _, err := interceptor(ctx, req, &grpc.UnaryServerInfo{}, handler); err != nil {
+			t.Fatalf("request should pass through: %v", err)
+		}
+	}
+
+	if len(events) != 1 {
+		t.Fatalf("expected 1 abuse event, got %d", len(events))
+	}
+	if events[0].Token != tokenA {
+		t.Fatalf("expected event for %s, got %q", tokenA, events[0].Token)
+	}
+}
+
+func TestAbuseDetectionInterceptor_ObservesCopiesThroughShareToken(t *testing.T) {
+	var events []AbuseEvent
+	detector := NewAbuseDetector(fakeCounter{}, AbuseDetectorConfig{
+		Window:         time.Minute,
+		MaxDistinctIPs: 1,
+	}, func(ctx context.Context, event AbuseEvent) {
+		events = append(events, event)
+	})
+
+	interceptor := AbuseDetectionInterceptor(detector)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return &censysv1.Collection{}, nil
+	}
+	reqs := []interface{}{
+		&censysv1.GetSharedCollectionRequest{Token: tokenA},
+		&censysv1.CopyCollectionRequest{ShareToken: tokenA},
+	}
+
+	for i, req := range reqs {
+		ctx := peer.NewContext(context.Background(), &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP(fmt.Sprintf("10.0.0.%d", i+1)), Port: 4000}})
+		if _, err := interceptor(ctx, req, &grpc.UnaryServerInfo{}, handler); err != nil {
+			t.Fatalf("request should pass through: %v", err)
+		}
+	}
+
+	if len(events) != 1 {
+		t.Fatalf("a copy from a second address should count towards the token, got %d events", len(events))
+	}
+}
+
+func TestPeerIP_UsesGatewayForwardedAddress(t *testing.T) {
+	loopback := peer.NewContext(context.Background(), &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP("127.0.0.1"), Port: 4000}})
+	forwarded := metadata.NewIncomingContext(loopback, metadata.Pairs("x-forwarded-for", "1.2.3.4, 10.0.0.7"))
+	if ip := peerIP(forwarded); ip != "10.0.0.7" {
+		t.Fatalf("expected the address the gateway appended, got %q", ip)
+	}
+
+	remote := peer.NewContext(context.Background(), &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP("10.0.0.9"), Port: 4000}})
+	spoofed := metadata.NewIncomingContext(remote, metadata.Pairs("x-forwarded-for", "1.2.3.4"))
+	if ip := peerIP(spoofed); ip != "10.0.0.9" {
+		t.Fatalf("x-forwarded-for from a remote peer should be ignored, got %q", ip)
+	}
+}
+
+func TestAbuseDetectionInterceptor_SeesThrottledRequests(t *testing.T) {
+	limiter := NewSlidingWindowRateLimiter(1, time.Minute)
+	var events []AbuseEvent
+	detector := NewAbuseDetector(limiter, AbuseDetectorConfig{
+		Window:         time.Minute,
+		MaxDistinctIPs: 2,
+	}, func(ctx context.Context, event AbuseEvent) {
+		events = append(events, event)
+	})
+
+	// the order main.go chains them in
+	detect, limit := AbuseDetectionInterceptor(detector), RateLimitInterceptor(limiter)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return &censysv1.Collection{}, nil
+	}
+	req := &censysv1.GetSharedCollectionRequest{Token: tokenA}
+
+	for i := 1; i <= 3; i++ {
+		ctx := peer.NewContext(context.Background(), &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP(fmt.Sprintf("10.0.0.%d", i)), Port: 4000}})
+		detect(ctx, req, &grpc.UnaryServerInfo{}, func(ctx context.Context, req interface{}) (interface{}, error) {
+			return limit(ctx, req, &grpc.UnaryServerInfo{}, handler)
+		})
+	}
+
+	if len(events) != 1 {
+		t.Fatalf("requests past the rate limit should still be observed, got %d events", len(events))
+	}
+}
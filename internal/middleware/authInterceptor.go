@@ -16,7 +16,36 @@ type contextKey string
 
 const claimsKey contextKey = "claims"
 
-func AuthInterceptor(auth *authentication.Authenticator) grpc.UnaryServerInterceptor {
+// skipMethods can be called without a token.
+var skipMethods = map[string]bool{
+	"/censys.v1.CollectionService/Login":                 true,
+	"/censys.v1.CollectionService/GetSharedCollection":   true,
+	"/censys.v1.CollectionService/WatchSharedCollection": true,
+	"/censys.v1.AdminService/CreateUser":                 true,
+	"/censys.v1.AdminService/CreateOrganization":         true,
+	"/grpc.health.v1.Health/Check":                       true,
+	"/grpc.health.v1.Health/Watch":                       true,
+}
+
+// optionalTokenMethods can be called without a token too, but a token that is sent is checked so the handler
+// knows the caller. AddOrganizationMember needs to know who grants the admin role.
+var optionalTokenMethods = map[string]bool{
+	"/censys.v1.AdminService/AddOrganizationMember": true,
+}
+
+// serviceMethods can only be called by a service identity. Bearer tokens belong to ordinary users, so without
+// mutual TLS these are not callable at all.
+var serviceMethods = map[string]bool{
+	"/censys.v1.AdminService/UpdateShareToken":           true,
+	"/censys.v1.AdminService/DeleteUser":                 true,
+	"/censys.v1.AdminService/DeleteOrganization":         true,
+	"/censys.v1.AdminService/ListQuarantinedCollections": true,
+	"/censys.v1.AdminService/ReassignCollection":         true,
+}
+
+// AuthInterceptor accepts a bearer token, or a client certificate mapped to one of identities. identities is
+// nil when mutual TLS is off.
+func AuthInterceptor(auth *authentication.Authenticator, identities *ServiceIdentities) grpc.UnaryServerInterceptor {
 	return func(
 		ctx context.Context,
 		req interface{},
@@ -24,43 +53,87 @@ func AuthInterceptor(auth *authentication.Authenticator) grpc.UnaryServerInterce
 		handler grpc.UnaryHandler,
 	) (interface{}, error) {
 
-		skipMethods := map[string]bool{
-			"/censys.v1.CollectionService/Login":               true,
-			"/censys.v1.CollectionService/GetSharedCollection": true,
-			"/censys.v1.AdminService/CreateUser":               true,
-			"/censys.v1.AdminService/CreateOrganization":       true,
-			"/censys.v1.AdminService/AddOrganizationMember":    true,
+		ctx, err := authorize(ctx, auth, identities, info.FullMethod)
+		if err != nil {
+			return nil, err
 		}
 
-		if skipMethods[info.FullMethod] {
-			return handler(ctx, req)
-		}
+		return handler(ctx, req)
+	}
+}
 
-		md, ok := metadata.FromIncomingContext(ctx)
-		if !ok {
-			return nil, status.Error(codes.Unauthenticated, "missing metadata")
-		}
+// AuthStreamInterceptor is AuthInterceptor for streaming RPCs, the caller is checked once when the stream opens.
+func AuthStreamInterceptor(auth *authentication.Authenticator, identities *ServiceIdentities) grpc.StreamServerInterceptor {
+	return func(
+		srv interface{},
+		stream grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
 
-		authHeader := md.Get("authorization")
-		if len(authHeader) == 0 {
-			return nil, status.Error(codes.Unauthenticated, "missing authorization header")
+		ctx, err := authorize(stream.Context(), auth, identities, info.FullMethod)
+		if err != nil {
+			return err
 		}
-
-		token := authHeader[0]
-		if !strings.HasPrefix(token, "Bearer ") {
-			return nil, status.Error(codes.Unauthenticated, "invalid authorization format")
+		if ctx == stream.Context() {
+			return handler(srv, stream)
 		}
-		token = strings.TrimPrefix(token, "Bearer ")
 
-		claims, err := auth.ValidateToken(token)
-		if err != nil {
-			return nil, status.Errorf(codes.Unauthenticated, "invalid token: %v", err)
-		}
+		return handler(srv, &authenticatedStream{ServerStream: stream, ctx: ctx})
+	}
+}
 
-		ctx = context.WithValue(ctx, claimsKey, claims)
+type authenticatedStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
 
-		return handler(ctx, req)
+func (s *authenticatedStream) Context() context.Context {
+	return s.ctx
+}
+
+// authorize decides how a call to method is authenticated and returns the context to handle it with.
+func authorize(ctx context.Context, auth *authentication.Authenticator, identities *ServiceIdentities, method string) (context.Context, error) {
+	if identity, ok := identities.fromPeer(ctx); ok && identity.allows(method) {
+		return context.WithValue(ctx, serviceIdentityKey, identity.Name), nil
+	}
+	if identities.protects(method) || serviceMethods[method] {
+		return nil, status.Error(codes.Unauthenticated, "a client certificate for a service identity is required")
 	}
+
+	if skipMethods[method] {
+		return ctx, nil
+	}
+	if optionalTokenMethods[method] && len(metadata.ValueFromIncomingContext(ctx, "authorization")) == 0 {
+		return ctx, nil
+	}
+	return authenticate(ctx, auth)
+}
+
+// authenticate validates the bearer token in the request metadata and stores its claims in the context.
+func authenticate(ctx context.Context, auth *authentication.Authenticator) (context.Context, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing metadata")
+	}
+
+	authHeader := md.Get("authorization")
+	if len(authHeader) == 0 {
+		return nil, status.Error(codes.Unauthenticated, "missing authorization header")
+	}
+
+	token := authHeader[0]
+	if !strings.HasPrefix(token, "Bearer ") {
+		return nil, status.Error(codes.Unauthenticated, "invalid authorization format")
+	}
+	token = strings.TrimPrefix(token, "Bearer ")
+
+	claims, err := auth.ValidateToken(token)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "invalid token: %v", err)
+	}
+
+	return context.WithValue(ctx, claimsKey, claims), nil
 }
 
 func UserIDFromContext(ctx context.Context) (int32, error) {
@@ -0,0 +1,132 @@
+package middleware
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"net/url"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+const deleteUserMethod = "/censys.v1.AdminService/DeleteUser"
+
+func adminIdentities() *ServiceIdentities {
+	return NewServiceIdentities([]ServiceIdentity{{
+		Name:        "admin-automation",
+		Certificate: "spiffe://censys/admin-bot",
+		Methods:     []string{"/censys.v1.AdminService/"},
+	}})
+}
+
+// certificateContext is a call over a connection that presented cert, verified when verified is set.
+func certificateContext(cert *x509.Certificate, verified bool) context.Context {
+	state := tls.ConnectionState{PeerCertificates: []*x509.Certificate{cert}}
+	if verified {
+		state.VerifiedChains = [][]*x509.Certificate{{cert}}
+	}
+	return peer.NewContext(context.Background(), &peer.Peer{AuthInfo: credentials.TLSInfo{State: state}})
+}
+
+func callAuth(ctx context.Context, identities *ServiceIdentities, method string) (string, error) {
+	var identity string
+	_, err := AuthInterceptor(nil, identities)(ctx, nil, &grpc.UnaryServerInfo{FullMethod: method},
+		func(ctx context.Context, req interface{}) (interface{}, error) {
+			identity, _ = ServiceIdentityFromContext(ctx)
+			return nil, nil
+		})
+	return identity, err
+}
+
+func TestAuthInterceptor_AcceptsServiceIdentity(t *testing.T) {
+	uri, _ := url.Parse("spiffe://censys/admin-bot")
+	ctx := certificateContext(&x509.Certificate{URIs: []*url.URL{uri}}, true)
+
+	identity, err := callAuth(ctx, adminIdentities(), deleteUserMethod)
+	if err != nil {
+		t.Fatalf("the admin identity should be accepted: %v", err)
+	}
+	if identity != "admin-automation" {
+		t.Fatalf("expected the identity in the context, got %q", identity)
+	}
+}
+
+func TestAuthInterceptor_ProtectedMethodsNeedCertificate(t *testing.T) {
+	tests := map[string]context.Context{
+		"no certificate":         context.Background(),
+		"unverified certificate": certificateContext(&x509.Certificate{Subject: pkix.Name{CommonName: "spiffe://censys/admin-bot"}}, false),
+		"unknown certificate":    certificateContext(&x509.Certificate{Subject: pkix.Name{CommonName: "someone-else"}}, true),
+	}
+
+	for name, ctx := range tests {
+		if _, err := callAuth(ctx, adminIdentities(), deleteUserMethod); status.Code(err) != codes.Unauthenticated {
+			t.Errorf("%s: expected Unauthenticated, got %v", name, err)
+		}
+	}
+}
+
+func TestAuthInterceptor_IdentityDoesNotCoverOtherMethods(t *testing.T) {
+	uri, _ := url.Parse("spiffe://censys/admin-bot")
+	ctx := certificateContext(&x509.Certificate{URIs: []*url.URL{uri}}, true)
+
+	// user methods still need a bearer token
+	if _, err := callAuth(ctx, adminIdentities(), "/censys.v1.CollectionService/GetCollection"); status.Code(err) != codes.Unauthenticated {
+		t.Fatalf("expected Unauthenticated, got %v", err)
+	}
+	// and methods open to everyone stay open
+	if _, err := callAuth(context.Background(), adminIdentities(), "/censys.v1.CollectionService/Login"); err != nil {
+		t.Fatalf("Login needs no credentials: %v", err)
+	}
+}
+
+func TestAuthInterceptor_DestructiveAdminMethodsNeedIdentity(t *testing.T) {
+	for _, method := range []string{
+		deleteUserMethod,
+		"/censys.v1.AdminService/DeleteOrganization",
+		"/censys.v1.AdminService/ListQuarantinedCollections",
+		"/censys.v1.AdminService/ReassignCollection",
+	} {
+		if _, err := callAuth(context.Background(), nil, method); status.Code(err) != codes.Unauthenticated {
+			t.Errorf("%s: expected Unauthenticated for an anonymous call, got %v", method, err)
+		}
+	}
+
+	// setting up users and organizations stays open for local setups
+	if _, err := callAuth(context.Background(), nil, "/censys.v1.AdminService/CreateUser"); err != nil {
+		t.Fatalf("CreateUser needs no credentials: %v", err)
+	}
+}
+
+func TestAuthInterceptor_ServiceMethodsNeedIdentity(t *testing.T) {
+	const method = "/censys.v1.AdminService/UpdateShareToken"
+
+	if _, err := callAuth(context.Background(), nil, method); status.Code(err) != codes.Unauthenticated {
+		t.Fatalf("without mutual TLS nobody can call it, got %v", err)
+	}
+
+	uri, _ := url.Parse("spiffe://censys/admin-bot")
+	ctx := certificateContext(&x509.Certificate{URIs: []*url.URL{uri}}, true)
+	if _, err := callAuth(ctx, adminIdentities(), method); err != nil {
+		t.Fatalf("the admin identity should be accepted: %v", err)
+	}
+}
+
+func TestAuthInterceptor_AddOrganizationMemberChecksTokenWhenSent(t *testing.T) {
+	const method = "/censys.v1.AdminService/AddOrganizationMember"
+
+	if _, err := callAuth(context.Background(), nil, method); err != nil {
+		t.Fatalf("adding a plain member needs no credentials: %v", err)
+	}
+
+	malformed := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Basic abc"))
+	if _, err := callAuth(malformed, nil, method); status.Code(err) != codes.Unauthenticated {
+		t.Fatalf("a token that is sent should be checked, got %v", err)
+	}
+}
@@ -13,6 +13,12 @@ import (
 
 type RateLimiter interface {
 	Allow(key string) bool
+	AllowLimit(key string, limit int, window time.Duration) bool
+}
+
+// RateLimitOverrides looks up a per key limit that should be used instead of the limiter default.
+type RateLimitOverrides interface {
+	RateLimitFor(ctx context.Context, key string) (limit int, window time.Duration, ok bool)
 }
 
 type entry struct {
@@ -35,12 +41,33 @@ func NewSlidingWindowRateLimiter(limit int, window time.Duration) *SlidingWindow
 }
 
 func (s *SlidingWindowRateLimiter) Allow(key string) bool {
+	return s.AllowLimit(key, 0, 0)
+}
+
+// SetDefaults changes the default limit and window, e.g. on a config reload. Existing counts are kept.
+func (s *SlidingWindowRateLimiter) SetDefaults(limit int, window time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.limit = limit
+	s.window = window
+}
+
+// AllowLimit is Allow with a caller supplied limit and window. Zero values use the limiter defaults.
+func (s *SlidingWindowRateLimiter) AllowLimit(key string, limit int, window time.Duration) bool {
 	// this would be better as a read lock
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
+	if limit <= 0 {
+		limit = s.limit
+	}
+	if window <= 0 {
+		window = s.window
+	}
+
 	now := time.Now()
-	cutoff := now.Add(-s.window)
+	cutoff := now.Add(-window)
 
 	e, exists := s.keys[key]
 	if !exists {
@@ -56,7 +83,7 @@ func (s *SlidingWindowRateLimiter) Allow(key string) bool {
 		}
 	}
 
-	if len(pruned) >= s.limit {
+	if len(pruned) >= limit {
 		e.timestamps = pruned
 		return false
 	}
@@ -65,22 +92,108 @@ func (s *SlidingWindowRateLimiter) Allow(key string) bool {
 	return true
 }
 
+// Count returns how many requests were allowed for key within the default window.
+func (s *SlidingWindowRateLimiter) Count(key string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	e, exists := s.keys[key]
+	if !exists {
+		return 0
+	}
+
+	cutoff := time.Now().Add(-s.window)
+	count := 0
+	for _, ts := range e.timestamps {
+		if ts.After(cutoff) {
+			count++
+		}
+	}
+	return count
+}
+
 func RateLimitInterceptor(limiter RateLimiter) grpc.UnaryServerInterceptor {
+	return RateLimitInterceptorWithOverrides(limiter, nil)
+}
+
+// RateLimitInterceptorWithOverrides consults overrides for a token specific limit before falling back to the limiter default.
+func RateLimitInterceptorWithOverrides(limiter RateLimiter, overrides RateLimitOverrides) grpc.UnaryServerInterceptor {
 	return func(
 		ctx context.Context,
 		req interface{},
 		info *grpc.UnaryServerInfo,
 		handler grpc.UnaryHandler,
 	) (interface{}, error) {
-		sharedReq, ok := req.(*censysv1.GetSharedCollectionRequest)
+		token, ok := requestShareToken(req)
 		if !ok {
 			return handler(ctx, req)
 		}
 
-		if !limiter.Allow(sharedReq.Token) {
-			return nil, status.Error(codes.ResourceExhausted, "rate limit exceeded")
+		if err := allowToken(ctx, limiter, overrides, token); err != nil {
+			return nil, err
 		}
 
 		return handler(ctx, req)
 	}
 }
+
+// RateLimitStreamInterceptorWithOverrides counts opening a WatchSharedCollection stream as one request on its token.
+func RateLimitStreamInterceptorWithOverrides(limiter RateLimiter, overrides RateLimitOverrides) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return handler(srv, &sharedTokenStream{
+			ServerStream: ss,
+			check: func(token string) error {
+				return allowToken(ss.Context(), limiter, overrides, token)
+			},
+		})
+	}
+}
+
+// requestShareToken returns the share token a unary request reads a collection through: GetSharedCollection, or
+// CopyCollection from a share link, which reads as much as a get and must not be a way around the limit.
+func requestShareToken(req interface{}) (string, bool) {
+	switch req := req.(type) {
+	case *censysv1.GetSharedCollectionRequest:
+		return req.Token, true
+	case *censysv1.CopyCollectionRequest:
+		return req.ShareToken, req.ShareToken != ""
+	}
+	return "", false
+}
+
+func allowToken(ctx context.Context, limiter RateLimiter, overrides RateLimitOverrides, token string) error {
+	allowed := false
+	if limit, window, ok := lookupOverride(ctx, overrides, token); ok {
+		allowed = limiter.AllowLimit(token, limit, window)
+	} else {
+		allowed = limiter.Allow(token)
+	}
+
+	if !allowed {
+		return status.Error(codes.ResourceExhausted, "rate limit exceeded")
+	}
+	return nil
+}
+
+// sharedTokenStream runs check on the share token of a WatchSharedCollection request as the handler receives it.
+type sharedTokenStream struct {
+	grpc.ServerStream
+	check func(token string) error
+}
+
+func (s *sharedTokenStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	if req, ok := m.(*censysv1.WatchSharedCollectionRequest); ok {
+		return s.check(req.Token)
+	}
+	return nil
+}
+
+func lookupOverride(ctx context.Context, overrides RateLimitOverrides, key string) (int, time.Duration, bool) {
+	if overrides == nil {
+		return 0, 0, false
+	}
+	return overrides.RateLimitFor(ctx, key)
+}
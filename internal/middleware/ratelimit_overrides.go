@@ -0,0 +1,129 @@
+package middleware
+
+import (
+	"container/list"
+	"context"
+	"encoding/hex"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/ajscimone/censys-challenge/internal/db"
+	"github.com/jackc/pgx/v5"
+)
+
+// maxCachedOverrides bounds the lookup cache, the least recently used entry is evicted past it so unknown
+// tokens cannot grow it forever
+const maxCachedOverrides = 10000
+
+// shareTokenLength is the length of the hex encoded 32 byte tokens share links are created with.
+const shareTokenLength = 64
+
+type cachedOverride struct {
+	token   string
+	limit   int
+	window  time.Duration
+	ok      bool
+	expires time.Time
+}
+
+// ShareLinkRateLimitQuerier is the query ShareLinkRateLimits reads overrides with, *db.Queries implements it.
+type ShareLinkRateLimitQuerier interface {
+	GetShareLinkRateLimit(ctx context.Context, token string) (db.GetShareLinkRateLimitRow, error)
+}
+
+// ShareLinkRateLimits reads per token overrides from share_links. Lookups, including tokens without an
+// override or that do not exist, are cached for ttl so a hot token does not cost an extra query per
+// request, which means updates can take up to ttl to apply.
+type ShareLinkRateLimits struct {
+	queries ShareLinkRateLimitQuerier
+	ttl     time.Duration
+
+	mu    sync.Mutex
+	cache map[string]*list.Element
+	// lru holds *cachedOverride, most recently used first
+	lru *list.List
+}
+
+func NewShareLinkRateLimits(queries ShareLinkRateLimitQuerier, ttl time.Duration) *ShareLinkRateLimits {
+	return &ShareLinkRateLimits{
+		queries: queries,
+		ttl:     ttl,
+		cache:   make(map[string]*list.Element),
+		lru:     list.New(),
+	}
+}
+
+// SetTTL changes how long lookups are cached. Entries already cached keep their old expiry.
+func (s *ShareLinkRateLimits) SetTTL(ttl time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.ttl = ttl
+}
+
+func (s *ShareLinkRateLimits) RateLimitFor(ctx context.Context, token string) (int, time.Duration, bool) {
+	// no share link has a token of any other shape, so there is nothing to look up or cache
+	if !wellFormedShareToken(token) {
+		return 0, 0, false
+	}
+
+	now := time.Now()
+
+	s.mu.Lock()
+	ttl := s.ttl
+	if element, exists := s.cache[token]; exists {
+		cached := element.Value.(*cachedOverride)
+		if now.Before(cached.expires) {
+			s.lru.MoveToFront(element)
+			s.mu.Unlock()
+			return cached.limit, cached.window, cached.ok
+		}
+	}
+	s.mu.Unlock()
+
+	override := &cachedOverride{token: token}
+	row, err := s.queries.GetShareLinkRateLimit(ctx, token)
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		// dont cache transient failures, fall back to the default limit for this request
+		return 0, 0, false
+	}
+	if err == nil && row.RateLimit.Valid {
+		override.limit = int(row.RateLimit.Int32)
+		override.ok = true
+		if row.RateLimitWindowSeconds.Valid {
+			override.window = time.Duration(row.RateLimitWindowSeconds.Int32) * time.Second
+		}
+	}
+	override.expires = now.Add(ttl)
+
+	s.mu.Lock()
+	s.store(override)
+	s.mu.Unlock()
+
+	return override.limit, override.window, override.ok
+}
+
+// store caches override, evicting the least recently used entry when full. s.mu must be held.
+func (s *ShareLinkRateLimits) store(override *cachedOverride) {
+	if element, exists := s.cache[override.token]; exists {
+		element.Value = override
+		s.lru.MoveToFront(element)
+		return
+	}
+
+	s.cache[override.token] = s.lru.PushFront(override)
+	if s.lru.Len() > maxCachedOverrides {
+		oldest := s.lru.Back()
+		s.lru.Remove(oldest)
+		delete(s.cache, oldest.Value.(*cachedOverride).token)
+	}
+}
+
+func wellFormedShareToken(token string) bool {
+	if len(token) != shareTokenLength {
+		return false
+	}
+	_, err := hex.DecodeString(token)
+	return err == nil
+}
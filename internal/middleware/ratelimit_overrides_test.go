@@ -0,0 +1,87 @@
+package middleware
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/ajscimone/censys-challenge/internal/db"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// countingQuerier knows overrides for a fixed set of tokens and counts lookups.
+type countingQuerier struct {
+	limits  map[string]int32
+	queries int
+}
+
+func (q *countingQuerier) GetShareLinkRateLimit(ctx context.Context, token string) (db.GetShareLinkRateLimitRow, error) {
+	q.queries++
+	limit, ok := q.limits[token]
+	if !ok {
+		return db.GetShareLinkRateLimitRow{}, pgx.ErrNoRows
+	}
+	return db.GetShareLinkRateLimitRow{RateLimit: pgtype.Int4{Int32: limit, Valid: true}}, nil
+}
+
+func testToken(i int) string {
+	return fmt.Sprintf("%064x", i)
+}
+
+func TestShareLinkRateLimits_CachesOverridesAndMisses(t *testing.T) {
+	querier := &countingQuerier{limits: map[string]int32{testToken(1): 5}}
+	overrides := NewShareLinkRateLimits(querier, time.Minute)
+
+	for i := 0; i < 3; i++ {
+		if limit, _, ok := overrides.RateLimitFor(context.Background(), testToken(1)); !ok || limit != 5 {
+			t.Fatalf("expected the override of 5, got %d %v", limit, ok)
+		}
+		if _, _, ok := overrides.RateLimitFor(context.Background(), testToken(2)); ok {
+			t.Fatal("an unknown token has no override")
+		}
+	}
+	if querier.queries != 2 {
+		t.Fatalf("expected one query per token, got %d", querier.queries)
+	}
+}
+
+func TestShareLinkRateLimits_SkipsMalformedTokens(t *testing.T) {
+	querier := &countingQuerier{}
+	overrides := NewShareLinkRateLimits(querier, time.Minute)
+
+	for _, token := range []string{"", "campaign", testToken(1)[:63], "zz" + testToken(1)[2:]} {
+		overrides.RateLimitFor(context.Background(), token)
+	}
+	if querier.queries != 0 {
+		t.Fatalf("malformed tokens should not be looked up, got %d queries", querier.queries)
+	}
+}
+
+func TestShareLinkRateLimits_EvictsLeastRecentlyUsed(t *testing.T) {
+	querier := &countingQuerier{}
+	overrides := NewShareLinkRateLimits(querier, time.Minute)
+
+	for i := 0; i <= maxCachedOverrides; i++ {
+		overrides.RateLimitFor(context.Background(), testToken(i))
+		if i == 0 {
+			continue
+		}
+		// keep the first token in use so the second one is the oldest
+		overrides.RateLimitFor(context.Background(), testToken(0))
+	}
+	if overrides.lru.Len() != maxCachedOverrides {
+		t.Fatalf("cache should be bounded at %d, has %d", maxCachedOverrides, overrides.lru.Len())
+	}
+
+	before := querier.queries
+	overrides.RateLimitFor(context.Background(), testToken(0))
+	if querier.queries != before {
+		t.Fatal("a recently used token should still be cached")
+	}
+	overrides.RateLimitFor(context.Background(), testToken(1))
+	if querier.queries != before+1 {
+		t.Fatal("the least recently used token should have been evicted")
+	}
+}
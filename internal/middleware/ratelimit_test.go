@@ -139,3 +139,132 @@ func TestRateLimitInterceptor_PassesThroughNonSharedRequests(t *testing.T) {
 		t.Fatal("handler should have been called")
 	}
 }
+
+func TestRequestShareToken(t *testing.T) {
+	tests := []struct {
+		name      string
+		req       interface{}
+		wantToken string
+		wantOK    bool
+	}{
+		{name: "get shared", req: &censysv1.GetSharedCollectionRequest{Token: "abc123"}, wantToken: "abc123", wantOK: true},
+		{name: "copy by share token", req: &censysv1.CopyCollectionRequest{ShareToken: "abc123"}, wantToken: "abc123", wantOK: true},
+		{name: "copy by uid", req: &censysv1.CopyCollectionRequest{Uid: "c1"}},
+		{name: "other request", req: &censysv1.CreateCollectionRequest{Name: "test"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			token, ok := requestShareToken(tt.req)
+			if token != tt.wantToken || ok != tt.wantOK {
+				t.Fatalf("expected (%q, %v), got (%q, %v)", tt.wantToken, tt.wantOK, token, ok)
+			}
+		})
+	}
+}
+
+func TestRateLimitInterceptor_BlocksCopyFromThrottledToken(t *testing.T) {
+	limiter := NewSlidingWindowRateLimiter(1, 1*time.Minute)
+
+	interceptor := RateLimitInterceptor(limiter)
+	called := false
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		called = true
+		return &censysv1.Collection{}, nil
+	}
+
+	if _, err := interceptor(context.Background(), &censysv1.GetSharedCollectionRequest{Token: "abc123"}, &grpc.UnaryServerInfo{}, handler); err != nil {
+		t.Fatalf("first request should succeed: %v", err)
+	}
+
+	called = false
+	_, err := interceptor(context.Background(), &censysv1.CopyCollectionRequest{ShareToken: "abc123"}, &grpc.UnaryServerInfo{}, handler)
+	if status.Code(err) != codes.ResourceExhausted {
+		t.Fatalf("copying through a throttled token should be ResourceExhausted, got %v", err)
+	}
+	if called {
+		t.Fatal("the copy should not reach the handler")
+	}
+}
+
+func TestSlidingWindowRateLimiter_AllowLimitUsesCallerLimit(t *testing.T) {
+	limiter := NewSlidingWindowRateLimiter(1, 1*time.Minute)
+
+	for i := 0; i < 3; i++ {
+		if !limiter.AllowLimit("token-a", 3, 0) {
+			t.Fatalf("request %d should have been allowed", i+1)
+		}
+	}
+
+	if limiter.AllowLimit("token-a", 3, 0) {
+		t.Fatal("request 4 should have been denied")
+	}
+}
+
+type staticOverrides map[string]int
+
+func (o staticOverrides) RateLimitFor(ctx context.Context, key string) (int, time.Duration, bool) {
+	limit, ok := o[key]
+	return limit, 0, ok
+}
+
+func TestRateLimitInterceptorWithOverrides_UsesTokenLimit(t *testing.T) {
+	limiter := NewSlidingWindowRateLimiter(1, 1*time.Minute)
+
+	interceptor := RateLimitInterceptorWithOverrides(limiter, staticOverrides{"campaign": 2})
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return &censysv1.Collection{}, nil
+	}
+
+	campaign := &censysv1.GetSharedCollectionRequest{Token: "campaign"}
+	for i := 0; i < 2; i++ {
+		if _, err := interceptor(context.Background(), campaign, &grpc.UnaryServerInfo{}, handler); err != nil {
+			t.Fatalf("request %d for overridden token should succeed: %v", i+1, err)
+		}
+	}
+	if _, err := interceptor(context.Background(), campaign, &grpc.UnaryServerInfo{}, handler); status.Code(err) != codes.ResourceExhausted {
+		t.Fatalf("expected ResourceExhausted after override limit, got %v", err)
+	}
+
+	other := &censysv1.GetSharedCollectionRequest{Token: "other"}
+	if _, err := interceptor(context.Background(), other, &grpc.UnaryServerInfo{}, handler); err != nil {
+		t.Fatalf("first request for default token should succeed: %v", err)
+	}
+	if _, err := interceptor(context.Background(), other, &grpc.UnaryServerInfo{}, handler); status.Code(err) != codes.ResourceExhausted {
+		t.Fatalf("expected ResourceExhausted at default limit, got %v", err)
+	}
+}
+
+// watchStream hands the handler one WatchSharedCollectionRequest.
+type watchStream struct {
+	grpc.ServerStream
+	token string
+}
+
+func (s *watchStream) Context() context.Context {
+	return context.Background()
+}
+
+func (s *watchStream) RecvMsg(m interface{}) error {
+	m.(*censysv1.WatchSharedCollectionRequest).Token = s.token
+	return nil
+}
+
+func TestRateLimitStreamInterceptor_CountsWatchAsRequest(t *testing.T) {
+	limiter := NewSlidingWindowRateLimiter(1, 1*time.Minute)
+
+	interceptor := RateLimitStreamInterceptorWithOverrides(limiter, nil)
+	handler := func(srv interface{}, stream grpc.ServerStream) error {
+		return stream.RecvMsg(&censysv1.WatchSharedCollectionRequest{})
+	}
+
+	if err := interceptor(nil, &watchStream{token: "abc123"}, &grpc.StreamServerInfo{}, handler); err != nil {
+		t.Fatalf("first watch should succeed: %v", err)
+	}
+	if err := interceptor(nil, &watchStream{token: "abc123"}, &grpc.StreamServerInfo{}, handler); status.Code(err) != codes.ResourceExhausted {
+		t.Fatalf("expected ResourceExhausted for second watch, got %v", err)
+	}
+	if !limiter.Allow("other") {
+		t.Fatal("other tokens should not be limited")
+	}
+}
@@ -0,0 +1,88 @@
+package middleware
+
+import (
+	"context"
+	"strings"
+
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+)
+
+const serviceIdentityKey contextKey = "service_identity"
+
+// ServiceIdentity lets internal callers presenting a verified client certificate call Methods without a
+// bearer token. Certificate is matched against the certificate's URI SANs and its common name. Methods are
+// full method names, one ending in "/" covers a whole service.
+type ServiceIdentity struct {
+	Name        string
+	Certificate string
+	Methods     []string
+}
+
+func (identity ServiceIdentity) allows(method string) bool {
+	for _, allowed := range identity.Methods {
+		if allowed == method || (strings.HasSuffix(allowed, "/") && strings.HasPrefix(method, allowed)) {
+			return true
+		}
+	}
+	return false
+}
+
+// ServiceIdentities are the identities accepted from client certificates. Methods any identity covers can
+// only be called with a certificate, even ones that otherwise need no token. A nil *ServiceIdentities
+// accepts none and protects nothing.
+type ServiceIdentities struct {
+	byCertificate map[string]ServiceIdentity
+}
+
+func NewServiceIdentities(identities []ServiceIdentity) *ServiceIdentities {
+	s := &ServiceIdentities{byCertificate: make(map[string]ServiceIdentity, len(identities))}
+	for _, identity := range identities {
+		s.byCertificate[identity.Certificate] = identity
+	}
+	return s
+}
+
+// protects reports whether method is reserved for service identities.
+func (s *ServiceIdentities) protects(method string) bool {
+	if s == nil {
+		return false
+	}
+	for _, identity := range s.byCertificate {
+		if identity.allows(method) {
+			return true
+		}
+	}
+	return false
+}
+
+// fromPeer returns the identity of the client certificate the connection was verified with.
+func (s *ServiceIdentities) fromPeer(ctx context.Context) (ServiceIdentity, bool) {
+	if s == nil {
+		return ServiceIdentity{}, false
+	}
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return ServiceIdentity{}, false
+	}
+	tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo)
+	// only verified chains count, a presented but unverified certificate proves nothing
+	if !ok || len(tlsInfo.State.VerifiedChains) == 0 || len(tlsInfo.State.VerifiedChains[0]) == 0 {
+		return ServiceIdentity{}, false
+	}
+
+	leaf := tlsInfo.State.VerifiedChains[0][0]
+	for _, uri := range leaf.URIs {
+		if identity, ok := s.byCertificate[uri.String()]; ok {
+			return identity, true
+		}
+	}
+	identity, ok := s.byCertificate[leaf.Subject.CommonName]
+	return identity, ok
+}
+
+// ServiceIdentityFromContext returns the name of the service identity that made the call, if it was one.
+func ServiceIdentityFromContext(ctx context.Context) (string, bool) {
+	name, ok := ctx.Value(serviceIdentityKey).(string)
+	return name, ok
+}
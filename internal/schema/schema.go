@@ -0,0 +1,92 @@
+package schema
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/santhosh-tekuri/jsonschema/v6"
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
+)
+
+// schemaURL is only used as the resource name inside the compiler, nothing is fetched from it
+const schemaURL = "collection-schema.json"
+
+var printer = message.NewPrinter(language.English)
+
+// Violation is one failed constraint, Field is a dotted path from the top of the collection
+// (e.g. "data.query.limit") so it can be used directly in a google.rpc.BadRequest.
+type Violation struct {
+	Field       string
+	Description string
+}
+
+type Schema struct {
+	compiled *jsonschema.Schema
+}
+
+// Compile parses and compiles a JSON Schema document. Remote $refs are not resolved.
+func Compile(raw []byte) (*Schema, error) {
+	doc, err := jsonschema.UnmarshalJSON(bytes.NewReader(raw))
+	if err != nil {
+		return nil, fmt.Errorf("invalid json: %w", err)
+	}
+
+	compiler := jsonschema.NewCompiler()
+	compiler.UseLoader(noRemoteLoader{})
+	if err := compiler.AddResource(schemaURL, doc); err != nil {
+		return nil, fmt.Errorf("invalid schema: %w", err)
+	}
+
+	compiled, err := compiler.Compile(schemaURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid schema: %w", err)
+	}
+
+	return &Schema{compiled: compiled}, nil
+}
+
+// Validate checks data against the schema and returns one violation per failed constraint.
+func (s *Schema) Validate(data []byte) ([]Violation, error) {
+	doc, err := jsonschema.UnmarshalJSON(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("invalid json: %w", err)
+	}
+
+	err = s.compiled.Validate(doc)
+	if err == nil {
+		return nil, nil
+	}
+
+	var validationErr *jsonschema.ValidationError
+	if !errors.As(err, &validationErr) {
+		return nil, err
+	}
+
+	var violations []Violation
+	collectViolations(validationErr, &violations)
+	return violations, nil
+}
+
+// collectViolations keeps only the leaves, the inner nodes just say that a nested check failed.
+func collectViolations(err *jsonschema.ValidationError, violations *[]Violation) {
+	if len(err.Causes) == 0 {
+		*violations = append(*violations, Violation{
+			Field:       strings.Join(append([]string{"data"}, err.InstanceLocation...), "."),
+			Description: err.ErrorKind.LocalizedString(printer),
+		})
+		return
+	}
+
+	for _, cause := range err.Causes {
+		collectViolations(cause, violations)
+	}
+}
+
+type noRemoteLoader struct{}
+
+func (noRemoteLoader) Load(url string) (any, error) {
+	return nil, fmt.Errorf("loading %s is not allowed, schemas must be self contained", url)
+}
@@ -0,0 +1,75 @@
+package schema
+
+import (
+	"testing"
+)
+
+const savedSearchSchema = `{
+	"type": "object",
+	"required": ["type", "query"],
+	"properties": {
+		"type": {"const": "saved_search"},
+		"query": {"type": "string", "minLength": 1},
+		"options": {
+			"type": "object",
+			"properties": {"limit": {"type": "integer", "maximum": 100}}
+		}
+	}
+}`
+
+func TestCompile_RejectsInvalidSchema(t *testing.T) {
+	if _, err := Compile([]byte(`{"type": "not-a-type"}`)); err == nil {
+		t.Fatal("schema with an unknown type should not compile")
+	}
+
+	if _, err := Compile([]byte(`{"type":`)); err == nil {
+		t.Fatal("malformed json should not compile")
+	}
+}
+
+func TestCompile_RejectsRemoteRefs(t *testing.T) {
+	if _, err := Compile([]byte(`{"$ref": "https://example.com/schema.json"}`)); err == nil {
+		t.Fatal("remote refs should not be resolved")
+	}
+}
+
+func TestValidate_AcceptsValidData(t *testing.T) {
+	s, err := Compile([]byte(savedSearchSchema))
+	if err != nil {
+		t.Fatalf("schema should compile: %v", err)
+	}
+
+	violations, err := s.Validate([]byte(`{"type": "saved_search", "query": "test", "options": {"limit": 10}}`))
+	if err != nil {
+		t.Fatalf("validate should not error: %v", err)
+	}
+	if len(violations) != 0 {
+		t.Fatalf("expected no violations, got %+v", violations)
+	}
+}
+
+func TestValidate_ReportsFieldViolations(t *testing.T) {
+	s, err := Compile([]byte(savedSearchSchema))
+	if err != nil {
+		t.Fatalf("schema should compile: %v", err)
+	}
+
+	violations, err := s.Validate([]byte(`{"type": "saved_search", "query": "", "options": {"limit": 1000}}`))
+	if err != nil {
+		t.Fatalf("validate should not error: %v", err)
+	}
+
+	fields := map[string]bool{}
+	for _, v := range violations {
+		fields[v.Field] = true
+		if v.Description == "" {
+			t.Fatalf("violation for %s has no description", v.Field)
+		}
+	}
+
+	for _, want := range []string{"data.query", "data.options.limit"} {
+		if !fields[want] {
+			t.Fatalf("expected a violation for %s, got %+v", want, violations)
+		}
+	}
+}
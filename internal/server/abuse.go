@@ -0,0 +1,53 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/ajscimone/censys-challenge/internal/db"
+	"github.com/ajscimone/censys-challenge/internal/middleware"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// OwnerNotifier tells the owner of a collection that one of its share tokens was flagged.
+type OwnerNotifier func(ctx context.Context, owner db.User, event middleware.AbuseEvent, suspended bool)
+
+// LogOwnerNotifier stands in for real notifications (email, webhooks) which are out of scope here.
+func LogOwnerNotifier(ctx context.Context, owner db.User, event middleware.AbuseEvent, suspended bool) {
+	log.Printf("abuse detected on share token for %s: %s (requests=%d distinct_ips=%d baseline=%.1f suspended=%t)",
+		owner.Email, event.Reason, event.Requests, event.DistinctIPs, event.Baseline, suspended)
+}
+
+// NewAbuseHandler returns the hook the abuse detector calls. When autoSuspend is false tokens are only
+// reported to their owner and keep being served (subject to the rate limiter).
+func NewAbuseHandler(queries *db.Queries, autoSuspend bool, notify OwnerNotifier) middleware.AbuseHandler {
+	return func(ctx context.Context, event middleware.AbuseEvent) {
+		shareLink, err := queries.GetShareLinkByToken(ctx, event.Token)
+		if err != nil {
+			// unknown tokens can be flagged too, there is nothing to suspend or notify
+			return
+		}
+
+		suspended := false
+		if autoSuspend && !shareLink.SuspendedAt.Valid {
+			_, err := queries.SuspendShareLink(ctx, db.SuspendShareLinkParams{
+				Token:           event.Token,
+				SuspendedReason: pgtype.Text{String: fmt.Sprintf("automatically suspended: %s", event.Reason), Valid: true},
+			})
+			if err != nil {
+				log.Printf("failed to suspend share token: %v", err)
+			} else {
+				suspended = true
+			}
+		}
+
+		owner, err := queries.GetUserByID(ctx, shareLink.CreatedBy)
+		if err != nil {
+			log.Printf("failed to look up share token owner: %v", err)
+			return
+		}
+
+		notify(ctx, owner, event, suspended)
+	}
+}
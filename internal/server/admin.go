@@ -2,22 +2,35 @@ package server
 
 import (
 	"context"
+	"errors"
 
 	"github.com/ajscimone/censys-challenge/gen/proto"
 	"github.com/ajscimone/censys-challenge/internal/db"
+	"github.com/ajscimone/censys-challenge/internal/middleware"
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
 
+type AdminServerConfig struct {
+	// OrphanPolicy applies to collections left without an owner or organization by a deletion.
+	OrphanPolicy OrphanPolicy
+}
+
 type AdminServer struct {
 	censysv1.UnimplementedAdminServiceServer
+	pool    *pgxpool.Pool
 	queries *db.Queries
+	config  AdminServerConfig
 }
 
-func NewAdminServer(queries *db.Queries) *AdminServer {
+func NewAdminServer(pool *pgxpool.Pool, config AdminServerConfig) *AdminServer {
 	return &AdminServer{
-		queries: queries,
+		pool:    pool,
+		queries: db.New(pool),
+		config:  config,
 	}
 }
 
@@ -91,9 +104,18 @@ func (s *AdminServer) AddOrganizationMember(ctx context.Context, req *censysv1.A
 		return nil, status.Errorf(codes.NotFound, "organization not found: %v", err)
 	}
 
+	role := db.OrganizationRoleMember
+	if req.Role == censysv1.OrganizationRole_ORGANIZATION_ROLE_ADMIN {
+		if err := s.requireAdminGrant(ctx, dbOrg.ID); err != nil {
+			return nil, err
+		}
+		role = db.OrganizationRoleAdmin
+	}
+
 	err = s.queries.AddOrganizationMember(ctx, db.AddOrganizationMemberParams{
 		UserID:         dbUser.ID,
 		OrganizationID: dbOrg.ID,
+		Role:           role,
 	})
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to add member: %v", err)
@@ -118,5 +140,56 @@ func (s *AdminServer) AddOrganizationMember(ctx context.Context, req *censysv1.A
 			Uid:  string(orgUIDBytes[1 : len(orgUIDBytes)-1]),
 			Name: dbOrg.Name,
 		},
+		Role: dbRoleToProto(role),
 	}, nil
 }
+
+// requireAdminGrant lets a service identity, or a user who already administers the organization, make someone
+// an admin of it. Anyone can add plain members.
+func (s *AdminServer) requireAdminGrant(ctx context.Context, organizationID int32) error {
+	if _, ok := middleware.ServiceIdentityFromContext(ctx); ok {
+		return nil
+	}
+
+	denied := status.Error(codes.PermissionDenied, "only a service identity or an organization admin can add an admin")
+	userID, err := middleware.UserIDFromContext(ctx)
+	if err != nil {
+		return denied
+	}
+	role, err := s.queries.GetOrganizationMemberRole(ctx, db.GetOrganizationMemberRoleParams{
+		UserID:         userID,
+		OrganizationID: organizationID,
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return denied
+	}
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to check organization role: %v", err)
+	}
+	if role != db.OrganizationRoleAdmin {
+		return denied
+	}
+	return nil
+}
+
+// UpdateShareToken lets an admin change a token's rate limit without being a member of the owning collection.
+func (s *AdminServer) UpdateShareToken(ctx context.Context, req *censysv1.UpdateShareTokenRequest) (*censysv1.ShareToken, error) {
+	if req.Token == "" {
+		return nil, status.Error(codes.InvalidArgument, "token is required")
+	}
+
+	shareLink, err := s.queries.GetShareLinkByToken(ctx, req.Token)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "token not found: %v", err)
+	}
+
+	// only service identities get here, they are trusted with any limit
+	return updateShareTokenOverrides(ctx, s.queries, shareLink, req, 0)
+}
+
+func dbRoleToProto(role db.OrganizationRole) censysv1.OrganizationRole {
+	if role == db.OrganizationRoleAdmin {
+		return censysv1.OrganizationRole_ORGANIZATION_ROLE_ADMIN
+	}
+	return censysv1.OrganizationRole_ORGANIZATION_ROLE_MEMBER
+}
@@ -0,0 +1,42 @@
+package server
+
+import (
+	"context"
+	"testing"
+
+	censysv1 "github.com/ajscimone/censys-challenge/gen/proto"
+	"github.com/ajscimone/censys-challenge/internal/db"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestAddOrganizationMember_AdminRoleNeedsAnAdmin(t *testing.T) {
+	env := newTestEnv(t)
+	admin, adminCtx := env.newUser(t)
+	member, memberCtx := env.newUser(t)
+	newcomer, _ := env.newUser(t)
+	other, _ := env.newUser(t)
+	org := env.newOrganization(t, admin, member)
+
+	add := func(ctx context.Context, user db.User, role censysv1.OrganizationRole) error {
+		_, err := env.admin.AddOrganizationMember(ctx, &censysv1.AddOrganizationMemberRequest{
+			UserUid:         user.Uid.String(),
+			OrganizationUid: org.Uid.String(),
+			Role:            role,
+		})
+		return err
+	}
+
+	if err := add(context.Background(), newcomer, censysv1.OrganizationRole_ORGANIZATION_ROLE_ADMIN); status.Code(err) != codes.PermissionDenied {
+		t.Fatalf("an anonymous caller must not add an admin, got %v", err)
+	}
+	if err := add(memberCtx, newcomer, censysv1.OrganizationRole_ORGANIZATION_ROLE_ADMIN); status.Code(err) != codes.PermissionDenied {
+		t.Fatalf("a plain member must not add an admin, got %v", err)
+	}
+	if err := add(context.Background(), other, censysv1.OrganizationRole_ORGANIZATION_ROLE_MEMBER); err != nil {
+		t.Fatalf("anyone can add a plain member: %v", err)
+	}
+	if err := add(adminCtx, newcomer, censysv1.OrganizationRole_ORGANIZATION_ROLE_ADMIN); err != nil {
+		t.Fatalf("an organization admin should be able to add an admin: %v", err)
+	}
+}
@@ -0,0 +1,31 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/ajscimone/censys-challenge/internal/db"
+	"github.com/jackc/pgx/v5/pgtype"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// recordAuditEvent writes an audit event with q, so inside a transaction the event is only kept if the
+// change it describes is committed.
+func recordAuditEvent(ctx context.Context, q *db.Queries, actorID int32, action string, collectionUID pgtype.UUID, details map[string]string) error {
+	detailBytes, err := json.Marshal(details)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to marshal audit details: %v", err)
+	}
+
+	err = q.CreateAuditEvent(ctx, db.CreateAuditEventParams{
+		ActorID:       pgtype.Int4{Int32: actorID, Valid: true},
+		Action:        action,
+		CollectionUid: collectionUID,
+		Details:       detailBytes,
+	})
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to record audit event: %v", err)
+	}
+	return nil
+}
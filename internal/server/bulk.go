@@ -0,0 +1,301 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+
+	"github.com/ajscimone/censys-challenge/gen/proto"
+	"github.com/ajscimone/censys-challenge/internal/db"
+	"github.com/ajscimone/censys-challenge/internal/middleware"
+	"github.com/jackc/pgx/v5/pgtype"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// maxImportLineBytes bounds how much NDJSON is buffered while waiting for a newline.
+const maxImportLineBytes = 16 << 20
+
+// maxImportResponseEntries bounds how many errors and collection uids a best effort import lists, the counts
+// keep going past it.
+const maxImportResponseEntries = 1000
+
+// A transactional import is held in memory until the stream ends, these bound how much.
+const (
+	maxTransactionalImportRecords = 10000
+	maxTransactionalImportBytes   = 64 << 20
+)
+
+func (s *CollectionServer) ImportCollections(stream grpc.ClientStreamingServer[censysv1.ImportCollectionsRequest, censysv1.ImportCollectionsResponse]) error {
+	ctx := stream.Context()
+
+	userID, err := middleware.UserIDFromContext(ctx)
+	if err != nil {
+		return status.Error(codes.Unauthenticated, "authentication required")
+	}
+
+	first, err := stream.Recv()
+	if errors.Is(err, io.EOF) {
+		return stream.SendAndClose(&censysv1.ImportCollectionsResponse{})
+	}
+	if err != nil {
+		return err
+	}
+
+	imp := &collectionImport{
+		server:        s,
+		userID:        userID,
+		transactional: first.Mode == censysv1.ImportMode_IMPORT_MODE_TRANSACTIONAL,
+		resp:          &censysv1.ImportCollectionsResponse{},
+	}
+
+	imp.q = s.queries
+	msg := first
+	for {
+		if err := imp.add(ctx, msg); err != nil {
+			return err
+		}
+
+		msg, err = stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return err
+		}
+	}
+	if err := imp.finish(ctx); err != nil {
+		return err
+	}
+
+	if imp.transactional {
+		// the whole stream has been received, so the transaction only lasts as long as the writes
+		err := withTx(ctx, s.pool, func(q *db.Queries) error {
+			imp.q = q
+			for index, req := range imp.buffered {
+				if err := imp.create(ctx, int32(index), req, nil); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return txStatus(err, "failed to import collections")
+		}
+	}
+
+	return stream.SendAndClose(imp.resp)
+}
+
+// collectionImport creates records as they arrive. In transactional mode records are buffered until the
+// stream ends and written in one transaction, the first failure is returned and rolls everything back.
+// Otherwise failures are collected in the response and the import carries on.
+type collectionImport struct {
+	server        *CollectionServer
+	q             *db.Queries
+	userID        int32
+	transactional bool
+	resp          *censysv1.ImportCollectionsResponse
+	index         int32
+	// partial NDJSON line carried over to the next message
+	pending []byte
+
+	buffered      []*censysv1.CreateCollectionRequest
+	bufferedBytes int
+}
+
+func (imp *collectionImport) add(ctx context.Context, msg *censysv1.ImportCollectionsRequest) error {
+	switch record := msg.Record.(type) {
+	case *censysv1.ImportCollectionsRequest_Collection:
+		return imp.record(ctx, record.Collection, nil)
+
+	case *censysv1.ImportCollectionsRequest_Ndjson:
+		imp.pending = append(imp.pending, record.Ndjson...)
+		for {
+			end := bytes.IndexByte(imp.pending, '\n')
+			if end < 0 {
+				break
+			}
+			line := imp.pending[:end]
+			imp.pending = imp.pending[end+1:]
+			if err := imp.createFromJSON(ctx, line); err != nil {
+				return err
+			}
+		}
+		if len(imp.pending) > maxImportLineBytes {
+			return status.Errorf(codes.InvalidArgument, "record %d: NDJSON line longer than %d bytes", imp.index, maxImportLineBytes)
+		}
+	}
+
+	return nil
+}
+
+// finish takes a last NDJSON line that was not terminated by a newline.
+func (imp *collectionImport) finish(ctx context.Context) error {
+	line := imp.pending
+	imp.pending = nil
+	return imp.createFromJSON(ctx, line)
+}
+
+func (imp *collectionImport) createFromJSON(ctx context.Context, line []byte) error {
+	line = bytes.TrimSpace(line)
+	if len(line) == 0 {
+		return nil
+	}
+
+	req := &censysv1.CreateCollectionRequest{}
+	if err := protojson.Unmarshal(line, req); err != nil {
+		return imp.record(ctx, nil, status.Errorf(codes.InvalidArgument, "invalid NDJSON record: %v", err))
+	}
+	return imp.record(ctx, req, nil)
+}
+
+// record takes one received record, or parseErr as its failure. Transactional imports buffer it for later,
+// otherwise it is created right away.
+func (imp *collectionImport) record(ctx context.Context, req *censysv1.CreateCollectionRequest, parseErr error) error {
+	index := imp.index
+	imp.index++
+
+	if !imp.transactional {
+		return imp.create(ctx, index, req, parseErr)
+	}
+
+	if parseErr != nil {
+		st := status.Convert(parseErr)
+		return status.Errorf(st.Code(), "record %d: %s", index, st.Message())
+	}
+	imp.buffered = append(imp.buffered, req)
+	imp.bufferedBytes += proto.Size(req)
+	if len(imp.buffered) > maxTransactionalImportRecords {
+		return status.Errorf(codes.InvalidArgument, "a transactional import can have at most %d records", maxTransactionalImportRecords)
+	}
+	if imp.bufferedBytes > maxTransactionalImportBytes {
+		return status.Errorf(codes.InvalidArgument, "a transactional import can be at most %d bytes", maxTransactionalImportBytes)
+	}
+	return nil
+}
+
+// create imports one record, or records parseErr as its failure.
+func (imp *collectionImport) create(ctx context.Context, index int32, req *censysv1.CreateCollectionRequest, parseErr error) error {
+	err := parseErr
+	var created db.Collection
+	if err == nil {
+		created, err = imp.server.createCollection(ctx, imp.q, imp.userID, req)
+	}
+
+	if err != nil {
+		st := status.Convert(err)
+		if imp.transactional {
+			return status.Errorf(st.Code(), "record %d: %s", index, st.Message())
+		}
+		imp.resp.Failed++
+		if len(imp.resp.Errors) < maxImportResponseEntries {
+			imp.resp.Errors = append(imp.resp.Errors, &censysv1.ImportError{
+				Index:   index,
+				Code:    int32(st.Code()),
+				Message: st.Message(),
+			})
+		}
+		return nil
+	}
+
+	imp.resp.Imported++
+	if len(imp.resp.CollectionUids) < maxImportResponseEntries {
+		imp.resp.CollectionUids = append(imp.resp.CollectionUids, created.Uid.String())
+	}
+	return nil
+}
+
+func (s *CollectionServer) ExportCollections(req *censysv1.ExportCollectionsRequest, stream grpc.ServerStreamingServer[censysv1.ExportCollectionsResponse]) error {
+	ctx := stream.Context()
+
+	userID, err := middleware.UserIDFromContext(ctx)
+	if err != nil {
+		return status.Error(codes.Unauthenticated, "authentication required")
+	}
+
+	labelFilter, err := parseLabelSelector(req.LabelSelector)
+	if err != nil {
+		return err
+	}
+
+	ndjson := req.Format == censysv1.ExportFormat_EXPORT_FORMAT_NDJSON
+	orgUIDs := map[int32]string{}
+
+	var before pgtype.Int4
+	for {
+		page, err := s.queries.SearchCollectionsForUser(ctx, db.SearchCollectionsForUserParams{
+			UserID:         pgtype.Int4{Int32: userID, Valid: true},
+			LabelEquals:    labelFilter.equals,
+			LabelNotEquals: labelFilter.notEquals,
+			LabelExists:    labelFilter.exists,
+			LabelMissing:   labelFilter.missing,
+			BeforeID:       before,
+			PageSize:       maxPageSize,
+		})
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to export collections: %v", err)
+		}
+
+		for _, c := range page {
+			protoCollection, err := dbCollectionToProto(c)
+			if err != nil {
+				return err
+			}
+
+			resp := &censysv1.ExportCollectionsResponse{}
+			if ndjson {
+				line, err := s.exportLine(ctx, c, protoCollection, orgUIDs)
+				if err != nil {
+					return err
+				}
+				resp.Record = &censysv1.ExportCollectionsResponse_Ndjson{Ndjson: line}
+			} else {
+				resp.Record = &censysv1.ExportCollectionsResponse_Collection{Collection: protoCollection}
+			}
+
+			if err := stream.Send(resp); err != nil {
+				return err
+			}
+		}
+
+		if len(page) < maxPageSize {
+			return nil
+		}
+		before = pgtype.Int4{Int32: page[len(page)-1].ID, Valid: true}
+	}
+}
+
+// exportLine renders a collection as the CreateCollectionRequest JSON that ImportCollections reads.
+// orgUIDs caches organization uids across the export.
+func (s *CollectionServer) exportLine(ctx context.Context, c db.Collection, protoCollection *censysv1.Collection, orgUIDs map[int32]string) ([]byte, error) {
+	record := &censysv1.CreateCollectionRequest{
+		Name:        protoCollection.Name,
+		Data:        protoCollection.Data,
+		AccessLevel: protoCollection.AccessLevel,
+		Labels:      protoCollection.Labels,
+	}
+
+	if c.OrganizationID.Valid {
+		orgUID, ok := orgUIDs[c.OrganizationID.Int32]
+		if !ok {
+			org, err := s.queries.GetOrganizationByID(ctx, c.OrganizationID.Int32)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to load organization: %v", err)
+			}
+			orgUID = org.Uid.String()
+			orgUIDs[c.OrganizationID.Int32] = orgUID
+		}
+		record.OrganizationUid = orgUID
+	}
+
+	line, err := protojson.Marshal(record)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to marshal collection: %v", err)
+	}
+	return append(line, '\n'), nil
+}
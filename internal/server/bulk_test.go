@@ -0,0 +1,83 @@
+package server
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	censysv1 "github.com/ajscimone/censys-challenge/gen/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestCollectionImport_SplitsNDJSON(t *testing.T) {
+	tests := []struct {
+		name      string
+		chunks    []string
+		wantNames []string
+		wantCode  codes.Code
+	}{
+		{
+			name:      "one line per message",
+			chunks:    []string{`{"name":"a"}` + "\n", `{"name":"b"}` + "\n"},
+			wantNames: []string{"a", "b"},
+		},
+		{
+			name:      "several lines in one message",
+			chunks:    []string{`{"name":"a"}` + "\n" + `{"name":"b"}` + "\n"},
+			wantNames: []string{"a", "b"},
+		},
+		{
+			name:      "line split across messages",
+			chunks:    []string{`{"na`, `me":"a"}` + "\n" + `{"name":`, `"b"}` + "\n"},
+			wantNames: []string{"a", "b"},
+		},
+		{
+			name:      "last line without a newline",
+			chunks:    []string{`{"name":"a"}` + "\n" + `{"name":"b"}`},
+			wantNames: []string{"a", "b"},
+		},
+		{
+			name:      "blank lines and carriage returns",
+			chunks:    []string{"\n" + `{"name":"a"}` + "\r\n  \n" + `{"name":"b"}` + "\r\n"},
+			wantNames: []string{"a", "b"},
+		},
+		{
+			name:     "invalid line",
+			chunks:   []string{`{"name":"a"}` + "\n" + `{"name":` + "\n"},
+			wantCode: codes.InvalidArgument,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// transactional imports only buffer records until the stream ends, nothing reaches the database
+			imp := &collectionImport{transactional: true, resp: &censysv1.ImportCollectionsResponse{}}
+
+			var err error
+			for _, chunk := range tt.chunks {
+				msg := &censysv1.ImportCollectionsRequest{Record: &censysv1.ImportCollectionsRequest_Ndjson{Ndjson: []byte(chunk)}}
+				if err = imp.add(context.Background(), msg); err != nil {
+					break
+				}
+			}
+			if err == nil {
+				err = imp.finish(context.Background())
+			}
+
+			if status.Code(err) != tt.wantCode {
+				t.Fatalf("expected %v, got %v", tt.wantCode, err)
+			}
+			if tt.wantCode != codes.OK {
+				return
+			}
+			var names []string
+			for _, req := range imp.buffered {
+				names = append(names, req.Name)
+			}
+			if !slices.Equal(names, tt.wantNames) {
+				t.Fatalf("expected records %v, got %v", tt.wantNames, names)
+			}
+		})
+	}
+}
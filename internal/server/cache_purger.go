@@ -0,0 +1,74 @@
+package server
+
+import (
+	"context"
+	"log"
+
+	"github.com/ajscimone/censys-challenge/internal/httpcache"
+)
+
+// maxPurgeKeys is how many surrogate keys are sent in one purge request.
+const maxPurgeKeys = 128
+
+// RunCachePurger purges cached /s/{token} responses as soon as their collection changes or their token
+// is revoked, suspended or given a new max-age, until ctx is cancelled. Every replica sees every change
+// so each purges, purges are idempotent.
+func (s *CollectionServer) RunCachePurger(ctx context.Context, purger *httpcache.Purger) {
+	for {
+		changes := s.changes.subscribe()
+		purgeChanges(ctx, changes, purger)
+		s.changes.unsubscribe(changes)
+		if ctx.Err() != nil {
+			return
+		}
+		log.Printf("Cache purger missed changes, cached share links may be stale until their max-age runs out")
+	}
+}
+
+// purgeChanges purges until the feed drops the subscription. Changes that arrive while a purge is in
+// flight are sent together in the next one.
+func purgeChanges(ctx context.Context, changes chan collectionChange, purger *httpcache.Purger) {
+	for {
+		change, err := nextChange(ctx, changes)
+		if err != nil {
+			return
+		}
+
+		keys := map[string]struct{}{}
+		addPurgeKey(keys, change)
+	drain:
+		for len(keys) < maxPurgeKeys {
+			select {
+			case change, ok := <-changes:
+				if !ok {
+					break drain
+				}
+				addPurgeKey(keys, change)
+			default:
+				break drain
+			}
+		}
+		if len(keys) == 0 {
+			continue
+		}
+
+		batch := make([]string, 0, len(keys))
+		for key := range keys {
+			batch = append(batch, key)
+		}
+		if err := purger.Purge(ctx, batch); err != nil && ctx.Err() == nil {
+			log.Printf("Failed to purge %d cache keys: %v", len(batch), err)
+		}
+	}
+}
+
+func addPurgeKey(keys map[string]struct{}, change collectionChange) {
+	switch change.Kind {
+	case changeKindCollection:
+		keys[httpcache.CollectionKey(change.CollectionUID)] = struct{}{}
+	case changeKindShareLink:
+		if change.TokenHash != "" {
+			keys[httpcache.ShareLinkKey(change.TokenHash)] = struct{}{}
+		}
+	}
+}
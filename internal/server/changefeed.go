@@ -0,0 +1,246 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/ajscimone/censys-challenge/internal/db"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// changeChannel is the Postgres NOTIFY channel the collection and membership triggers publish on.
+const changeChannel = "collection_changes"
+
+// changeBuffer is how many changes a watcher can fall behind before it is disconnected.
+const changeBuffer = 256
+
+const (
+	changeKindCollection = "collection"
+	changeKindMembership = "membership"
+	changeKindShareLink  = "share_link"
+)
+
+// collectionChange is the JSON payload of a notification. Collection changes carry the row before and
+// after the change so watchers can tell whether it was visible to them, membership and share link changes
+// only the ids.
+type collectionChange struct {
+	Kind              string         `json:"kind"`
+	Op                string         `json:"op"`
+	CollectionID      int32          `json:"collection_id"`
+	CollectionUID     string         `json:"collection_uid"`
+	OwnerID           pgtype.Int4    `json:"owner_id"`
+	OrganizationID    pgtype.Int4    `json:"organization_id"`
+	AccessLevel       db.AccessLevel `json:"access_level"`
+	OldOwnerID        pgtype.Int4    `json:"old_owner_id"`
+	OldOrganizationID pgtype.Int4    `json:"old_organization_id"`
+	OldAccessLevel    db.AccessLevel `json:"old_access_level"`
+	UserID            int32          `json:"user_id"`
+	ShareLinkID       int32          `json:"share_link_id"`
+	TokenHash         string         `json:"token_hash"`
+}
+
+// changeFilter picks the changes a narrowed subscriber receives, zero fields match nothing.
+type changeFilter struct {
+	// collectionID matches changes to the collection and to its share links.
+	collectionID int32
+	// organizationID matches changes to collections in the organization or moving in or out of it.
+	organizationID int32
+	// userID matches the user's membership changes.
+	userID int32
+}
+
+type changeSubscribers map[chan collectionChange]struct{}
+
+// changeFeed fans notifications from a single LISTEN connection out to the watchers on this replica they
+// concern. Watchers are indexed by what they watch so a change is only handed to the ones it can matter to.
+type changeFeed struct {
+	pool *pgxpool.Pool
+
+	mu          sync.Mutex
+	subscribers map[chan collectionChange]changeFilter
+	// unfiltered subscribers receive every change
+	unfiltered    changeSubscribers
+	collections   map[int32]changeSubscribers
+	organizations map[int32]changeSubscribers
+	users         map[int32]changeSubscribers
+}
+
+func newChangeFeed(pool *pgxpool.Pool) *changeFeed {
+	return &changeFeed{
+		pool:          pool,
+		subscribers:   make(map[chan collectionChange]changeFilter),
+		unfiltered:    make(changeSubscribers),
+		collections:   make(map[int32]changeSubscribers),
+		organizations: make(map[int32]changeSubscribers),
+		users:         make(map[int32]changeSubscribers),
+	}
+}
+
+// subscribe registers a watcher that receives every change until it is narrowed. The channel is closed if
+// the watcher falls behind or the feed loses its connection, either way changes may have been missed and
+// the watcher should end its stream.
+func (f *changeFeed) subscribe() chan collectionChange {
+	ch := make(chan collectionChange, changeBuffer)
+	f.mu.Lock()
+	f.subscribers[ch] = changeFilter{}
+	f.unfiltered[ch] = struct{}{}
+	f.mu.Unlock()
+	return ch
+}
+
+// narrow limits ch to the changes filter matches. Watchers subscribe before looking up what they watch and
+// narrow once they know, so no change in between is missed.
+func (f *changeFeed) narrow(ch chan collectionChange, filter changeFilter) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if _, ok := f.subscribers[ch]; !ok {
+		return
+	}
+
+	delete(f.unfiltered, ch)
+	f.subscribers[ch] = filter
+	indexSubscriber(f.collections, filter.collectionID, ch)
+	indexSubscriber(f.organizations, filter.organizationID, ch)
+	indexSubscriber(f.users, filter.userID, ch)
+}
+
+func (f *changeFeed) unsubscribe(ch chan collectionChange) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.remove(ch)
+}
+
+// remove drops a subscriber and closes its channel. f.mu must be held.
+func (f *changeFeed) remove(ch chan collectionChange) {
+	filter, ok := f.subscribers[ch]
+	if !ok {
+		return
+	}
+
+	delete(f.subscribers, ch)
+	delete(f.unfiltered, ch)
+	unindexSubscriber(f.collections, filter.collectionID, ch)
+	unindexSubscriber(f.organizations, filter.organizationID, ch)
+	unindexSubscriber(f.users, filter.userID, ch)
+	close(ch)
+}
+
+func (f *changeFeed) publish(change collectionChange) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	for ch := range f.recipients(change) {
+		select {
+		case ch <- change:
+		default:
+			f.remove(ch)
+		}
+	}
+}
+
+// recipients are the subscribers change concerns. f.mu must be held.
+func (f *changeFeed) recipients(change collectionChange) changeSubscribers {
+	recipients := make(changeSubscribers, len(f.unfiltered))
+	add := func(subscribers changeSubscribers) {
+		for ch := range subscribers {
+			recipients[ch] = struct{}{}
+		}
+	}
+
+	add(f.unfiltered)
+	switch change.Kind {
+	case changeKindCollection:
+		add(f.collections[change.CollectionID])
+		if change.OrganizationID.Valid {
+			add(f.organizations[change.OrganizationID.Int32])
+		}
+		if change.OldOrganizationID.Valid {
+			add(f.organizations[change.OldOrganizationID.Int32])
+		}
+	case changeKindShareLink:
+		add(f.collections[change.CollectionID])
+	case changeKindMembership:
+		add(f.users[change.UserID])
+	}
+	return recipients
+}
+
+func (f *changeFeed) disconnectAll() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	for ch := range f.subscribers {
+		f.remove(ch)
+	}
+}
+
+func indexSubscriber(index map[int32]changeSubscribers, id int32, ch chan collectionChange) {
+	if id == 0 {
+		return
+	}
+	if index[id] == nil {
+		index[id] = make(changeSubscribers)
+	}
+	index[id][ch] = struct{}{}
+}
+
+func unindexSubscriber(index map[int32]changeSubscribers, id int32, ch chan collectionChange) {
+	delete(index[id], ch)
+	if len(index[id]) == 0 {
+		delete(index, id)
+	}
+}
+
+// run listens for notifications until ctx is cancelled, reconnecting after a second if the connection drops.
+func (f *changeFeed) run(ctx context.Context) {
+	for {
+		err := f.listen(ctx)
+		f.disconnectAll()
+		if ctx.Err() != nil {
+			return
+		}
+		log.Printf("Collection change feed disconnected, reconnecting: %v", err)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(time.Second):
+		}
+	}
+}
+
+func (f *changeFeed) listen(ctx context.Context) error {
+	pooled, err := f.pool.Acquire(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to acquire connection: %w", err)
+	}
+	// the connection stays subscribed to the channel so it is taken out of the pool for good
+	conn := pooled.Hijack()
+	defer conn.Close(context.Background())
+
+	if _, err := conn.Exec(ctx, "LISTEN "+changeChannel); err != nil {
+		return fmt.Errorf("failed to listen: %w", err)
+	}
+
+	for {
+		notification, err := conn.WaitForNotification(ctx)
+		if err != nil {
+			return err
+		}
+
+		var change collectionChange
+		if err := json.Unmarshal([]byte(notification.Payload), &change); err != nil {
+			log.Printf("Ignoring malformed collection change %q: %v", notification.Payload, err)
+			continue
+		}
+		f.publish(change)
+	}
+}
+
+// RunChangeFeed delivers collection changes to Watch streams on this server until ctx is cancelled.
+func (s *CollectionServer) RunChangeFeed(ctx context.Context) {
+	s.changes.run(ctx)
+}
@@ -0,0 +1,88 @@
+package server
+
+import (
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+func TestChangeFeed_DeliversOnlyMatchingChanges(t *testing.T) {
+	org := func(id int32) pgtype.Int4 { return pgtype.Int4{Int32: id, Valid: true} }
+
+	tests := []struct {
+		name   string
+		filter changeFilter
+		change collectionChange
+		want   bool
+	}{
+		{
+			name:   "collection change to the watched collection",
+			filter: changeFilter{collectionID: 1},
+			change: collectionChange{Kind: changeKindCollection, CollectionID: 1},
+			want:   true,
+		},
+		{
+			name:   "collection change to another collection",
+			filter: changeFilter{collectionID: 1},
+			change: collectionChange{Kind: changeKindCollection, CollectionID: 2, OrganizationID: org(1)},
+		},
+		{
+			name:   "share link of the watched collection",
+			filter: changeFilter{collectionID: 1},
+			change: collectionChange{Kind: changeKindShareLink, CollectionID: 1, ShareLinkID: 7},
+			want:   true,
+		},
+		{
+			name:   "collection moving into the watched organization",
+			filter: changeFilter{organizationID: 3},
+			change: collectionChange{Kind: changeKindCollection, CollectionID: 2, OrganizationID: org(3)},
+			want:   true,
+		},
+		{
+			name:   "collection moving out of the watched organization",
+			filter: changeFilter{organizationID: 3},
+			change: collectionChange{Kind: changeKindCollection, CollectionID: 2, OrganizationID: org(4), OldOrganizationID: org(3)},
+			want:   true,
+		},
+		{
+			name:   "collection in another organization",
+			filter: changeFilter{organizationID: 3},
+			change: collectionChange{Kind: changeKindCollection, CollectionID: 2, OrganizationID: org(4)},
+		},
+		{
+			name:   "membership change of the watching user",
+			filter: changeFilter{organizationID: 3, userID: 5},
+			change: collectionChange{Kind: changeKindMembership, UserID: 5, OrganizationID: org(3)},
+			want:   true,
+		},
+		{
+			name:   "membership change of another user in the organization",
+			filter: changeFilter{organizationID: 3, userID: 5},
+			change: collectionChange{Kind: changeKindMembership, UserID: 6, OrganizationID: org(3)},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			feed := newChangeFeed(nil)
+			ch := feed.subscribe()
+			feed.narrow(ch, tt.filter)
+			everything := feed.subscribe()
+
+			feed.publish(tt.change)
+
+			if got := len(ch) == 1; got != tt.want {
+				t.Fatalf("expected delivery %v, got %v", tt.want, got)
+			}
+			if len(everything) != 1 {
+				t.Fatal("a subscriber that was not narrowed should receive every change")
+			}
+
+			feed.unsubscribe(ch)
+			feed.unsubscribe(everything)
+			if len(feed.collections) != 0 || len(feed.organizations) != 0 || len(feed.users) != 0 || len(feed.unfiltered) != 0 {
+				t.Fatal("unsubscribing should leave the indexes empty")
+			}
+		})
+	}
+}
@@ -6,13 +6,18 @@ import (
 	"encoding/hex"
 	"encoding/json"
 	"fmt"
+	"strconv"
 	"strings"
+	"sync/atomic"
+	"time"
 
 	"github.com/ajscimone/censys-challenge/gen/proto"
 	"github.com/ajscimone/censys-challenge/internal/authentication"
 	"github.com/ajscimone/censys-challenge/internal/db"
+	"github.com/ajscimone/censys-challenge/internal/httpcache"
 	"github.com/ajscimone/censys-challenge/internal/middleware"
 	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
@@ -20,51 +25,71 @@ import (
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
+type CollectionServerConfig struct {
+	// VersionRetention is how many previous versions are kept for collections without their own limit.
+	VersionRetention int32
+	Quotas           QuotaConfig
+	// MaxRateLimitOverride is the highest rate_limit owners can set on their share tokens, zero means no cap.
+	MaxRateLimitOverride int32
+}
+
 type CollectionServer struct {
 	censysv1.UnimplementedCollectionServiceServer
+	pool    *pgxpool.Pool
 	queries *db.Queries
 	auth    *authentication.Authenticator
+	config  CollectionServerConfig
+	changes *changeFeed
+
+	maxRateLimitOverride atomic.Int32
 }
 
-func NewCollectionServer(queries *db.Queries, auth *authentication.Authenticator) *CollectionServer {
-	return &CollectionServer{
-		queries: queries,
+func NewCollectionServer(pool *pgxpool.Pool, auth *authentication.Authenticator, config CollectionServerConfig) *CollectionServer {
+	s := &CollectionServer{
+		pool:    pool,
+		queries: db.New(pool),
 		auth:    auth,
+		config:  config,
+		changes: newChangeFeed(pool),
 	}
+	s.maxRateLimitOverride.Store(config.MaxRateLimitOverride)
+	return s
 }
 
-func (s *CollectionServer) CreateCollection(ctx context.Context, req *censysv1.CreateCollectionRequest) (*censysv1.Collection, error) {
-	if req.Name == "" {
-		return nil, status.Error(codes.InvalidArgument, "name is required")
-	}
+// SetMaxRateLimitOverride changes the cap on owner set rate limits, tokens already above it keep their limit.
+func (s *CollectionServer) SetMaxRateLimitOverride(limit int32) {
+	s.maxRateLimitOverride.Store(limit)
+}
 
+func (s *CollectionServer) CreateCollection(ctx context.Context, req *censysv1.CreateCollectionRequest) (*censysv1.Collection, error) {
 	userID, err := middleware.UserIDFromContext(ctx)
 	if err != nil {
 		return nil, status.Error(codes.Unauthenticated, "authentication required")
 	}
 
+	dbCollection, err := s.createCollection(ctx, s.queries, userID, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return dbCollectionToProto(dbCollection)
+}
+
+// createCollection validates req and inserts it with q, so imports can run it inside their transaction.
+func (s *CollectionServer) createCollection(ctx context.Context, q *db.Queries, userID int32, req *censysv1.CreateCollectionRequest) (db.Collection, error) {
+	if req.Name == "" {
+		return db.Collection{}, status.Error(codes.InvalidArgument, "name is required")
+	}
+
 	var orgID pgtype.Int4
 	if req.AccessLevel == censysv1.AccessLevel_ACCESS_LEVEL_ORGANIZATION {
 		if req.OrganizationUid == "" {
-			return nil, status.Error(codes.InvalidArgument, "organization_uid required for organization-level access")
-		}
-
-		var orgUUID pgtype.UUID
-		if err := orgUUID.Scan(req.OrganizationUid); err != nil {
-			return nil, status.Errorf(codes.InvalidArgument, "invalid organization_uid: %v", err)
+			return db.Collection{}, status.Error(codes.InvalidArgument, "organization_uid required for organization-level access")
 		}
 
-		org, err := s.queries.GetOrganizationByUID(ctx, orgUUID)
+		org, err := s.memberOrganization(ctx, userID, req.OrganizationUid)
 		if err != nil {
-			return nil, status.Errorf(codes.NotFound, "organization not found: %v", err)
-		}
-
-		_, err = s.queries.IsUserInOrganization(ctx, db.IsUserInOrganizationParams{
-			UserID:         userID,
-			OrganizationID: org.ID,
-		})
-		if err != nil {
-			return nil, status.Error(codes.PermissionDenied, "user not in organization")
+			return db.Collection{}, err
 		}
 
 		orgID = pgtype.Int4{Int32: org.ID, Valid: true}
@@ -72,21 +97,44 @@ func (s *CollectionServer) CreateCollection(ctx context.Context, req *censysv1.C
 
 	dataBytes, err := req.Data.MarshalJSON()
 	if err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, "invalid data: %v", err)
+		return db.Collection{}, status.Errorf(codes.InvalidArgument, "invalid data: %v", err)
 	}
 
-	dbCollection, err := s.queries.CreateCollection(ctx, db.CreateCollectionParams{
+	size, err := dataSize(ctx, q, dataBytes)
+	if err != nil {
+		return db.Collection{}, err
+	}
+	if err := s.checkDataSize(size); err != nil {
+		return db.Collection{}, err
+	}
+
+	labelBytes, err := labelsToJSON(req.Labels)
+	if err != nil {
+		return db.Collection{}, err
+	}
+
+	if err := validateData(ctx, q, orgID, dataBytes); err != nil {
+		return db.Collection{}, err
+	}
+
+	added := quotaAmounts{collections: 1, storageBytes: size}
+	if err := s.checkQuota(ctx, q, pgtype.Int4{Int32: userID, Valid: true}, added, orgID, added); err != nil {
+		return db.Collection{}, err
+	}
+
+	dbCollection, err := q.CreateCollection(ctx, db.CreateCollectionParams{
 		Name:           req.Name,
 		Data:           dataBytes,
-		AccessLevel:    db.AccessLevel(strings.ToLower(req.AccessLevel.String()[len("ACCESS_LEVEL_"):])),
+		AccessLevel:    protoAccessLevelToDB(req.AccessLevel),
 		OwnerID:        pgtype.Int4{Int32: userID, Valid: true},
 		OrganizationID: orgID,
+		Labels:         labelBytes,
 	})
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to create collection: %v", err)
+		return db.Collection{}, status.Errorf(codes.Internal, "failed to create collection: %v", err)
 	}
 
-	return dbCollectionToProto(dbCollection)
+	return dbCollection, nil
 }
 
 func (s *CollectionServer) GetCollection(ctx context.Context, req *censysv1.GetCollectionRequest) (*censysv1.Collection, error) {
@@ -140,6 +188,42 @@ func (s *CollectionServer) UpdateCollection(ctx context.Context, req *censysv1.U
 		return nil, status.Error(codes.PermissionDenied, "access denied")
 	}
 
+	var updated db.Collection
+	err = withTx(ctx, s.pool, func(q *db.Queries) error {
+		// re-read under a row lock so unchanged fields come from the row that is actually replaced
+		locked, err := q.GetCollectionByIDForUpdate(ctx, dbCollection.ID)
+		if err != nil {
+			return status.Errorf(codes.NotFound, "collection not found: %v", err)
+		}
+
+		expectedRevision, err := checkEtag(req.Etag, locked)
+		if err != nil {
+			return err
+		}
+
+		var params db.UpdateCollectionParams
+		if req.UpdateMask != nil {
+			params, err = s.maskedUpdateParams(ctx, userID, locked, req)
+		} else {
+			params, err = s.legacyUpdateParams(ctx, userID, locked, req)
+		}
+		if err != nil {
+			return err
+		}
+		params.ExpectedRevision = expectedRevision
+
+		updated, err = s.writeCollection(ctx, q, locked, userID, params)
+		return err
+	})
+	if err != nil {
+		return nil, txStatus(err, "failed to update collection")
+	}
+
+	return dbCollectionToProto(updated)
+}
+
+// legacyUpdateParams keeps the pre update_mask behaviour where empty fields mean "leave unchanged".
+func (s *CollectionServer) legacyUpdateParams(ctx context.Context, userID int32, dbCollection db.Collection, req *censysv1.UpdateCollectionRequest) (db.UpdateCollectionParams, error) {
 	name := dbCollection.Name
 	if req.Name != "" {
 		name = req.Name
@@ -150,28 +234,37 @@ func (s *CollectionServer) UpdateCollection(ctx context.Context, req *censysv1.U
 		var err error
 		dataBytes, err = req.Data.MarshalJSON()
 		if err != nil {
-			return nil, status.Errorf(codes.InvalidArgument, "invalid data: %v", err)
+			return db.UpdateCollectionParams{}, status.Errorf(codes.InvalidArgument, "invalid data: %v", err)
+		}
+	}
+
+	versionRetention := dbCollection.VersionRetention
+	if req.VersionRetention > 0 {
+		versionRetention = pgtype.Int4{Int32: req.VersionRetention, Valid: true}
+	}
+
+	labelBytes := dbCollection.Labels
+	if len(req.Labels) > 0 {
+		var err error
+		labelBytes, err = labelsToJSON(req.Labels)
+		if err != nil {
+			return db.UpdateCollectionParams{}, err
 		}
 	}
 
 	accessLevel := dbCollection.AccessLevel
 	orgID := dbCollection.OrganizationID
 	if req.AccessLevel != censysv1.AccessLevel_ACCESS_LEVEL_UNSPECIFIED {
-		accessLevel = db.AccessLevel(strings.ToLower(req.AccessLevel.String()[len("ACCESS_LEVEL_"):]))
+		accessLevel = protoAccessLevelToDB(req.AccessLevel)
 
 		if req.AccessLevel == censysv1.AccessLevel_ACCESS_LEVEL_ORGANIZATION {
 			if req.OrganizationUid == "" {
-				return nil, status.Error(codes.InvalidArgument, "organization_uid required for organization-level access")
+				return db.UpdateCollectionParams{}, status.Error(codes.InvalidArgument, "organization_uid required for organization-level access")
 			}
 
-			var orgUUID pgtype.UUID
-			if err := orgUUID.Scan(req.OrganizationUid); err != nil {
-				return nil, status.Errorf(codes.InvalidArgument, "invalid organization_uid: %v", err)
-			}
-
-			org, err := s.queries.GetOrganizationByUID(ctx, orgUUID)
+			org, err := s.memberOrganization(ctx, userID, req.OrganizationUid)
 			if err != nil {
-				return nil, status.Errorf(codes.NotFound, "organization not found: %v", err)
+				return db.UpdateCollectionParams{}, err
 			}
 
 			orgID = pgtype.Int4{Int32: org.ID, Valid: true}
@@ -180,18 +273,15 @@ func (s *CollectionServer) UpdateCollection(ctx context.Context, req *censysv1.U
 		}
 	}
 
-	updated, err := s.queries.UpdateCollection(ctx, db.UpdateCollectionParams{
-		ID:             dbCollection.ID,
-		Name:           name,
-		Data:           dataBytes,
-		AccessLevel:    accessLevel,
-		OrganizationID: orgID,
-	})
-	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to update collection: %v", err)
-	}
-
-	return dbCollectionToProto(updated)
+	return db.UpdateCollectionParams{
+		ID:               dbCollection.ID,
+		Name:             name,
+		Data:             dataBytes,
+		AccessLevel:      accessLevel,
+		OrganizationID:   orgID,
+		VersionRetention: versionRetention,
+		Labels:           labelBytes,
+	}, nil
 }
 
 func (s *CollectionServer) DeleteCollection(ctx context.Context, req *censysv1.DeleteCollectionRequest) (*emptypb.Empty, error) {
@@ -218,9 +308,22 @@ func (s *CollectionServer) DeleteCollection(ctx context.Context, req *censysv1.D
 		return nil, status.Error(codes.PermissionDenied, "access denied")
 	}
 
-	if err := s.queries.DeleteCollection(ctx, dbCollection.ID); err != nil {
+	expectedRevision, err := checkEtag(req.Etag, dbCollection)
+	if err != nil {
+		return nil, err
+	}
+
+	// soft delete, the collection and its share links stop resolving straight away and the row is purged later
+	deleted, err := s.queries.SoftDeleteCollection(ctx, db.SoftDeleteCollectionParams{
+		ID:               dbCollection.ID,
+		ExpectedRevision: expectedRevision,
+	})
+	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to delete collection: %v", err)
 	}
+	if deleted == 0 && expectedRevision.Valid {
+		return nil, status.Error(codes.Aborted, "collection was modified, etag does not match")
+	}
 
 	return &emptypb.Empty{}, nil
 }
@@ -241,6 +344,31 @@ func checkAccess(ctx context.Context, queries *db.Queries, collectionID, userID
 	return err == nil
 }
 
+func formatEtag(revision int64) string {
+	return strconv.FormatInt(revision, 10)
+}
+
+// checkEtag parses an etag sent by the client. An empty etag means the write is unconditional.
+// The stale etag check here is only a fast path, the write queries enforce it again in their WHERE clause.
+func checkEtag(etag string, c db.Collection) (pgtype.Int8, error) {
+	if etag == "" {
+		return pgtype.Int8{}, nil
+	}
+
+	// accept HTTP style etags as well
+	trimmed := strings.Trim(strings.TrimPrefix(etag, "W/"), `"`)
+	revision, err := strconv.ParseInt(trimmed, 10, 64)
+	if err != nil {
+		return pgtype.Int8{}, status.Errorf(codes.InvalidArgument, "invalid etag %q", etag)
+	}
+
+	if revision != c.Revision {
+		return pgtype.Int8{}, status.Error(codes.Aborted, "collection was modified, etag does not match")
+	}
+
+	return pgtype.Int8{Int64: revision, Valid: true}, nil
+}
+
 func dbCollectionToProto(c db.Collection) (*censysv1.Collection, error) {
 	uidBytes, err := c.Uid.MarshalJSON()
 	if err != nil {
@@ -274,15 +402,37 @@ func dbCollectionToProto(c db.Collection) (*censysv1.Collection, error) {
 		orgID = fmt.Sprintf("%d", c.OrganizationID.Int32)
 	}
 
+	var deletedAt *timestamppb.Timestamp
+	if c.DeletedAt.Valid {
+		deletedAt = timestamppb.New(c.DeletedAt.Time)
+	}
+
+	var quarantinedAt *timestamppb.Timestamp
+	if c.QuarantinedAt.Valid {
+		quarantinedAt = timestamppb.New(c.QuarantinedAt.Time)
+	}
+
+	labelMap, err := labelsFromJSON(c.Labels)
+	if err != nil {
+		return nil, err
+	}
+
 	return &censysv1.Collection{
-		Uid:            string(uidBytes[1 : len(uidBytes)-1]),
-		Name:           c.Name,
-		Data:           &dataStruct,
-		AccessLevel:    accessLevel,
-		OwnerId:        ownerID,
-		OrganizationId: orgID,
-		CreatedAt:      timestamppb.New(c.CreatedAt.Time),
-		UpdatedAt:      timestamppb.New(c.UpdatedAt.Time),
+		Uid:                 string(uidBytes[1 : len(uidBytes)-1]),
+		Name:                c.Name,
+		Data:                &dataStruct,
+		AccessLevel:         accessLevel,
+		OwnerId:             ownerID,
+		OrganizationId:      orgID,
+		CreatedAt:           timestamppb.New(c.CreatedAt.Time),
+		UpdatedAt:           timestamppb.New(c.UpdatedAt.Time),
+		Revision:            c.Revision,
+		Etag:                formatEtag(c.Revision),
+		VersionRetention:    c.VersionRetention.Int32,
+		DeletedAt:           deletedAt,
+		Labels:              labelMap,
+		SourceCollectionUid: c.SourceCollectionUid.String(),
+		QuarantinedAt:       quarantinedAt,
 	}, nil
 }
 
@@ -310,30 +460,227 @@ func (s *CollectionServer) CreateShareToken(ctx context.Context, req *censysv1.C
 		return nil, status.Error(codes.PermissionDenied, "access denied")
 	}
 
+	rateLimit, rateLimitWindow, err := rateLimitParams(req.RateLimit, req.RateLimitWindowSeconds, s.maxRateLimitOverride.Load())
+	if err != nil {
+		return nil, err
+	}
+	cacheMaxAge, err := cacheMaxAgeParam(req.CacheMaxAgeSeconds)
+	if err != nil {
+		return nil, err
+	}
+	if req.ExpiresInSeconds < 0 {
+		return nil, status.Error(codes.InvalidArgument, "expires_in_seconds must not be negative")
+	}
+	var expiresAt pgtype.Timestamptz
+	if req.ExpiresInSeconds > 0 {
+		expiresAt = pgtype.Timestamptz{Time: time.Now().Add(time.Duration(req.ExpiresInSeconds) * time.Second), Valid: true}
+	}
+
+	added := quotaAmounts{shareTokens: 1}
+	if err := s.checkQuota(ctx, s.queries, pgtype.Int4{Int32: userID, Valid: true}, added, dbCollection.OrganizationID, added); err != nil {
+		return nil, err
+	}
+
 	token, err := generateSecureToken()
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to generate token: %v", err)
 	}
 
 	shareLink, err := s.queries.CreateShareLink(ctx, db.CreateShareLinkParams{
-		Token:        token,
-		CollectionID: dbCollection.ID,
-		CreatedBy:    userID,
+		Token:                  token,
+		CollectionID:           dbCollection.ID,
+		CreatedBy:              userID,
+		RateLimit:              rateLimit,
+		RateLimitWindowSeconds: rateLimitWindow,
+		CacheMaxAgeSeconds:     cacheMaxAge,
+		ExpiresAt:              expiresAt,
 	})
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to create share link: %v", err)
 	}
 
-	collectionUIDBytes, err := dbCollection.Uid.MarshalJSON()
+	return dbShareLinkToProto(shareLink, dbCollection)
+}
+
+func (s *CollectionServer) UpdateShareToken(ctx context.Context, req *censysv1.UpdateShareTokenRequest) (*censysv1.ShareToken, error) {
+	if req.Token == "" {
+		return nil, status.Error(codes.InvalidArgument, "token is required")
+	}
+
+	userID, err := middleware.UserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "authentication required")
+	}
+
+	shareLink, err := s.queries.GetShareLinkByToken(ctx, req.Token)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "token not found: %v", err)
+	}
+
+	if !checkAccess(ctx, s.queries, shareLink.CollectionID, userID) {
+		return nil, status.Error(codes.PermissionDenied, "access denied")
+	}
+
+	return updateShareTokenOverrides(ctx, s.queries, shareLink, req, s.maxRateLimitOverride.Load())
+}
+
+func (s *CollectionServer) SuspendShareToken(ctx context.Context, req *censysv1.SuspendShareTokenRequest) (*censysv1.ShareToken, error) {
+	if req.Token == "" {
+		return nil, status.Error(codes.InvalidArgument, "token is required")
+	}
+
+	userID, err := middleware.UserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "authentication required")
+	}
+
+	shareLink, err := s.queries.GetShareLinkByToken(ctx, req.Token)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "token not found: %v", err)
+	}
+
+	if !checkAccess(ctx, s.queries, shareLink.CollectionID, userID) {
+		return nil, status.Error(codes.PermissionDenied, "access denied")
+	}
+
+	reason := req.Reason
+	if reason == "" {
+		reason = "suspended by owner"
+	}
+
+	suspended, err := s.queries.SuspendShareLink(ctx, db.SuspendShareLinkParams{
+		Token:           req.Token,
+		SuspendedReason: pgtype.Text{String: reason, Valid: true},
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to suspend token: %v", err)
+	}
+
+	dbCollection, err := s.queries.GetCollectionByID(ctx, suspended.CollectionID)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "collection not found: %v", err)
+	}
+
+	return dbShareLinkToProto(suspended, dbCollection)
+}
+
+func (s *CollectionServer) ResumeShareToken(ctx context.Context, req *censysv1.ResumeShareTokenRequest) (*censysv1.ShareToken, error) {
+	if req.Token == "" {
+		return nil, status.Error(codes.InvalidArgument, "token is required")
+	}
+
+	userID, err := middleware.UserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "authentication required")
+	}
+
+	shareLink, err := s.queries.GetShareLinkByToken(ctx, req.Token)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "token not found: %v", err)
+	}
+
+	if !checkAccess(ctx, s.queries, shareLink.CollectionID, userID) {
+		return nil, status.Error(codes.PermissionDenied, "access denied")
+	}
+
+	resumed, err := s.queries.ResumeShareLink(ctx, req.Token)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to resume token: %v", err)
+	}
+
+	dbCollection, err := s.queries.GetCollectionByID(ctx, resumed.CollectionID)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "collection not found: %v", err)
+	}
+
+	return dbShareLinkToProto(resumed, dbCollection)
+}
+
+// updateShareTokenOverrides is shared with the admin service which skips the access check and the cap on
+// rate_limit, maxRateLimit is zero for it.
+func updateShareTokenOverrides(ctx context.Context, queries *db.Queries, shareLink db.ShareLink, req *censysv1.UpdateShareTokenRequest, maxRateLimit int32) (*censysv1.ShareToken, error) {
+	rateLimit, rateLimitWindow, err := rateLimitParams(req.RateLimit, req.RateLimitWindowSeconds, maxRateLimit)
+	if err != nil {
+		return nil, err
+	}
+	cacheMaxAge, err := cacheMaxAgeParam(req.CacheMaxAgeSeconds)
+	if err != nil {
+		return nil, err
+	}
+
+	updated, err := queries.UpdateShareLinkOverrides(ctx, db.UpdateShareLinkOverridesParams{
+		Token:                  shareLink.Token,
+		RateLimit:              rateLimit,
+		RateLimitWindowSeconds: rateLimitWindow,
+		CacheMaxAgeSeconds:     cacheMaxAge,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update share token: %v", err)
+	}
+
+	dbCollection, err := queries.GetCollectionByID(ctx, updated.CollectionID)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "collection not found: %v", err)
+	}
+
+	return dbShareLinkToProto(updated, dbCollection)
+}
+
+// rateLimitParams validates a requested override. A zero limit means no override, a zero maxLimit no cap.
+func rateLimitParams(limit, windowSeconds, maxLimit int32) (pgtype.Int4, pgtype.Int4, error) {
+	if limit < 0 || windowSeconds < 0 {
+		return pgtype.Int4{}, pgtype.Int4{}, status.Error(codes.InvalidArgument, "rate_limit and rate_limit_window_seconds must not be negative")
+	}
+	if maxLimit > 0 && limit > maxLimit {
+		return pgtype.Int4{}, pgtype.Int4{}, status.Errorf(codes.InvalidArgument, "rate_limit must not be above %d", maxLimit)
+	}
+	if limit == 0 && windowSeconds != 0 {
+		return pgtype.Int4{}, pgtype.Int4{}, status.Error(codes.InvalidArgument, "rate_limit_window_seconds requires rate_limit")
+	}
+
+	return pgtype.Int4{Int32: limit, Valid: limit > 0}, pgtype.Int4{Int32: windowSeconds, Valid: windowSeconds > 0}, nil
+}
+
+// maxCacheMaxAgeSeconds caps per token max-age at a day, a purge can be missed and this bounds how long a
+// revoked link can stay cached.
+const maxCacheMaxAgeSeconds = 24 * 60 * 60
+
+// cacheMaxAgeParam validates a requested max-age override. Zero means no override.
+func cacheMaxAgeParam(seconds int32) (pgtype.Int4, error) {
+	if seconds < 0 || seconds > maxCacheMaxAgeSeconds {
+		return pgtype.Int4{}, status.Errorf(codes.InvalidArgument, "cache_max_age_seconds must be between 0 and %d", maxCacheMaxAgeSeconds)
+	}
+	return pgtype.Int4{Int32: seconds, Valid: seconds > 0}, nil
+}
+
+func dbShareLinkToProto(shareLink db.ShareLink, c db.Collection) (*censysv1.ShareToken, error) {
+	collectionUIDBytes, err := c.Uid.MarshalJSON()
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to marshal collection uid: %v", err)
 	}
 
+	var suspendedAt *timestamppb.Timestamp
+	if shareLink.SuspendedAt.Valid {
+		suspendedAt = timestamppb.New(shareLink.SuspendedAt.Time)
+	}
+
+	var expiresAt *timestamppb.Timestamp
+	if shareLink.ExpiresAt.Valid {
+		expiresAt = timestamppb.New(shareLink.ExpiresAt.Time)
+	}
+
 	return &censysv1.ShareToken{
-		Token:         shareLink.Token,
-		CollectionUid: string(collectionUIDBytes[1 : len(collectionUIDBytes)-1]),
-		AccessCount:   shareLink.AccessCount,
-		CreatedAt:     timestamppb.New(shareLink.CreatedAt.Time),
+		Token:                  shareLink.Token,
+		CollectionUid:          string(collectionUIDBytes[1 : len(collectionUIDBytes)-1]),
+		AccessCount:            shareLink.AccessCount,
+		CreatedAt:              timestamppb.New(shareLink.CreatedAt.Time),
+		RateLimit:              shareLink.RateLimit.Int32,
+		RateLimitWindowSeconds: shareLink.RateLimitWindowSeconds.Int32,
+		Suspended:              shareLink.SuspendedAt.Valid,
+		SuspendedReason:        shareLink.SuspendedReason.String,
+		SuspendedAt:            suspendedAt,
+		CacheMaxAgeSeconds:     shareLink.CacheMaxAgeSeconds.Int32,
+		ExpiresAt:              expiresAt,
 	}, nil
 }
 
@@ -350,14 +697,23 @@ func (s *CollectionServer) GetSharedCollection(ctx context.Context, req *censysv
 		return nil, status.Error(codes.InvalidArgument, "token is required")
 	}
 
-	shareLink, err := s.queries.IncrementAccessCount(ctx, req.Token)
-	if err != nil {
-		return nil, status.Errorf(codes.NotFound, "invalid or revoked token: %v", err)
+	if req.IfNoneMatch != "" {
+		// revalidation is a single read and deliberately not counted as an access, access_count counts the
+		// times the collection was served. Anything but a match takes the full path, which also reports
+		// revoked and suspended tokens
+		current, err := s.queries.GetSharedCollectionRevision(ctx, req.Token)
+		if err == nil && httpcache.ETagMatches(req.IfNoneMatch, formatEtag(current.Revision)) {
+			return &censysv1.SharedCollectionResponse{
+				Etag:               formatEtag(current.Revision),
+				CacheMaxAgeSeconds: current.CacheMaxAgeSeconds.Int32,
+				NotModified:        true,
+			}, nil
+		}
 	}
 
-	dbCollection, err := s.queries.GetCollectionByID(ctx, shareLink.CollectionID)
+	shareLink, dbCollection, err := s.sharedCollection(ctx, req.Token)
 	if err != nil {
-		return nil, status.Errorf(codes.NotFound, "collection not found: %v", err)
+		return nil, err
 	}
 
 	protoCollection, err := dbCollectionToProto(dbCollection)
@@ -366,12 +722,60 @@ func (s *CollectionServer) GetSharedCollection(ctx context.Context, req *censysv
 	}
 
 	return &censysv1.SharedCollectionResponse{
-		Collection:  protoCollection,
-		AccessCount: shareLink.AccessCount,
+		Collection:         protoCollection,
+		AccessCount:        shareLink.AccessCount,
+		Etag:               formatEtag(dbCollection.Revision),
+		CacheMaxAgeSeconds: shareLink.CacheMaxAgeSeconds.Int32,
 	}, nil
 
 }
 
+// sharedCollection counts an access on the share token and returns the collection it points to.
+func (s *CollectionServer) sharedCollection(ctx context.Context, token string) (db.ShareLink, db.Collection, error) {
+	shareLink, err := s.queries.IncrementAccessCount(ctx, token)
+	if err != nil {
+		if existing, lookupErr := s.queries.GetShareLinkByToken(ctx, token); lookupErr == nil {
+			if existing.SuspendedAt.Valid {
+				return db.ShareLink{}, db.Collection{}, status.Error(codes.PermissionDenied, "share token suspended")
+			}
+			if shareLinkExpired(existing) {
+				return db.ShareLink{}, db.Collection{}, status.Error(codes.PermissionDenied, "share token expired")
+			}
+			if current, err := s.queries.GetCollectionByID(ctx, existing.CollectionID); err == nil {
+				if err := checkShared(current); err != nil {
+					return db.ShareLink{}, db.Collection{}, err
+				}
+			}
+		}
+		return db.ShareLink{}, db.Collection{}, status.Errorf(codes.NotFound, "invalid or revoked token: %v", err)
+	}
+
+	dbCollection, err := s.queries.GetCollectionByID(ctx, shareLink.CollectionID)
+	if err != nil {
+		return db.ShareLink{}, db.Collection{}, status.Errorf(codes.NotFound, "collection not found: %v", err)
+	}
+	// it may have stopped being shared since the access was counted
+	if err := checkShared(dbCollection); err != nil {
+		return db.ShareLink{}, db.Collection{}, err
+	}
+
+	return shareLink, dbCollection, nil
+}
+
+// checkShared is the rule for what a share token can read: only collections whose access level is shared.
+// Tokens can be created on any collection but serve nothing until it is shared. IncrementAccessCount and
+// GetSharedCollectionRevision apply the same rule in SQL.
+func checkShared(c db.Collection) error {
+	if c.AccessLevel != db.AccessLevelShared {
+		return status.Error(codes.PermissionDenied, "collection is not shared")
+	}
+	return nil
+}
+
+func shareLinkExpired(shareLink db.ShareLink) bool {
+	return shareLink.ExpiresAt.Valid && !shareLink.ExpiresAt.Time.After(time.Now())
+}
+
 func (s *CollectionServer) RevokeShareToken(ctx context.Context, req *censysv1.RevokeShareTokenRequest) (*emptypb.Empty, error) {
 	if req.Token == "" {
 		return nil, status.Error(codes.InvalidArgument, "token is required")
@@ -0,0 +1,63 @@
+package server
+
+import (
+	"testing"
+
+	"github.com/ajscimone/censys-challenge/internal/db"
+	"github.com/jackc/pgx/v5/pgtype"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestRateLimitParams(t *testing.T) {
+	for _, tc := range []struct {
+		name                    string
+		limit, window, maxLimit int32
+		code                    codes.Code
+	}{
+		{name: "no override", code: codes.OK},
+		{name: "within the cap", limit: 100, window: 60, maxLimit: 100, code: codes.OK},
+		{name: "above the cap", limit: 101, maxLimit: 100, code: codes.InvalidArgument},
+		{name: "no cap", limit: 1 << 30, code: codes.OK},
+		{name: "negative limit", limit: -1, code: codes.InvalidArgument},
+		{name: "window without limit", window: 60, code: codes.InvalidArgument},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			limit, window, err := rateLimitParams(tc.limit, tc.window, tc.maxLimit)
+			if status.Code(err) != tc.code {
+				t.Fatalf("expected %v, got %v", tc.code, err)
+			}
+			if err == nil && (limit.Valid != (tc.limit > 0) || window.Valid != (tc.window > 0)) {
+				t.Fatalf("unexpected override %+v %+v", limit, window)
+			}
+		})
+	}
+}
+
+func TestCheckEtag(t *testing.T) {
+	collection := db.Collection{Revision: 7}
+
+	for _, tc := range []struct {
+		etag string
+		want pgtype.Int8
+		code codes.Code
+	}{
+		{etag: "", want: pgtype.Int8{}},
+		{etag: "7", want: pgtype.Int8{Int64: 7, Valid: true}},
+		{etag: `"7"`, want: pgtype.Int8{Int64: 7, Valid: true}},
+		{etag: `W/"7"`, want: pgtype.Int8{Int64: 7, Valid: true}},
+		{etag: "6", code: codes.Aborted},
+		{etag: `"8"`, code: codes.Aborted},
+		{etag: "seven", code: codes.InvalidArgument},
+		{etag: `W/`, code: codes.InvalidArgument},
+	} {
+		got, err := checkEtag(tc.etag, collection)
+		if status.Code(err) != tc.code {
+			t.Errorf("%q: expected %v, got %v", tc.etag, tc.code, err)
+			continue
+		}
+		if err == nil && got != tc.want {
+			t.Errorf("%q: expected %+v, got %+v", tc.etag, tc.want, got)
+		}
+	}
+}
@@ -0,0 +1,90 @@
+package server
+
+import (
+	"context"
+
+	"github.com/ajscimone/censys-challenge/gen/proto"
+	"github.com/ajscimone/censys-challenge/internal/db"
+	"github.com/ajscimone/censys-challenge/internal/middleware"
+	"github.com/jackc/pgx/v5/pgtype"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *CollectionServer) CopyCollection(ctx context.Context, req *censysv1.CopyCollectionRequest) (*censysv1.Collection, error) {
+	if (req.Uid == "") == (req.ShareToken == "") {
+		return nil, status.Error(codes.InvalidArgument, "exactly one of uid and share_token is required")
+	}
+
+	userID, err := middleware.UserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "authentication required")
+	}
+
+	var source db.Collection
+	if req.Uid != "" {
+		source, _, err = s.accessibleCollection(ctx, req.Uid)
+	} else {
+		_, source, err = s.sharedCollection(ctx, req.ShareToken)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	name := source.Name
+	if req.Name != "" {
+		name = req.Name
+	}
+
+	accessLevel := db.AccessLevelPrivate
+	var orgID pgtype.Int4
+	if req.OrganizationUid != "" {
+		org, err := s.memberOrganization(ctx, userID, req.OrganizationUid)
+		if err != nil {
+			return nil, err
+		}
+		accessLevel = db.AccessLevelOrganization
+		orgID = pgtype.Int4{Int32: org.ID, Valid: true}
+	}
+
+	if err := s.checkDataSize(int64(len(source.Data))); err != nil {
+		return nil, err
+	}
+
+	if err := validateData(ctx, s.queries, orgID, source.Data); err != nil {
+		return nil, err
+	}
+
+	added := quotaAmounts{collections: 1, storageBytes: int64(len(source.Data))}
+	if err := s.checkQuota(ctx, s.queries, pgtype.Int4{Int32: userID, Valid: true}, added, orgID, added); err != nil {
+		return nil, err
+	}
+
+	var copied db.Collection
+	err = withTx(ctx, s.pool, func(q *db.Queries) error {
+		var err error
+		copied, err = q.CreateCollection(ctx, db.CreateCollectionParams{
+			Name:                name,
+			Data:                source.Data,
+			AccessLevel:         accessLevel,
+			OwnerID:             pgtype.Int4{Int32: userID, Valid: true},
+			OrganizationID:      orgID,
+			Labels:              source.Labels,
+			SourceCollectionUid: source.Uid,
+		})
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to copy collection: %v", err)
+		}
+
+		details := map[string]string{"copy_uid": copied.Uid.String()}
+		if req.ShareToken != "" {
+			details["via"] = "share_token"
+		}
+		return recordAuditEvent(ctx, q, userID, "collection.copied", source.Uid, details)
+	})
+	if err != nil {
+		return nil, txStatus(err, "failed to copy collection")
+	}
+
+	return dbCollectionToProto(copied)
+}
@@ -0,0 +1,105 @@
+package server
+
+import (
+	"encoding/json"
+
+	"github.com/ajscimone/censys-challenge/internal/labels"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func labelsToJSON(l map[string]string) ([]byte, error) {
+	if err := labels.Validate(l); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid labels: %v", err)
+	}
+	if l == nil {
+		l = map[string]string{}
+	}
+
+	raw, err := json.Marshal(l)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to marshal labels: %v", err)
+	}
+	return raw, nil
+}
+
+func labelsFromJSON(raw []byte) (map[string]string, error) {
+	l := map[string]string{}
+	if len(raw) == 0 {
+		return l, nil
+	}
+	if err := json.Unmarshal(raw, &l); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to unmarshal labels: %v", err)
+	}
+	return l, nil
+}
+
+// patchLabelKeys sets or, when the key is missing from requested, removes the given label keys.
+func patchLabelKeys(current []byte, requested map[string]string, keys []string) ([]byte, error) {
+	l, err := labelsFromJSON(current)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, key := range keys {
+		value, ok := requested[key]
+		if !ok {
+			delete(l, key)
+			continue
+		}
+		l[key] = value
+	}
+
+	return labelsToJSON(l)
+}
+
+// labelFilter is a parsed label selector in the shape the list and search queries take. Nil fields are
+// left out of the query.
+type labelFilter struct {
+	// equals is one object checked with @>
+	equals []byte
+	// notEquals is an array of single label objects, none of which may be contained
+	notEquals []byte
+	exists    []string
+	missing   []string
+}
+
+func parseLabelSelector(raw string) (labelFilter, error) {
+	var filter labelFilter
+
+	selector, err := labels.ParseSelector(raw)
+	if err != nil {
+		return filter, status.Errorf(codes.InvalidArgument, "invalid label_selector: %v", err)
+	}
+
+	equals := map[string]string{}
+	var notEquals []map[string]string
+	for _, req := range selector {
+		switch req.Operator {
+		case labels.Equals:
+			if existing, ok := equals[req.Key]; ok && existing != req.Value {
+				return filter, status.Errorf(codes.InvalidArgument, "label_selector requires %q to equal both %q and %q", req.Key, existing, req.Value)
+			}
+			equals[req.Key] = req.Value
+		case labels.NotEquals:
+			notEquals = append(notEquals, map[string]string{req.Key: req.Value})
+		case labels.Exists:
+			filter.exists = append(filter.exists, req.Key)
+		case labels.DoesNotExist:
+			filter.missing = append(filter.missing, req.Key)
+		}
+	}
+
+	if len(equals) > 0 {
+		if filter.equals, err = json.Marshal(equals); err != nil {
+			return filter, status.Errorf(codes.Internal, "failed to marshal label_selector: %v", err)
+		}
+	}
+	if len(notEquals) > 0 {
+		if filter.notEquals, err = json.Marshal(notEquals); err != nil {
+			return filter, status.Errorf(codes.Internal, "failed to marshal label_selector: %v", err)
+		}
+	}
+
+	return filter, nil
+}
@@ -0,0 +1,243 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"github.com/ajscimone/censys-challenge/gen/proto"
+	"github.com/ajscimone/censys-challenge/internal/db"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type OrphanPolicy string
+
+const (
+	// OrphanPolicyReassign gives orphans to an admin of the organization they belong to as private
+	// collections, falling back to quarantine when they belong to none or it has no other admin.
+	OrphanPolicyReassign   OrphanPolicy = "reassign"
+	OrphanPolicyQuarantine OrphanPolicy = "quarantine"
+	OrphanPolicyDelete     OrphanPolicy = "delete"
+)
+
+func (s *AdminServer) DeleteUser(ctx context.Context, req *censysv1.DeleteUserRequest) (*censysv1.OrphanedCollections, error) {
+	if req.UserUid == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_uid is required")
+	}
+
+	var userUUID pgtype.UUID
+	if err := userUUID.Scan(req.UserUid); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user_uid: %v", err)
+	}
+
+	dbUser, err := s.queries.GetUserByUID(ctx, userUUID)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "user not found: %v", err)
+	}
+
+	resp := &censysv1.OrphanedCollections{}
+	err = withTx(ctx, s.pool, func(q *db.Queries) error {
+		if err := q.ReleaseUserFromOrganizationCollections(ctx, pgtype.Int4{Int32: dbUser.ID, Valid: true}); err != nil {
+			return status.Errorf(codes.Internal, "failed to release organization collections: %v", err)
+		}
+
+		orphans, err := q.ListOrphanedCollectionsForUser(ctx, pgtype.Int4{Int32: dbUser.ID, Valid: true})
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to list orphaned collections: %v", err)
+		}
+
+		// each collection goes to an admin of its own organization. Memberships go with the user, so the
+		// admins have to be found before the delete
+		byAdmin := map[pgtype.Int4][]int32{}
+		admins := map[int32]pgtype.Int4{}
+		for _, orphan := range orphans {
+			var adminID pgtype.Int4
+			if s.config.OrphanPolicy == OrphanPolicyReassign && orphan.OrganizationID.Valid {
+				var cached bool
+				if adminID, cached = admins[orphan.OrganizationID.Int32]; !cached {
+					adminID, err = lookupAdmin(q.GetOrganizationAdmin(ctx, db.GetOrganizationAdminParams{
+						OrganizationID: orphan.OrganizationID.Int32,
+						ExcludeUserID:  dbUser.ID,
+					}))
+					if err != nil {
+						return err
+					}
+					admins[orphan.OrganizationID.Int32] = adminID
+				}
+			}
+			byAdmin[adminID] = append(byAdmin[adminID], orphan.ID)
+		}
+		for adminID, ids := range byAdmin {
+			if err := s.applyOrphanPolicy(ctx, q, ids, adminID, resp); err != nil {
+				return err
+			}
+		}
+
+		if _, err := q.DeleteUser(ctx, dbUser.ID); err != nil {
+			return status.Errorf(codes.Internal, "failed to delete user: %v", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, txStatus(err, "failed to delete user")
+	}
+
+	return resp, nil
+}
+
+func (s *AdminServer) DeleteOrganization(ctx context.Context, req *censysv1.DeleteOrganizationRequest) (*censysv1.OrphanedCollections, error) {
+	if req.OrganizationUid == "" {
+		return nil, status.Error(codes.InvalidArgument, "organization_uid is required")
+	}
+
+	var orgUUID pgtype.UUID
+	if err := orgUUID.Scan(req.OrganizationUid); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid organization_uid: %v", err)
+	}
+
+	dbOrg, err := s.queries.GetOrganizationByUID(ctx, orgUUID)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "organization not found: %v", err)
+	}
+
+	resp := &censysv1.OrphanedCollections{}
+	err = withTx(ctx, s.pool, func(q *db.Queries) error {
+		adminID, err := lookupAdmin(q.GetOrganizationAdmin(ctx, db.GetOrganizationAdminParams{OrganizationID: dbOrg.ID}))
+		if err != nil {
+			return err
+		}
+
+		if err := q.ReleaseOrganizationFromOwnedCollections(ctx, pgtype.Int4{Int32: dbOrg.ID, Valid: true}); err != nil {
+			return status.Errorf(codes.Internal, "failed to release owned collections: %v", err)
+		}
+
+		orphans, err := q.ListOrphanedCollectionIDsForOrganization(ctx, pgtype.Int4{Int32: dbOrg.ID, Valid: true})
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to list orphaned collections: %v", err)
+		}
+		if err := s.applyOrphanPolicy(ctx, q, orphans, adminID, resp); err != nil {
+			return err
+		}
+
+		if _, err := q.DeleteOrganization(ctx, dbOrg.ID); err != nil {
+			return status.Errorf(codes.Internal, "failed to delete organization: %v", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, txStatus(err, "failed to delete organization")
+	}
+
+	return resp, nil
+}
+
+func (s *AdminServer) ListQuarantinedCollections(ctx context.Context, req *censysv1.ListQuarantinedCollectionsRequest) (*censysv1.ListQuarantinedCollectionsResponse, error) {
+	pageSize := clampPageSize(req.PageSize)
+	before, err := parseIDPageToken(req.PageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	quarantined, err := s.queries.ListQuarantinedCollections(ctx, db.ListQuarantinedCollectionsParams{
+		BeforeID: before,
+		PageSize: pageSize,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list quarantined collections: %v", err)
+	}
+
+	resp := &censysv1.ListQuarantinedCollectionsResponse{}
+	for _, c := range quarantined {
+		protoCollection, err := dbCollectionToProto(c)
+		if err != nil {
+			return nil, err
+		}
+		resp.Collections = append(resp.Collections, protoCollection)
+	}
+	if len(quarantined) == int(pageSize) {
+		resp.NextPageToken = strconv.FormatInt(int64(quarantined[len(quarantined)-1].ID), 10)
+	}
+
+	return resp, nil
+}
+
+func (s *AdminServer) ReassignCollection(ctx context.Context, req *censysv1.ReassignCollectionRequest) (*censysv1.Collection, error) {
+	if req.CollectionUid == "" {
+		return nil, status.Error(codes.InvalidArgument, "collection_uid is required")
+	}
+	if req.UserUid == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_uid is required")
+	}
+
+	var collectionUUID pgtype.UUID
+	if err := collectionUUID.Scan(req.CollectionUid); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid collection_uid: %v", err)
+	}
+
+	var userUUID pgtype.UUID
+	if err := userUUID.Scan(req.UserUid); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user_uid: %v", err)
+	}
+
+	dbUser, err := s.queries.GetUserByUID(ctx, userUUID)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "user not found: %v", err)
+	}
+
+	reassigned, err := s.queries.ReassignQuarantinedCollection(ctx, db.ReassignQuarantinedCollectionParams{
+		Uid:     collectionUUID,
+		OwnerID: pgtype.Int4{Int32: dbUser.ID, Valid: true},
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "quarantined collection not found: %v", err)
+	}
+
+	return dbCollectionToProto(reassigned)
+}
+
+// applyOrphanPolicy disposes of collections that no longer have an owner or organization and adds them
+// to the counts in resp.
+func (s *AdminServer) applyOrphanPolicy(ctx context.Context, q *db.Queries, ids []int32, adminID pgtype.Int4, resp *censysv1.OrphanedCollections) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	switch {
+	case s.config.OrphanPolicy == OrphanPolicyDelete:
+		deleted, err := q.DeleteCollectionsByID(ctx, ids)
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to delete orphaned collections: %v", err)
+		}
+		resp.Deleted += int32(deleted)
+
+	case s.config.OrphanPolicy == OrphanPolicyReassign && adminID.Valid:
+		reassigned, err := q.ReassignCollections(ctx, db.ReassignCollectionsParams{OwnerID: adminID, Ids: ids})
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to reassign orphaned collections: %v", err)
+		}
+		resp.Reassigned += int32(reassigned)
+
+	default:
+		quarantined, err := q.QuarantineCollections(ctx, ids)
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to quarantine orphaned collections: %v", err)
+		}
+		resp.Quarantined += int32(quarantined)
+	}
+
+	return nil
+}
+
+// lookupAdmin turns the result of an admin query into an optional user id.
+func lookupAdmin(userID int32, err error) (pgtype.Int4, error) {
+	if errors.Is(err, pgx.ErrNoRows) {
+		return pgtype.Int4{}, nil
+	}
+	if err != nil {
+		return pgtype.Int4{}, status.Errorf(codes.Internal, "failed to find organization admin: %v", err)
+	}
+	return pgtype.Int4{Int32: userID, Valid: true}, nil
+}
@@ -0,0 +1,116 @@
+package server
+
+import (
+	"context"
+	"testing"
+
+	censysv1 "github.com/ajscimone/censys-challenge/gen/proto"
+	"github.com/ajscimone/censys-challenge/internal/db"
+	"github.com/jackc/pgx/v5/pgtype"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+func (e *testEnv) collectionByUID(t *testing.T, uid string) db.Collection {
+	t.Helper()
+
+	var collectionUUID pgtype.UUID
+	if err := collectionUUID.Scan(uid); err != nil {
+		t.Fatal(err)
+	}
+	collection, err := e.queries.GetCollectionByUID(context.Background(), collectionUUID)
+	if err != nil {
+		t.Fatalf("failed to load collection: %v", err)
+	}
+	return collection
+}
+
+// privateOrganizationCollection is a collection made private after being shared with org, which keeps its
+// organization_id.
+func (e *testEnv) privateOrganizationCollection(t *testing.T, ctx context.Context, org db.Organization) *censysv1.Collection {
+	t.Helper()
+
+	collection, err := e.collections.CreateCollection(ctx, &censysv1.CreateCollectionRequest{
+		Name:            "private",
+		AccessLevel:     censysv1.AccessLevel_ACCESS_LEVEL_ORGANIZATION,
+		OrganizationUid: org.Uid.String(),
+	})
+	if err != nil {
+		t.Fatalf("failed to create collection: %v", err)
+	}
+	collection, err = e.collections.UpdateCollection(ctx, &censysv1.UpdateCollectionRequest{
+		Uid:         collection.Uid,
+		AccessLevel: censysv1.AccessLevel_ACCESS_LEVEL_PRIVATE,
+		UpdateMask:  &fieldmaskpb.FieldMask{Paths: []string{"access_level"}},
+	})
+	if err != nil {
+		t.Fatalf("failed to make collection private: %v", err)
+	}
+	return collection
+}
+
+func TestDeleteUser_OrphansGoToTheirOwnOrganizationAdmin(t *testing.T) {
+	env := newTestEnv(t)
+	user, ctx := env.newUser(t)
+	otherAdmin, _ := env.newUser(t)
+	orgAdmin, _ := env.newUser(t)
+	// the user's oldest membership is in an organization unrelated to the collections
+	env.newOrganization(t, otherAdmin, user)
+	org := env.newOrganization(t, orgAdmin, user)
+
+	private := env.privateOrganizationCollection(t, ctx, org)
+	shared, err := env.collections.CreateCollection(ctx, &censysv1.CreateCollectionRequest{
+		Name:            "shared with the organization",
+		AccessLevel:     censysv1.AccessLevel_ACCESS_LEVEL_ORGANIZATION,
+		OrganizationUid: org.Uid.String(),
+	})
+	if err != nil {
+		t.Fatalf("failed to create collection: %v", err)
+	}
+
+	admin := NewAdminServer(env.pool, AdminServerConfig{OrphanPolicy: OrphanPolicyReassign})
+	resp, err := admin.DeleteUser(context.Background(), &censysv1.DeleteUserRequest{UserUid: user.Uid.String()})
+	if err != nil {
+		t.Fatalf("failed to delete user: %v", err)
+	}
+	if resp.Reassigned != 1 {
+		t.Fatalf("expected the private collection to be reassigned, got %+v", resp)
+	}
+
+	reassigned := env.collectionByUID(t, private.Uid)
+	if reassigned.OwnerID.Int32 != orgAdmin.ID || reassigned.AccessLevel != db.AccessLevelPrivate {
+		t.Fatalf("the private collection should go to its own organization's admin and stay private, got owner %v level %s",
+			reassigned.OwnerID, reassigned.AccessLevel)
+	}
+
+	kept := env.collectionByUID(t, shared.Uid)
+	if kept.OwnerID.Valid || kept.OrganizationID.Int32 != org.ID || kept.AccessLevel != db.AccessLevelOrganization {
+		t.Fatalf("the organization collection should stay with the organization, got %+v", kept)
+	}
+}
+
+func TestDeleteUser_PrivateCollectionsDoNotBecomeOrganizationVisible(t *testing.T) {
+	env := newTestEnv(t)
+	user, ctx := env.newUser(t)
+	orgAdmin, _ := env.newUser(t)
+	org := env.newOrganization(t, orgAdmin, user)
+
+	private := env.privateOrganizationCollection(t, ctx, org)
+	collectionID := env.collectionByUID(t, private.Uid).ID
+
+	// env.admin quarantines orphans
+	resp, err := env.admin.DeleteUser(context.Background(), &censysv1.DeleteUserRequest{UserUid: user.Uid.String()})
+	if err != nil {
+		t.Fatalf("failed to delete user: %v", err)
+	}
+	if resp.Quarantined != 1 {
+		t.Fatalf("expected the private collection to be quarantined, got %+v", resp)
+	}
+
+	quarantined, err := env.queries.GetCollectionByID(context.Background(), collectionID)
+	if err != nil {
+		t.Fatalf("failed to load collection: %v", err)
+	}
+	if quarantined.AccessLevel != db.AccessLevelPrivate || !quarantined.QuarantinedAt.Valid || quarantined.OrganizationID.Valid {
+		t.Fatalf("expected a quarantined private collection, got %+v", quarantined)
+	}
+}
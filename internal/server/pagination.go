@@ -0,0 +1,37 @@
+package server
+
+import (
+	"strconv"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	defaultPageSize = 20
+	maxPageSize     = 100
+)
+
+func clampPageSize(requested int32) int32 {
+	if requested <= 0 {
+		return defaultPageSize
+	}
+	if requested > maxPageSize {
+		return maxPageSize
+	}
+	return requested
+}
+
+// parseIDPageToken decodes a page token holding the id the previous page stopped at.
+func parseIDPageToken(token string) (pgtype.Int4, error) {
+	if token == "" {
+		return pgtype.Int4{}, nil
+	}
+
+	id, err := strconv.ParseInt(token, 10, 32)
+	if err != nil {
+		return pgtype.Int4{}, status.Errorf(codes.InvalidArgument, "invalid page_token: %v", err)
+	}
+	return pgtype.Int4{Int32: int32(id), Valid: true}, nil
+}
@@ -0,0 +1,113 @@
+package server
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/ajscimone/censys-challenge/gen/proto"
+	"github.com/ajscimone/censys-challenge/internal/db"
+	"github.com/ajscimone/censys-challenge/internal/middleware"
+	jsonpatch "github.com/evanphx/json-patch/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// PatchCollectionData applies a JSON Patch or merge patch to the stored data. The row is locked for
+// the duration of the transaction so concurrent patches are applied one after another instead of
+// overwriting each other.
+func (s *CollectionServer) PatchCollectionData(ctx context.Context, req *censysv1.PatchCollectionDataRequest) (*censysv1.Collection, error) {
+	if req.Uid == "" {
+		return nil, status.Error(codes.InvalidArgument, "uid is required")
+	}
+	if req.Patch == "" {
+		return nil, status.Error(codes.InvalidArgument, "patch is required")
+	}
+
+	var collectionUUID pgtype.UUID
+	if err := collectionUUID.Scan(req.Uid); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid uid: %v", err)
+	}
+
+	userID, err := middleware.UserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "authentication required")
+	}
+
+	dbCollection, err := s.queries.GetCollectionByUID(ctx, collectionUUID)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "collection not found: %v", err)
+	}
+
+	if !checkAccess(ctx, s.queries, dbCollection.ID, userID) {
+		return nil, status.Error(codes.PermissionDenied, "access denied")
+	}
+
+	var updated db.Collection
+	err = withTx(ctx, s.pool, func(q *db.Queries) error {
+		locked, err := q.GetCollectionByIDForUpdate(ctx, dbCollection.ID)
+		if err != nil {
+			return status.Errorf(codes.NotFound, "collection not found: %v", err)
+		}
+
+		expectedRevision, err := checkEtag(req.Etag, locked)
+		if err != nil {
+			return err
+		}
+
+		patched, err := applyDataPatch(locked.Data, req.PatchType, []byte(req.Patch))
+		if err != nil {
+			return err
+		}
+
+		updated, err = s.writeCollection(ctx, q, locked, userID, db.UpdateCollectionParams{
+			ID:               locked.ID,
+			Name:             locked.Name,
+			Data:             patched,
+			AccessLevel:      locked.AccessLevel,
+			OrganizationID:   locked.OrganizationID,
+			VersionRetention: locked.VersionRetention,
+			Labels:           locked.Labels,
+			ExpectedRevision: expectedRevision,
+		})
+		return err
+	})
+	if err != nil {
+		return nil, txStatus(err, "failed to patch collection")
+	}
+
+	return dbCollectionToProto(updated)
+}
+
+func applyDataPatch(data []byte, patchType censysv1.PatchType, patch []byte) ([]byte, error) {
+	var patched []byte
+	switch patchType {
+	case censysv1.PatchType_PATCH_TYPE_JSON_PATCH:
+		decoded, err := jsonpatch.DecodePatch(patch)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid json patch: %v", err)
+		}
+		patched, err = decoded.Apply(data)
+		if err != nil {
+			// a failed test operation or a missing path is the caller's precondition not holding
+			return nil, status.Errorf(codes.FailedPrecondition, "failed to apply json patch: %v", err)
+		}
+
+	case censysv1.PatchType_PATCH_TYPE_MERGE_PATCH:
+		var err error
+		patched, err = jsonpatch.MergePatch(data, patch)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid merge patch: %v", err)
+		}
+
+	default:
+		return nil, status.Error(codes.InvalidArgument, "patch_type is required")
+	}
+
+	// collection data is a Struct so the result has to stay a JSON object
+	if trimmed := bytes.TrimSpace(patched); len(trimmed) == 0 || trimmed[0] != '{' {
+		return nil, status.Error(codes.InvalidArgument, "patched data must be a JSON object")
+	}
+
+	return patched, nil
+}
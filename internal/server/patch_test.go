@@ -0,0 +1,98 @@
+package server
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	censysv1 "github.com/ajscimone/censys-challenge/gen/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestApplyDataPatch(t *testing.T) {
+	const data = `{"a":1,"b":{"c":2}}`
+
+	for _, tc := range []struct {
+		name      string
+		patchType censysv1.PatchType
+		patch     string
+		want      string
+		code      codes.Code
+	}{
+		{
+			name:      "json patch",
+			patchType: censysv1.PatchType_PATCH_TYPE_JSON_PATCH,
+			patch:     `[{"op":"replace","path":"/a","value":10},{"op":"add","path":"/b/d","value":3}]`,
+			want:      `{"a":10,"b":{"c":2,"d":3}}`,
+		},
+		{
+			name:      "json patch test holds",
+			patchType: censysv1.PatchType_PATCH_TYPE_JSON_PATCH,
+			patch:     `[{"op":"test","path":"/a","value":1},{"op":"remove","path":"/b"}]`,
+			want:      `{"a":1}`,
+		},
+		{
+			name:      "json patch test fails",
+			patchType: censysv1.PatchType_PATCH_TYPE_JSON_PATCH,
+			patch:     `[{"op":"test","path":"/a","value":2}]`,
+			code:      codes.FailedPrecondition,
+		},
+		{
+			name:      "json patch missing path",
+			patchType: censysv1.PatchType_PATCH_TYPE_JSON_PATCH,
+			patch:     `[{"op":"remove","path":"/x"}]`,
+			code:      codes.FailedPrecondition,
+		},
+		{
+			name:      "invalid json patch",
+			patchType: censysv1.PatchType_PATCH_TYPE_JSON_PATCH,
+			patch:     `{"op":"remove"}`,
+			code:      codes.InvalidArgument,
+		},
+		{
+			name:      "json patch replacing the document",
+			patchType: censysv1.PatchType_PATCH_TYPE_JSON_PATCH,
+			patch:     `[{"op":"replace","path":"","value":[1]}]`,
+			code:      codes.InvalidArgument,
+		},
+		{
+			name:      "merge patch",
+			patchType: censysv1.PatchType_PATCH_TYPE_MERGE_PATCH,
+			patch:     `{"a":null,"b":{"d":3}}`,
+			want:      `{"b":{"c":2,"d":3}}`,
+		},
+		{
+			name:      "merge patch replacing the document",
+			patchType: censysv1.PatchType_PATCH_TYPE_MERGE_PATCH,
+			patch:     `"text"`,
+			code:      codes.InvalidArgument,
+		},
+		{
+			name:  "missing patch type",
+			patch: `{}`,
+			code:  codes.InvalidArgument,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			patched, err := applyDataPatch([]byte(data), tc.patchType, []byte(tc.patch))
+			if status.Code(err) != tc.code {
+				t.Fatalf("expected %v, got %v", tc.code, err)
+			}
+			if err != nil {
+				return
+			}
+
+			var got, want any
+			if err := json.Unmarshal(patched, &got); err != nil {
+				t.Fatal(err)
+			}
+			if err := json.Unmarshal([]byte(tc.want), &want); err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, want) {
+				t.Fatalf("expected %s, got %s", tc.want, patched)
+			}
+		})
+	}
+}
@@ -0,0 +1,325 @@
+package server
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"log"
+
+	"github.com/ajscimone/censys-challenge/gen/proto"
+	"github.com/ajscimone/censys-challenge/internal/db"
+	"github.com/jackc/pgx/v5"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// payloadChunkSize is how payloads are split for storage, independent of how the client chunks its upload.
+// Downloads are sent in chunks of the same size.
+const payloadChunkSize = 1 << 20
+
+const defaultPayloadContentType = "application/octet-stream"
+
+// UploadCollectionData replaces a collection's payload. Chunks are stored as they arrive, outside any
+// transaction, and marked as the upload's so the trash purger leaves them alone. The payload is swapped in
+// one short transaction once the stream ends, so a failed or cancelled stream leaves the previous payload
+// in place and only the unmarked chunks it stored are removed.
+func (s *CollectionServer) UploadCollectionData(stream grpc.ClientStreamingServer[censysv1.UploadCollectionDataRequest, censysv1.CollectionPayload]) error {
+	ctx := stream.Context()
+
+	first, err := stream.Recv()
+	if errors.Is(err, io.EOF) {
+		return status.Error(codes.InvalidArgument, "header is required")
+	}
+	if err != nil {
+		return err
+	}
+
+	header := first.GetHeader()
+	if header == nil {
+		return status.Error(codes.InvalidArgument, "the first message must be the header")
+	}
+
+	dbCollection, _, err := s.accessibleCollection(ctx, header.CollectionUid)
+	if err != nil {
+		return err
+	}
+
+	expectedRevision, err := checkEtag(header.Etag, dbCollection)
+	if err != nil {
+		return err
+	}
+
+	contentType := header.ContentType
+	if contentType == "" {
+		contentType = defaultPayloadContentType
+	}
+
+	uploadID, err := s.queries.CreatePayloadUpload(ctx, dbCollection.ID)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to start upload: %v", err)
+	}
+	w := &payloadWriter{q: s.queries, uploadID: uploadID, digest: sha256.New(), limit: s.config.Quotas.MaxPayloadBytes}
+	finished := false
+	defer func() {
+		if !finished {
+			s.abandonUpload(context.WithoutCancel(ctx), uploadID, w.hashes)
+		}
+	}()
+
+	for {
+		msg, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return err
+		}
+
+		chunk, ok := msg.Part.(*censysv1.UploadCollectionDataRequest_Chunk)
+		if !ok {
+			return status.Error(codes.InvalidArgument, "only the first message can be a header")
+		}
+		if err := w.write(ctx, chunk.Chunk); err != nil {
+			return err
+		}
+	}
+	if err := w.flush(ctx); err != nil {
+		return err
+	}
+
+	resp := &censysv1.CollectionPayload{CollectionUid: header.CollectionUid}
+	err = withTx(ctx, s.pool, func(q *db.Queries) error {
+		// the chunks stay marked until this commits with the payload referencing them
+		deleted, err := q.DeletePayloadUpload(ctx, uploadID)
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to finish upload: %v", err)
+		}
+		if deleted == 0 {
+			return status.Error(codes.Aborted, "the upload ran for too long and was abandoned")
+		}
+
+		// serializes concurrent uploads to the same collection
+		locked, err := q.GetCollectionByIDForUpdate(ctx, dbCollection.ID)
+		if err != nil {
+			return status.Errorf(codes.NotFound, "collection not found: %v", err)
+		}
+
+		var previousSize int64
+		previous, err := q.GetCollectionPayload(ctx, locked.ID)
+		if err == nil {
+			previousSize = previous.Size
+		} else if !errors.Is(err, pgx.ErrNoRows) {
+			return status.Errorf(codes.Internal, "failed to get collection payload: %v", err)
+		}
+
+		growth := quotaAmounts{storageBytes: w.size - previousSize}
+		if err := s.checkQuota(ctx, q, locked.OwnerID, growth, locked.OrganizationID, growth); err != nil {
+			return err
+		}
+
+		previousHashes, err := q.ListCollectionPayloadChunkHashes(ctx, locked.ID)
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to list payload chunks: %v", err)
+		}
+
+		if err := q.DeleteCollectionPayload(ctx, locked.ID); err != nil {
+			return status.Errorf(codes.Internal, "failed to delete collection payload: %v", err)
+		}
+
+		if w.size > 0 {
+			payload, err := q.CreateCollectionPayload(ctx, db.CreateCollectionPayloadParams{
+				CollectionID: locked.ID,
+				ContentType:  contentType,
+				Size:         w.size,
+				Sha256:       hex.EncodeToString(w.digest.Sum(nil)),
+				ChunkSize:    payloadChunkSize,
+			})
+			if err != nil {
+				return status.Errorf(codes.Internal, "failed to create collection payload: %v", err)
+			}
+
+			if err := q.AddCollectionPayloadChunks(ctx, db.AddCollectionPayloadChunksParams{
+				CollectionID: locked.ID,
+				Hashes:       w.hashes,
+			}); err != nil {
+				return status.Errorf(codes.Internal, "failed to add payload chunks: %v", err)
+			}
+
+			resp = dbPayloadToProto(header.CollectionUid, payload)
+		}
+
+		if len(previousHashes) > 0 {
+			if _, err := q.DeleteUnreferencedBlobChunks(ctx, previousHashes); err != nil {
+				return status.Errorf(codes.Internal, "failed to delete payload chunks: %v", err)
+			}
+		}
+
+		if _, err := q.TouchCollection(ctx, db.TouchCollectionParams{
+			ID:               locked.ID,
+			ExpectedRevision: expectedRevision,
+		}); err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return status.Error(codes.Aborted, "collection was modified, etag does not match")
+			}
+			return status.Errorf(codes.Internal, "failed to update collection: %v", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return txStatus(err, "failed to upload collection data")
+	}
+	finished = true
+
+	return stream.SendAndClose(resp)
+}
+
+// abandonUpload unmarks the chunks of an upload that did not finish and removes the ones nothing else uses.
+// The purger gets to anything left behind once the upload is stale.
+func (s *CollectionServer) abandonUpload(ctx context.Context, uploadID int32, hashes [][]byte) {
+	if _, err := s.queries.DeletePayloadUpload(ctx, uploadID); err != nil {
+		log.Printf("Failed to abandon upload %d: %v", uploadID, err)
+		return
+	}
+	if len(hashes) > 0 {
+		if _, err := s.queries.DeleteUnreferencedBlobChunks(ctx, hashes); err != nil {
+			log.Printf("Failed to remove chunks of upload %d: %v", uploadID, err)
+		}
+	}
+}
+
+// payloadWriter re-chunks an upload into payloadChunkSize pieces and stages each one as it fills up.
+type payloadWriter struct {
+	q        *db.Queries
+	uploadID int32
+	digest   hash.Hash
+	limit    int64
+	size     int64
+	buf      []byte
+	hashes   [][]byte
+}
+
+func (w *payloadWriter) write(ctx context.Context, p []byte) error {
+	w.size += int64(len(p))
+	if w.limit > 0 && w.size > w.limit {
+		return quotaError([]*errdetails.QuotaFailure_Violation{{
+			Subject:     "collection",
+			Description: fmt.Sprintf("payload is larger than the limit of %d bytes", w.limit),
+		}})
+	}
+
+	w.digest.Write(p)
+	w.buf = append(w.buf, p...)
+	for len(w.buf) >= payloadChunkSize {
+		if err := w.store(ctx, w.buf[:payloadChunkSize]); err != nil {
+			return err
+		}
+		w.buf = append([]byte(nil), w.buf[payloadChunkSize:]...)
+	}
+	return nil
+}
+
+func (w *payloadWriter) flush(ctx context.Context) error {
+	if len(w.buf) == 0 {
+		return nil
+	}
+	err := w.store(ctx, w.buf)
+	w.buf = nil
+	return err
+}
+
+func (w *payloadWriter) store(ctx context.Context, chunk []byte) error {
+	sum := sha256.Sum256(chunk)
+	if err := w.q.StageBlobChunk(ctx, db.StageBlobChunkParams{UploadID: w.uploadID, Hash: sum[:], Data: chunk}); err != nil {
+		return status.Errorf(codes.Internal, "failed to store payload chunk: %v", err)
+	}
+	w.hashes = append(w.hashes, sum[:])
+	return nil
+}
+
+// DownloadCollectionData streams a byte range of a collection's payload. All chunks are read from one
+// snapshot so a concurrent upload cannot mix two payloads in a single download.
+func (s *CollectionServer) DownloadCollectionData(req *censysv1.DownloadCollectionDataRequest, stream grpc.ServerStreamingServer[censysv1.DownloadCollectionDataResponse]) error {
+	ctx := stream.Context()
+
+	dbCollection, _, err := s.accessibleCollection(ctx, req.CollectionUid)
+	if err != nil {
+		return err
+	}
+
+	if req.Offset < 0 || req.Length < 0 {
+		return status.Error(codes.InvalidArgument, "offset and length must not be negative")
+	}
+
+	err = withSnapshot(ctx, s.pool, func(q *db.Queries) error {
+		payload, err := q.GetCollectionPayload(ctx, dbCollection.ID)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return status.Error(codes.NotFound, "collection has no payload")
+		}
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to get collection payload: %v", err)
+		}
+
+		if req.Offset > payload.Size {
+			return status.Errorf(codes.OutOfRange, "offset %d is past the end of the %d byte payload", req.Offset, payload.Size)
+		}
+		end := payload.Size
+		if req.Length > 0 && req.Offset+req.Length < end {
+			end = req.Offset + req.Length
+		}
+
+		resp := &censysv1.DownloadCollectionDataResponse{
+			Payload: dbPayloadToProto(req.CollectionUid, payload),
+			Offset:  req.Offset,
+		}
+		chunkSize := int64(payload.ChunkSize)
+		for pos := req.Offset; pos < end; {
+			seq := pos / chunkSize
+			data, err := q.GetCollectionPayloadChunk(ctx, db.GetCollectionPayloadChunkParams{
+				CollectionID: dbCollection.ID,
+				Seq:          int32(seq),
+			})
+			if err != nil {
+				return status.Errorf(codes.Internal, "failed to read payload chunk: %v", err)
+			}
+
+			start := pos - seq*chunkSize
+			stop := min(int64(len(data)), end-seq*chunkSize)
+			resp.Offset = pos
+			resp.Data = data[start:stop]
+			if err := stream.Send(resp); err != nil {
+				return err
+			}
+
+			pos += stop - start
+			resp = &censysv1.DownloadCollectionDataResponse{}
+		}
+
+		// an empty range still describes the payload
+		if resp.Payload != nil {
+			return stream.Send(resp)
+		}
+		return nil
+	})
+	if err != nil {
+		return txStatus(err, "failed to download collection data")
+	}
+	return nil
+}
+
+func dbPayloadToProto(collectionUID string, p db.CollectionPayload) *censysv1.CollectionPayload {
+	return &censysv1.CollectionPayload{
+		CollectionUid: collectionUID,
+		ContentType:   p.ContentType,
+		Size:          p.Size,
+		Sha256:        p.Sha256,
+		UpdatedAt:     timestamppb.New(p.UpdatedAt.Time),
+	}
+}
@@ -0,0 +1,52 @@
+package server
+
+import (
+	"context"
+	"crypto/sha256"
+	"testing"
+
+	censysv1 "github.com/ajscimone/censys-challenge/gen/proto"
+	"github.com/ajscimone/censys-challenge/internal/db"
+)
+
+func TestDeleteUnreferencedBlobChunks_SkipsChunksOfUploadsInFlight(t *testing.T) {
+	env := newTestEnv(t)
+	_, userCtx := env.newUser(t)
+	ctx := context.Background()
+
+	collection, err := env.collections.CreateCollection(userCtx, &censysv1.CreateCollectionRequest{Name: "payload"})
+	if err != nil {
+		t.Fatalf("failed to create collection: %v", err)
+	}
+
+	data := []byte(uniqueName(t))
+	sum := sha256.Sum256(data)
+
+	// an upload that has stored the chunk but not finished yet
+	uploadID, err := env.queries.CreatePayloadUpload(ctx, env.collectionByUID(t, collection.Uid).ID)
+	if err != nil {
+		t.Fatalf("failed to start upload: %v", err)
+	}
+	if err := env.queries.StageBlobChunk(ctx, db.StageBlobChunkParams{UploadID: uploadID, Hash: sum[:], Data: data}); err != nil {
+		t.Fatalf("failed to stage chunk: %v", err)
+	}
+
+	removed, err := env.queries.DeleteUnreferencedBlobChunks(ctx, [][]byte{sum[:]})
+	if err != nil {
+		t.Fatalf("failed to delete chunks: %v", err)
+	}
+	if removed != 0 {
+		t.Fatal("a chunk an upload has stored should not be removed")
+	}
+
+	if _, err := env.queries.DeletePayloadUpload(ctx, uploadID); err != nil {
+		t.Fatalf("failed to abandon upload: %v", err)
+	}
+	removed, err = env.queries.DeleteUnreferencedBlobChunks(ctx, [][]byte{sum[:]})
+	if err != nil {
+		t.Fatalf("failed to delete chunks: %v", err)
+	}
+	if removed != 1 {
+		t.Fatalf("the chunk should be removed once the upload is gone, removed %d", removed)
+	}
+}
@@ -0,0 +1,197 @@
+package server
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ajscimone/censys-challenge/gen/proto"
+	"github.com/ajscimone/censys-challenge/internal/db"
+	"github.com/ajscimone/censys-challenge/internal/middleware"
+	"github.com/jackc/pgx/v5/pgtype"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// QuotaConfig caps what a single user or organization can store. Zero disables a limit.
+// Checks read current usage before writing, so concurrent writes can overshoot a limit slightly.
+type QuotaConfig struct {
+	MaxDataBytes                   int64
+	MaxCollectionsPerUser          int64
+	MaxCollectionsPerOrganization  int64
+	MaxShareTokensPerUser          int64
+	MaxShareTokensPerOrganization  int64
+	MaxStorageBytesPerUser         int64
+	MaxStorageBytesPerOrganization int64
+	MaxPayloadBytes                int64
+}
+
+type quotaAmounts struct {
+	collections  int64
+	storageBytes int64
+	shareTokens  int64
+}
+
+func (a quotaAmounts) isZero() bool {
+	return a.collections <= 0 && a.storageBytes <= 0 && a.shareTokens <= 0
+}
+
+func (c QuotaConfig) userLimits() quotaAmounts {
+	return quotaAmounts{
+		collections:  c.MaxCollectionsPerUser,
+		storageBytes: c.MaxStorageBytesPerUser,
+		shareTokens:  c.MaxShareTokensPerUser,
+	}
+}
+
+func (c QuotaConfig) organizationLimits() quotaAmounts {
+	return quotaAmounts{
+		collections:  c.MaxCollectionsPerOrganization,
+		storageBytes: c.MaxStorageBytesPerOrganization,
+		shareTokens:  c.MaxShareTokensPerOrganization,
+	}
+}
+
+// overQuota lists every limit that adding delta to usage would exceed.
+func overQuota(subject string, usage, delta, limits quotaAmounts) []*errdetails.QuotaFailure_Violation {
+	var violations []*errdetails.QuotaFailure_Violation
+	check := func(name string, used, add, max int64) {
+		if max > 0 && add > 0 && used+add > max {
+			violations = append(violations, &errdetails.QuotaFailure_Violation{
+				Subject:     subject,
+				Description: fmt.Sprintf("%s limit of %d exceeded, %d in use", name, max, used),
+			})
+		}
+	}
+
+	check("collection", usage.collections, delta.collections, limits.collections)
+	check("storage bytes", usage.storageBytes, delta.storageBytes, limits.storageBytes)
+	check("share token", usage.shareTokens, delta.shareTokens, limits.shareTokens)
+	return violations
+}
+
+func quotaError(violations []*errdetails.QuotaFailure_Violation) error {
+	st, err := status.New(codes.ResourceExhausted, "quota exceeded").WithDetails(&errdetails.QuotaFailure{Violations: violations})
+	if err != nil {
+		return status.Error(codes.ResourceExhausted, "quota exceeded")
+	}
+	return st.Err()
+}
+
+// dataSize measures data written by the server the way usage counts it, as the text Postgres keeps for the
+// jsonb value, which differs from the JSON it was marshalled as. Data read back from Postgres is already in
+// that form so its length can be used as is.
+func dataSize(ctx context.Context, q *db.Queries, data []byte) (int64, error) {
+	size, err := q.GetDataSize(ctx, data)
+	if err != nil {
+		return 0, status.Errorf(codes.InvalidArgument, "invalid data: %v", err)
+	}
+	return size, nil
+}
+
+func (s *CollectionServer) checkDataSize(size int64) error {
+	limit := s.config.Quotas.MaxDataBytes
+	if limit <= 0 || size <= limit {
+		return nil
+	}
+
+	return quotaError([]*errdetails.QuotaFailure_Violation{{
+		Subject:     "collection",
+		Description: fmt.Sprintf("data is %d bytes, the limit is %d", size, limit),
+	}})
+}
+
+// checkQuota fails with ResourceExhausted if adding userDelta to the user's usage or orgDelta to the
+// organization's usage would go over a configured limit. Invalid ids skip that side of the check.
+func (s *CollectionServer) checkQuota(ctx context.Context, q *db.Queries, userID pgtype.Int4, userDelta quotaAmounts, orgID pgtype.Int4, orgDelta quotaAmounts) error {
+	var violations []*errdetails.QuotaFailure_Violation
+
+	if userID.Valid && !userDelta.isZero() {
+		usage, err := q.GetUserUsage(ctx, userID.Int32)
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to check quota: %v", err)
+		}
+		violations = append(violations, overQuota("user", userUsageAmounts(usage), userDelta, s.config.Quotas.userLimits())...)
+	}
+
+	if orgID.Valid && !orgDelta.isZero() {
+		usage, err := q.GetOrganizationUsage(ctx, orgID.Int32)
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to check quota: %v", err)
+		}
+		violations = append(violations, overQuota("organization", organizationUsageAmounts(usage), orgDelta, s.config.Quotas.organizationLimits())...)
+	}
+
+	if len(violations) > 0 {
+		return quotaError(violations)
+	}
+	return nil
+}
+
+// checkWriteQuota covers replacing a collection's data and possibly moving it to another organization.
+func (s *CollectionServer) checkWriteQuota(ctx context.Context, q *db.Queries, locked db.Collection, params db.UpdateCollectionParams) error {
+	size, err := dataSize(ctx, q, params.Data)
+	if err != nil {
+		return err
+	}
+	if err := s.checkDataSize(size); err != nil {
+		return err
+	}
+
+	growth := size - int64(len(locked.Data))
+	userDelta := quotaAmounts{storageBytes: growth}
+
+	orgDelta := quotaAmounts{storageBytes: growth}
+	if params.OrganizationID != locked.OrganizationID {
+		orgDelta = quotaAmounts{collections: 1, storageBytes: size}
+	}
+
+	return s.checkQuota(ctx, q, locked.OwnerID, userDelta, params.OrganizationID, orgDelta)
+}
+
+func (s *CollectionServer) GetQuotaUsage(ctx context.Context, req *censysv1.GetQuotaUsageRequest) (*censysv1.QuotaUsage, error) {
+	userID, err := middleware.UserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "authentication required")
+	}
+
+	var usage, limits quotaAmounts
+	if req.OrganizationUid != "" {
+		org, err := s.memberOrganization(ctx, userID, req.OrganizationUid)
+		if err != nil {
+			return nil, err
+		}
+
+		orgUsage, err := s.queries.GetOrganizationUsage(ctx, org.ID)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get usage: %v", err)
+		}
+		usage, limits = organizationUsageAmounts(orgUsage), s.config.Quotas.organizationLimits()
+	} else {
+		userUsage, err := s.queries.GetUserUsage(ctx, userID)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get usage: %v", err)
+		}
+		usage, limits = userUsageAmounts(userUsage), s.config.Quotas.userLimits()
+	}
+
+	return &censysv1.QuotaUsage{
+		OrganizationUid: req.OrganizationUid,
+		Collections:     usage.collections,
+		MaxCollections:  limits.collections,
+		ShareTokens:     usage.shareTokens,
+		MaxShareTokens:  limits.shareTokens,
+		StorageBytes:    usage.storageBytes,
+		MaxStorageBytes: limits.storageBytes,
+		MaxDataBytes:    s.config.Quotas.MaxDataBytes,
+		MaxPayloadBytes: s.config.Quotas.MaxPayloadBytes,
+	}, nil
+}
+
+func userUsageAmounts(u db.GetUserUsageRow) quotaAmounts {
+	return quotaAmounts{collections: u.Collections, storageBytes: u.StorageBytes, shareTokens: u.ShareTokens}
+}
+
+func organizationUsageAmounts(u db.GetOrganizationUsageRow) quotaAmounts {
+	return quotaAmounts{collections: u.Collections, storageBytes: u.StorageBytes, shareTokens: u.ShareTokens}
+}
@@ -0,0 +1,84 @@
+package server
+
+import (
+	"slices"
+	"testing"
+
+	censysv1 "github.com/ajscimone/censys-challenge/gen/proto"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func TestCreateCollection_MeasuresDataLikeUsage(t *testing.T) {
+	env := newTestEnv(t)
+	_, ctx := env.newUser(t)
+
+	// jsonb adds a space after every colon and comma, so its text is longer than what protojson sends
+	data, err := structpb.NewStruct(map[string]any{"a": 1, "b": []any{1, 2, 3}, "c": map[string]any{"d": "e"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	sent, err := data.MarshalJSON()
+	if err != nil {
+		t.Fatal(err)
+	}
+	size, err := dataSize(ctx, env.queries, sent)
+	if err != nil {
+		t.Fatalf("failed to measure data: %v", err)
+	}
+
+	// exactly the measured size fits, which the length of what was sent would not tell
+	env.collections.config.Quotas = QuotaConfig{MaxDataBytes: size, MaxStorageBytesPerUser: size}
+	if _, err := env.collections.CreateCollection(ctx, &censysv1.CreateCollectionRequest{
+		Name:        "measured",
+		Data:        data,
+		AccessLevel: censysv1.AccessLevel_ACCESS_LEVEL_PRIVATE,
+	}); err != nil {
+		t.Fatalf("data of exactly the limit should fit: %v", err)
+	}
+
+	usage, err := env.collections.GetQuotaUsage(ctx, &censysv1.GetQuotaUsageRequest{})
+	if err != nil {
+		t.Fatalf("failed to read usage: %v", err)
+	}
+	if usage.StorageBytes != size {
+		t.Fatalf("usage should count %d bytes like the write check did, got %d (%d sent)", size, usage.StorageBytes, len(sent))
+	}
+}
+
+func TestOverQuota(t *testing.T) {
+	limits := quotaAmounts{collections: 10, storageBytes: 1000, shareTokens: 5}
+
+	for _, tc := range []struct {
+		name         string
+		usage, delta quotaAmounts
+		limits       quotaAmounts
+		want         []string
+	}{
+		{name: "within every limit", usage: quotaAmounts{collections: 9, storageBytes: 900}, delta: quotaAmounts{collections: 1, storageBytes: 100}, limits: limits},
+		{name: "one over", usage: quotaAmounts{collections: 10}, delta: quotaAmounts{collections: 1}, limits: limits, want: []string{"collection limit of 10 exceeded, 10 in use"}},
+		{
+			name:   "several over",
+			usage:  quotaAmounts{storageBytes: 990, shareTokens: 5},
+			delta:  quotaAmounts{storageBytes: 11, shareTokens: 1},
+			limits: limits,
+			want:   []string{"storage bytes limit of 1000 exceeded, 990 in use", "share token limit of 5 exceeded, 5 in use"},
+		},
+		// shrinking is allowed even while over a limit that was lowered
+		{name: "already over and shrinking", usage: quotaAmounts{storageBytes: 2000}, delta: quotaAmounts{storageBytes: -10}, limits: limits},
+		{name: "already over and not growing", usage: quotaAmounts{collections: 20}, delta: quotaAmounts{storageBytes: 10}, limits: limits},
+		{name: "unlimited", usage: quotaAmounts{collections: 1 << 40}, delta: quotaAmounts{collections: 1}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			var got []string
+			for _, violation := range overQuota("user", tc.usage, tc.delta, tc.limits) {
+				if violation.Subject != "user" {
+					t.Errorf("expected subject user, got %q", violation.Subject)
+				}
+				got = append(got, violation.Description)
+			}
+			if !slices.Equal(got, tc.want) {
+				t.Fatalf("expected %q, got %q", tc.want, got)
+			}
+		})
+	}
+}
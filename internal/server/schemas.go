@@ -0,0 +1,214 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	"github.com/ajscimone/censys-challenge/gen/proto"
+	"github.com/ajscimone/censys-challenge/internal/db"
+	"github.com/ajscimone/censys-challenge/internal/middleware"
+	"github.com/ajscimone/censys-challenge/internal/schema"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// RegisterCollectionSchema and DeleteCollectionSchema need an organization admin, every member can list schemas
+// so they know what their writes are validated against.
+func (s *CollectionServer) RegisterCollectionSchema(ctx context.Context, req *censysv1.RegisterCollectionSchemaRequest) (*censysv1.CollectionSchema, error) {
+	if req.OrganizationUid == "" {
+		return nil, status.Error(codes.InvalidArgument, "organization_uid is required")
+	}
+	if req.Type == "" {
+		return nil, status.Error(codes.InvalidArgument, "type is required")
+	}
+	if req.Schema == nil {
+		return nil, status.Error(codes.InvalidArgument, "schema is required")
+	}
+
+	userID, err := middleware.UserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "authentication required")
+	}
+
+	org, err := s.memberOrganization(ctx, userID, req.OrganizationUid)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.requireOrganizationAdmin(ctx, userID, org.ID); err != nil {
+		return nil, err
+	}
+
+	schemaBytes, err := req.Schema.MarshalJSON()
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid schema: %v", err)
+	}
+
+	// compile up front so a broken schema cannot block every write of that type later
+	if _, err := schema.Compile(schemaBytes); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	dbSchema, err := s.queries.UpsertCollectionSchema(ctx, db.UpsertCollectionSchemaParams{
+		OrganizationID: org.ID,
+		Type:           req.Type,
+		Schema:         schemaBytes,
+		CreatedBy:      pgtype.Int4{Int32: userID, Valid: true},
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to register schema: %v", err)
+	}
+
+	return dbCollectionSchemaToProto(dbSchema, org)
+}
+
+func (s *CollectionServer) ListCollectionSchemas(ctx context.Context, req *censysv1.ListCollectionSchemasRequest) (*censysv1.ListCollectionSchemasResponse, error) {
+	if req.OrganizationUid == "" {
+		return nil, status.Error(codes.InvalidArgument, "organization_uid is required")
+	}
+
+	userID, err := middleware.UserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "authentication required")
+	}
+
+	org, err := s.memberOrganization(ctx, userID, req.OrganizationUid)
+	if err != nil {
+		return nil, err
+	}
+
+	dbSchemas, err := s.queries.ListCollectionSchemas(ctx, org.ID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list schemas: %v", err)
+	}
+
+	resp := &censysv1.ListCollectionSchemasResponse{}
+	for _, dbSchema := range dbSchemas {
+		protoSchema, err := dbCollectionSchemaToProto(dbSchema, org)
+		if err != nil {
+			return nil, err
+		}
+		resp.Schemas = append(resp.Schemas, protoSchema)
+	}
+
+	return resp, nil
+}
+
+func (s *CollectionServer) DeleteCollectionSchema(ctx context.Context, req *censysv1.DeleteCollectionSchemaRequest) (*emptypb.Empty, error) {
+	if req.OrganizationUid == "" {
+		return nil, status.Error(codes.InvalidArgument, "organization_uid is required")
+	}
+	if req.Type == "" {
+		return nil, status.Error(codes.InvalidArgument, "type is required")
+	}
+
+	userID, err := middleware.UserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "authentication required")
+	}
+
+	org, err := s.memberOrganization(ctx, userID, req.OrganizationUid)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.requireOrganizationAdmin(ctx, userID, org.ID); err != nil {
+		return nil, err
+	}
+
+	deleted, err := s.queries.DeleteCollectionSchema(ctx, db.DeleteCollectionSchemaParams{
+		OrganizationID: org.ID,
+		Type:           req.Type,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete schema: %v", err)
+	}
+	if deleted == 0 {
+		return nil, status.Error(codes.NotFound, "schema not found")
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// validateData checks data against the schema the collection's organization registered for data.type.
+// Collections without an organization, or whose data has no string type, are not validated.
+func validateData(ctx context.Context, q *db.Queries, orgID pgtype.Int4, data []byte) error {
+	if !orgID.Valid {
+		return nil
+	}
+
+	var typed struct {
+		Type interface{} `json:"type"`
+	}
+	if err := json.Unmarshal(data, &typed); err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid data: %v", err)
+	}
+	dataType, ok := typed.Type.(string)
+	if !ok || dataType == "" {
+		return nil
+	}
+
+	dbSchema, err := q.GetCollectionSchema(ctx, db.GetCollectionSchemaParams{
+		OrganizationID: orgID.Int32,
+		Type:           dataType,
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		// no schema registered for this type
+		return nil
+	}
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to load schema: %v", err)
+	}
+
+	compiled, err := schema.Compile(dbSchema.Schema)
+	if err != nil {
+		return status.Errorf(codes.Internal, "stored schema for %q is invalid: %v", dataType, err)
+	}
+
+	violations, err := compiled.Validate(data)
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid data: %v", err)
+	}
+	if len(violations) == 0 {
+		return nil
+	}
+
+	badRequest := &errdetails.BadRequest{}
+	for _, v := range violations {
+		badRequest.FieldViolations = append(badRequest.FieldViolations, &errdetails.BadRequest_FieldViolation{
+			Field:       v.Field,
+			Description: v.Description,
+		})
+	}
+
+	st, err := status.Newf(codes.InvalidArgument, "data does not match the %q schema", dataType).WithDetails(badRequest)
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "data does not match the %q schema", dataType)
+	}
+	return st.Err()
+}
+
+func dbCollectionSchemaToProto(s db.CollectionSchema, org db.Organization) (*censysv1.CollectionSchema, error) {
+	orgUIDBytes, err := org.Uid.MarshalJSON()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to marshal organization uid: %v", err)
+	}
+
+	var schemaStruct structpb.Struct
+	if err := json.Unmarshal(s.Schema, &schemaStruct); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to unmarshal schema: %v", err)
+	}
+
+	return &censysv1.CollectionSchema{
+		OrganizationUid: string(orgUIDBytes[1 : len(orgUIDBytes)-1]),
+		Type:            s.Type,
+		Schema:          &schemaStruct,
+		CreatedAt:       timestamppb.New(s.CreatedAt.Time),
+		UpdatedAt:       timestamppb.New(s.UpdatedAt.Time),
+	}, nil
+}
@@ -0,0 +1,43 @@
+package server
+
+import (
+	"testing"
+
+	censysv1 "github.com/ajscimone/censys-challenge/gen/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func TestCollectionSchemas_NeedOrganizationAdmin(t *testing.T) {
+	env := newTestEnv(t)
+	admin, adminCtx := env.newUser(t)
+	member, memberCtx := env.newUser(t)
+	org := env.newOrganization(t, admin, member)
+
+	schema, err := structpb.NewStruct(map[string]any{"type": "object"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	register := &censysv1.RegisterCollectionSchemaRequest{OrganizationUid: org.Uid.String(), Type: "host", Schema: schema}
+	remove := &censysv1.DeleteCollectionSchemaRequest{OrganizationUid: org.Uid.String(), Type: "host"}
+
+	if _, err := env.collections.RegisterCollectionSchema(memberCtx, register); status.Code(err) != codes.PermissionDenied {
+		t.Fatalf("a member should not register schemas, got %v", err)
+	}
+	if _, err := env.collections.RegisterCollectionSchema(adminCtx, register); err != nil {
+		t.Fatalf("an admin should register schemas: %v", err)
+	}
+
+	listed, err := env.collections.ListCollectionSchemas(memberCtx, &censysv1.ListCollectionSchemasRequest{OrganizationUid: org.Uid.String()})
+	if err != nil || len(listed.Schemas) != 1 {
+		t.Fatalf("members should see the registered schema, got %v %v", listed, err)
+	}
+
+	if _, err := env.collections.DeleteCollectionSchema(memberCtx, remove); status.Code(err) != codes.PermissionDenied {
+		t.Fatalf("a member should not delete schemas, got %v", err)
+	}
+	if _, err := env.collections.DeleteCollectionSchema(adminCtx, remove); err != nil {
+		t.Fatalf("an admin should delete schemas: %v", err)
+	}
+}
@@ -0,0 +1,122 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/ajscimone/censys-challenge/gen/proto"
+	"github.com/ajscimone/censys-challenge/internal/db"
+	"github.com/ajscimone/censys-challenge/internal/middleware"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *CollectionServer) SearchCollections(ctx context.Context, req *censysv1.SearchCollectionsRequest) (*censysv1.SearchCollectionsResponse, error) {
+	userID, err := middleware.UserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "authentication required")
+	}
+
+	pageSize := clampPageSize(req.PageSize)
+	before, err := parseIDPageToken(req.PageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	dataContains, err := filtersToContainment(req.Filters)
+	if err != nil {
+		return nil, err
+	}
+
+	labelFilter, err := parseLabelSelector(req.LabelSelector)
+	if err != nil {
+		return nil, err
+	}
+
+	matches, err := s.queries.SearchCollectionsForUser(ctx, db.SearchCollectionsForUserParams{
+		UserID:         pgtype.Int4{Int32: userID, Valid: true},
+		Query:          pgtype.Text{String: req.Query, Valid: strings.TrimSpace(req.Query) != ""},
+		DataContains:   dataContains,
+		DataPath:       pgtype.Text{String: req.DataPath, Valid: req.DataPath != ""},
+		LabelEquals:    labelFilter.equals,
+		LabelNotEquals: labelFilter.notEquals,
+		LabelExists:    labelFilter.exists,
+		LabelMissing:   labelFilter.missing,
+		BeforeID:       before,
+		PageSize:       pageSize,
+	})
+	if err != nil {
+		var pgErr *pgconn.PgError
+		// class 22 is a data exception and 42601 a syntax error, both come from a bad data_path
+		if errors.As(err, &pgErr) && (strings.HasPrefix(pgErr.Code, "22") || pgErr.Code == "42601") {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid search: %s", pgErr.Message)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to search collections: %v", err)
+	}
+
+	resp := &censysv1.SearchCollectionsResponse{}
+	for _, c := range matches {
+		protoCollection, err := dbCollectionToProto(c)
+		if err != nil {
+			return nil, err
+		}
+		resp.Collections = append(resp.Collections, protoCollection)
+	}
+	if len(matches) == int(pageSize) {
+		resp.NextPageToken = strconv.FormatInt(int64(matches[len(matches)-1].ID), 10)
+	}
+
+	return resp, nil
+}
+
+// filtersToContainment folds equality filters into one JSON document for a @> containment check,
+// so {path: "query.limit", value: 10} becomes {"query": {"limit": 10}}.
+func filtersToContainment(filters []*censysv1.DataFilter) ([]byte, error) {
+	if len(filters) == 0 {
+		return nil, nil
+	}
+
+	doc := map[string]interface{}{}
+	for _, filter := range filters {
+		if filter.Path == "" {
+			return nil, status.Error(codes.InvalidArgument, "filter path is required")
+		}
+		if filter.Value == nil {
+			return nil, status.Errorf(codes.InvalidArgument, "filter %q needs a value", filter.Path)
+		}
+
+		keys := strings.Split(filter.Path, ".")
+		node := doc
+		for _, key := range keys[:len(keys)-1] {
+			child, exists := node[key]
+			if !exists {
+				next := map[string]interface{}{}
+				node[key] = next
+				node = next
+				continue
+			}
+			next, ok := child.(map[string]interface{})
+			if !ok {
+				return nil, status.Errorf(codes.InvalidArgument, "filter %q conflicts with another filter", filter.Path)
+			}
+			node = next
+		}
+
+		last := keys[len(keys)-1]
+		if _, exists := node[last]; exists {
+			return nil, status.Errorf(codes.InvalidArgument, "filter %q conflicts with another filter", filter.Path)
+		}
+		node[last] = filter.Value.AsInterface()
+	}
+
+	containment, err := json.Marshal(doc)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid filters: %v", err)
+	}
+	return containment, nil
+}
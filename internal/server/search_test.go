@@ -0,0 +1,95 @@
+package server
+
+import (
+	"slices"
+	"testing"
+
+	censysv1 "github.com/ajscimone/censys-challenge/gen/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func TestSearchCollections_LabelSelector(t *testing.T) {
+	env := newTestEnv(t)
+	_, ctx := env.newUser(t)
+
+	for name, labels := range map[string]map[string]string{
+		"prod-infra":  {"env": "prod", "team": "infra"},
+		"prod-search": {"env": "prod", "team": "search"},
+		"prod":        {"env": "prod"},
+		"dev":         {"env": "dev"},
+	} {
+		if _, err := env.collections.CreateCollection(ctx, &censysv1.CreateCollectionRequest{
+			Name:        name,
+			AccessLevel: censysv1.AccessLevel_ACCESS_LEVEL_PRIVATE,
+			Labels:      labels,
+		}); err != nil {
+			t.Fatalf("failed to create %s: %v", name, err)
+		}
+	}
+
+	tests := map[string][]string{
+		// a collection without the key satisfies !=
+		"env=prod,team!=infra": {"prod", "prod-search"},
+		"team!=infra":          {"dev", "prod", "prod-search"},
+		"!team":                {"dev", "prod"},
+		"env,!team":            {"dev", "prod"},
+		"team,env!=prod":       nil,
+	}
+	for selector, want := range tests {
+		resp, err := env.collections.SearchCollections(ctx, &censysv1.SearchCollectionsRequest{LabelSelector: selector})
+		if err != nil {
+			t.Fatalf("%s: search failed: %v", selector, err)
+		}
+		var got []string
+		for _, collection := range resp.Collections {
+			got = append(got, collection.Name)
+		}
+		slices.Sort(got)
+		if !slices.Equal(got, want) {
+			t.Errorf("%s: expected %v, got %v", selector, want, got)
+		}
+	}
+}
+
+func TestFiltersToContainment(t *testing.T) {
+	filter := func(path string, value any) *censysv1.DataFilter {
+		v, err := structpb.NewValue(value)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return &censysv1.DataFilter{Path: path, Value: v}
+	}
+
+	for _, tc := range []struct {
+		name    string
+		filters []*censysv1.DataFilter
+		want    string
+		code    codes.Code
+	}{
+		{name: "no filters"},
+		{name: "top level", filters: []*censysv1.DataFilter{filter("type", "host")}, want: `{"type":"host"}`},
+		{
+			name:    "nested paths share parents",
+			filters: []*censysv1.DataFilter{filter("query.limit", 10), filter("query.text", "ssh")},
+			want:    `{"query":{"limit":10,"text":"ssh"}}`,
+		},
+		{name: "object value", filters: []*censysv1.DataFilter{filter("a", map[string]any{"b": true})}, want: `{"a":{"b":true}}`},
+		{name: "missing path", filters: []*censysv1.DataFilter{filter("", 1)}, code: codes.InvalidArgument},
+		{name: "missing value", filters: []*censysv1.DataFilter{{Path: "a"}}, code: codes.InvalidArgument},
+		{name: "same path twice", filters: []*censysv1.DataFilter{filter("a", 1), filter("a", 2)}, code: codes.InvalidArgument},
+		{name: "path below a value", filters: []*censysv1.DataFilter{filter("a", 1), filter("a.b", 2)}, code: codes.InvalidArgument},
+		{name: "value above a path", filters: []*censysv1.DataFilter{filter("a.b", 1), filter("a", 2)}, code: codes.InvalidArgument},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := filtersToContainment(tc.filters)
+			if status.Code(err) != tc.code {
+				t.Fatalf("expected %v, got %v", tc.code, err)
+			}
+			if string(got) != tc.want {
+				t.Fatalf("expected %s, got %s", tc.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,104 @@
+package server
+
+import (
+	"context"
+	"testing"
+
+	censysv1 "github.com/ajscimone/censys-challenge/gen/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+func TestShareToken_OnlyServesSharedCollections(t *testing.T) {
+	env := newTestEnv(t)
+	_, ctx := env.newUser(t)
+
+	collection, err := env.collections.CreateCollection(ctx, &censysv1.CreateCollectionRequest{
+		Name:        "not yet shared",
+		AccessLevel: censysv1.AccessLevel_ACCESS_LEVEL_PRIVATE,
+	})
+	if err != nil {
+		t.Fatalf("failed to create collection: %v", err)
+	}
+	shareToken, err := env.collections.CreateShareToken(ctx, &censysv1.CreateShareTokenRequest{CollectionUid: collection.Uid})
+	if err != nil {
+		t.Fatalf("failed to create share token: %v", err)
+	}
+	setAccessLevel := func(level censysv1.AccessLevel) {
+		t.Helper()
+		if _, err := env.collections.UpdateCollection(ctx, &censysv1.UpdateCollectionRequest{
+			Uid:         collection.Uid,
+			AccessLevel: level,
+			UpdateMask:  &fieldmaskpb.FieldMask{Paths: []string{"access_level"}},
+		}); err != nil {
+			t.Fatalf("failed to change access level: %v", err)
+		}
+	}
+	get := func() (*censysv1.SharedCollectionResponse, error) {
+		return env.collections.GetSharedCollection(context.Background(), &censysv1.GetSharedCollectionRequest{Token: shareToken.Token})
+	}
+
+	if _, err := get(); status.Code(err) != codes.PermissionDenied {
+		t.Fatalf("a token on a private collection should be refused, got %v", err)
+	}
+	if _, err := env.queries.GetSharedCollectionRevision(context.Background(), shareToken.Token); err == nil {
+		t.Fatal("revalidation should apply the same rule")
+	}
+
+	setAccessLevel(censysv1.AccessLevel_ACCESS_LEVEL_SHARED)
+	resp, err := get()
+	if err != nil {
+		t.Fatalf("the token should work once the collection is shared: %v", err)
+	}
+	if resp.AccessCount != 1 {
+		t.Fatalf("refused reads should not be counted, got %d", resp.AccessCount)
+	}
+
+	setAccessLevel(censysv1.AccessLevel_ACCESS_LEVEL_PRIVATE)
+	if _, err := get(); status.Code(err) != codes.PermissionDenied {
+		t.Fatalf("the token should stop working once the collection is private again, got %v", err)
+	}
+}
+
+func TestGetSharedCollection_RevalidationIsNotCounted(t *testing.T) {
+	env := newTestEnv(t)
+	_, ctx := env.newUser(t)
+
+	collection, err := env.collections.CreateCollection(ctx, &censysv1.CreateCollectionRequest{
+		Name:        "cached",
+		AccessLevel: censysv1.AccessLevel_ACCESS_LEVEL_SHARED,
+	})
+	if err != nil {
+		t.Fatalf("failed to create collection: %v", err)
+	}
+	shareToken, err := env.collections.CreateShareToken(ctx, &censysv1.CreateShareTokenRequest{CollectionUid: collection.Uid})
+	if err != nil {
+		t.Fatalf("failed to create share token: %v", err)
+	}
+
+	first, err := env.collections.GetSharedCollection(context.Background(), &censysv1.GetSharedCollectionRequest{Token: shareToken.Token})
+	if err != nil {
+		t.Fatalf("failed to get shared collection: %v", err)
+	}
+	for i := 0; i < 3; i++ {
+		resp, err := env.collections.GetSharedCollection(context.Background(), &censysv1.GetSharedCollectionRequest{
+			Token:       shareToken.Token,
+			IfNoneMatch: `"` + first.Etag + `"`,
+		})
+		if err != nil {
+			t.Fatalf("failed to revalidate: %v", err)
+		}
+		if !resp.NotModified {
+			t.Fatal("a matching etag should be answered with not modified")
+		}
+	}
+
+	link, err := env.queries.GetShareLinkByToken(context.Background(), shareToken.Token)
+	if err != nil {
+		t.Fatalf("failed to read share link: %v", err)
+	}
+	if link.AccessCount != first.AccessCount {
+		t.Fatalf("revalidations should not be counted, expected %d accesses, got %d", first.AccessCount, link.AccessCount)
+	}
+}
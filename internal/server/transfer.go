@@ -0,0 +1,336 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ajscimone/censys-challenge/gen/proto"
+	"github.com/ajscimone/censys-challenge/internal/db"
+	"github.com/ajscimone/censys-challenge/internal/middleware"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *CollectionServer) TransferCollection(ctx context.Context, req *censysv1.TransferCollectionRequest) (*censysv1.CollectionTransfer, error) {
+	if (req.ToUserUid == "") == (req.ToOrganizationUid == "") {
+		return nil, status.Error(codes.InvalidArgument, "exactly one of to_user_uid and to_organization_uid is required")
+	}
+
+	dbCollection, userID, err := s.accessibleCollection(ctx, req.CollectionUid)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.requireTransferRights(ctx, dbCollection, userID); err != nil {
+		return nil, err
+	}
+
+	params := db.CreateCollectionTransferParams{
+		CollectionID: dbCollection.ID,
+		RequestedBy:  pgtype.Int4{Int32: userID, Valid: true},
+	}
+	details := map[string]string{}
+
+	if req.ToUserUid != "" {
+		var userUUID pgtype.UUID
+		if err := userUUID.Scan(req.ToUserUid); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid to_user_uid: %v", err)
+		}
+		user, err := s.queries.GetUserByUID(ctx, userUUID)
+		if err != nil {
+			return nil, status.Errorf(codes.NotFound, "user not found: %v", err)
+		}
+		if dbCollection.OwnerID.Valid && dbCollection.OwnerID.Int32 == user.ID {
+			return nil, status.Error(codes.InvalidArgument, "user already owns the collection")
+		}
+		params.ToUserID = pgtype.Int4{Int32: user.ID, Valid: true}
+		details["to_user_uid"] = req.ToUserUid
+	} else {
+		var orgUUID pgtype.UUID
+		if err := orgUUID.Scan(req.ToOrganizationUid); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid to_organization_uid: %v", err)
+		}
+		org, err := s.queries.GetOrganizationByUID(ctx, orgUUID)
+		if err != nil {
+			return nil, status.Errorf(codes.NotFound, "organization not found: %v", err)
+		}
+		if !dbCollection.OwnerID.Valid && dbCollection.OrganizationID.Valid && dbCollection.OrganizationID.Int32 == org.ID {
+			return nil, status.Error(codes.InvalidArgument, "organization already owns the collection")
+		}
+		params.ToOrganizationID = pgtype.Int4{Int32: org.ID, Valid: true}
+		details["to_organization_uid"] = req.ToOrganizationUid
+	}
+
+	var transfer db.CollectionTransfer
+	err = withTx(ctx, s.pool, func(q *db.Queries) error {
+		var err error
+		transfer, err = q.CreateCollectionTransfer(ctx, params)
+		if err != nil {
+			var pgErr *pgconn.PgError
+			if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+				return status.Error(codes.FailedPrecondition, "collection already has a pending transfer")
+			}
+			return status.Errorf(codes.Internal, "failed to create transfer: %v", err)
+		}
+
+		return recordAuditEvent(ctx, q, userID, "collection.transfer_requested", dbCollection.Uid, details)
+	})
+	if err != nil {
+		return nil, txStatus(err, "failed to create transfer")
+	}
+
+	return s.dbTransferToProto(ctx, transfer)
+}
+
+func (s *CollectionServer) AcceptCollectionTransfer(ctx context.Context, req *censysv1.AcceptCollectionTransferRequest) (*censysv1.CollectionTransfer, error) {
+	var resolved db.CollectionTransfer
+	err := s.resolveTransfer(ctx, req.TransferUid, func(q *db.Queries, transfer db.CollectionTransfer, userID int32) error {
+		recipient, err := s.isTransferRecipient(ctx, transfer, userID)
+		if err != nil {
+			return err
+		}
+		if !recipient {
+			return status.Error(codes.PermissionDenied, "only the receiving user or an admin of the receiving organization can accept a transfer")
+		}
+
+		locked, err := q.GetCollectionByIDForUpdate(ctx, transfer.CollectionID)
+		if err != nil {
+			return status.Errorf(codes.NotFound, "collection not found: %v", err)
+		}
+		// the requester may have lost the collection or their admin role since asking
+		if !transfer.RequestedBy.Valid {
+			return status.Error(codes.FailedPrecondition, "the requester no longer exists")
+		}
+		if err := s.requireTransferRights(ctx, locked, transfer.RequestedBy.Int32); err != nil {
+			if status.Code(err) == codes.PermissionDenied {
+				return status.Error(codes.FailedPrecondition, "the requester can no longer transfer the collection")
+			}
+			return err
+		}
+
+		params := db.TransferCollectionOwnershipParams{ID: locked.ID, AccessLevel: locked.AccessLevel}
+		if transfer.ToUserID.Valid {
+			// the new owner may not be in the old organization, so the collection becomes theirs alone
+			params.OwnerID = transfer.ToUserID
+			if params.AccessLevel == db.AccessLevelOrganization {
+				params.AccessLevel = db.AccessLevelPrivate
+			}
+		} else {
+			params.OrganizationID = transfer.ToOrganizationID
+			params.AccessLevel = db.AccessLevelOrganization
+		}
+
+		added := quotaAmounts{collections: 1, storageBytes: int64(len(locked.Data))}
+		if err := s.checkQuota(ctx, q, params.OwnerID, added, params.OrganizationID, added); err != nil {
+			return err
+		}
+		if err := validateData(ctx, q, params.OrganizationID, locked.Data); err != nil {
+			return err
+		}
+
+		if _, err := q.TransferCollectionOwnership(ctx, params); err != nil {
+			return status.Errorf(codes.Internal, "failed to transfer collection: %v", err)
+		}
+
+		resolved, err = q.ResolveCollectionTransfer(ctx, db.ResolveCollectionTransferParams{
+			ID:         transfer.ID,
+			Status:     db.TransferStatusAccepted,
+			ResolvedBy: pgtype.Int4{Int32: userID, Valid: true},
+		})
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to accept transfer: %v", err)
+		}
+
+		return recordAuditEvent(ctx, q, userID, "collection.transfer_accepted", locked.Uid, transferAuditDetails(transfer, locked))
+	})
+	if err != nil {
+		return nil, txStatus(err, "failed to accept transfer")
+	}
+
+	return s.dbTransferToProto(ctx, resolved)
+}
+
+func (s *CollectionServer) DeclineCollectionTransfer(ctx context.Context, req *censysv1.DeclineCollectionTransferRequest) (*censysv1.CollectionTransfer, error) {
+	var resolved db.CollectionTransfer
+	err := s.resolveTransfer(ctx, req.TransferUid, func(q *db.Queries, transfer db.CollectionTransfer, userID int32) error {
+		requester := transfer.RequestedBy.Valid && transfer.RequestedBy.Int32 == userID
+		if !requester {
+			recipient, err := s.isTransferRecipient(ctx, transfer, userID)
+			if err != nil {
+				return err
+			}
+			if !recipient {
+				return status.Error(codes.PermissionDenied, "access denied")
+			}
+		}
+
+		var err error
+		resolved, err = q.ResolveCollectionTransfer(ctx, db.ResolveCollectionTransferParams{
+			ID:         transfer.ID,
+			Status:     db.TransferStatusDeclined,
+			ResolvedBy: pgtype.Int4{Int32: userID, Valid: true},
+		})
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to decline transfer: %v", err)
+		}
+
+		collectionUID, err := q.GetCollectionUIDByID(ctx, transfer.CollectionID)
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to load collection: %v", err)
+		}
+		return recordAuditEvent(ctx, q, userID, "collection.transfer_declined", collectionUID, nil)
+	})
+	if err != nil {
+		return nil, txStatus(err, "failed to decline transfer")
+	}
+
+	return s.dbTransferToProto(ctx, resolved)
+}
+
+func (s *CollectionServer) ListCollectionTransfers(ctx context.Context, req *censysv1.ListCollectionTransfersRequest) (*censysv1.ListCollectionTransfersResponse, error) {
+	userID, err := middleware.UserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "authentication required")
+	}
+
+	transfers, err := s.queries.ListPendingTransfersForUser(ctx, pgtype.Int4{Int32: userID, Valid: true})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list transfers: %v", err)
+	}
+
+	resp := &censysv1.ListCollectionTransfersResponse{}
+	for _, t := range transfers {
+		protoTransfer, err := s.dbTransferToProto(ctx, t)
+		if err != nil {
+			return nil, err
+		}
+		resp.Transfers = append(resp.Transfers, protoTransfer)
+	}
+
+	return resp, nil
+}
+
+// resolveTransfer locks a pending transfer and runs fn on it inside a transaction.
+func (s *CollectionServer) resolveTransfer(ctx context.Context, transferUID string, fn func(q *db.Queries, transfer db.CollectionTransfer, userID int32) error) error {
+	if transferUID == "" {
+		return status.Error(codes.InvalidArgument, "transfer_uid is required")
+	}
+
+	var transferUUID pgtype.UUID
+	if err := transferUUID.Scan(transferUID); err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid transfer_uid: %v", err)
+	}
+
+	userID, err := middleware.UserIDFromContext(ctx)
+	if err != nil {
+		return status.Error(codes.Unauthenticated, "authentication required")
+	}
+
+	return withTx(ctx, s.pool, func(q *db.Queries) error {
+		transfer, err := q.GetCollectionTransferByUIDForUpdate(ctx, transferUUID)
+		if err != nil {
+			return status.Errorf(codes.NotFound, "transfer not found: %v", err)
+		}
+		if transfer.Status != db.TransferStatusPending {
+			return status.Errorf(codes.FailedPrecondition, "transfer is already %s", transfer.Status)
+		}
+
+		return fn(q, transfer, userID)
+	})
+}
+
+// requireTransferRights lets the owner or an admin of the collection's organization move it. Members who
+// only see it through the organization cannot take it out.
+func (s *CollectionServer) requireTransferRights(ctx context.Context, dbCollection db.Collection, userID int32) error {
+	if dbCollection.OwnerID.Valid && dbCollection.OwnerID.Int32 == userID {
+		return nil
+	}
+	denied := status.Error(codes.PermissionDenied, "only the owner or an organization admin can transfer the collection")
+	if !dbCollection.OrganizationID.Valid {
+		return denied
+	}
+	if err := s.requireOrganizationAdmin(ctx, userID, dbCollection.OrganizationID.Int32); err != nil {
+		if status.Code(err) == codes.PermissionDenied {
+			return denied
+		}
+		return err
+	}
+	return nil
+}
+
+// isTransferRecipient reports whether the user can resolve a transfer for the receiving side: the user it is
+// addressed to, or an admin of the organization it is addressed to.
+func (s *CollectionServer) isTransferRecipient(ctx context.Context, transfer db.CollectionTransfer, userID int32) (bool, error) {
+	if transfer.ToUserID.Valid {
+		return transfer.ToUserID.Int32 == userID, nil
+	}
+
+	err := s.requireOrganizationAdmin(ctx, userID, transfer.ToOrganizationID.Int32)
+	if status.Code(err) == codes.PermissionDenied {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+func transferAuditDetails(transfer db.CollectionTransfer, previous db.Collection) map[string]string {
+	details := map[string]string{}
+	if previous.OwnerID.Valid {
+		details["previous_owner_id"] = fmt.Sprintf("%d", previous.OwnerID.Int32)
+	}
+	if previous.OrganizationID.Valid {
+		details["previous_organization_id"] = fmt.Sprintf("%d", previous.OrganizationID.Int32)
+	}
+	if transfer.ToUserID.Valid {
+		details["to_user_id"] = fmt.Sprintf("%d", transfer.ToUserID.Int32)
+	}
+	if transfer.ToOrganizationID.Valid {
+		details["to_organization_id"] = fmt.Sprintf("%d", transfer.ToOrganizationID.Int32)
+	}
+	return details
+}
+
+func (s *CollectionServer) dbTransferToProto(ctx context.Context, t db.CollectionTransfer) (*censysv1.CollectionTransfer, error) {
+	collectionUID, err := s.queries.GetCollectionUIDByID(ctx, t.CollectionID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to load collection: %v", err)
+	}
+
+	protoTransfer := &censysv1.CollectionTransfer{
+		Uid:           t.Uid.String(),
+		CollectionUid: collectionUID.String(),
+		CreatedAt:     timestamppb.New(t.CreatedAt.Time),
+	}
+
+	if t.ToUserID.Valid {
+		user, err := s.queries.GetUserByID(ctx, t.ToUserID.Int32)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to load user: %v", err)
+		}
+		protoTransfer.ToUserUid = user.Uid.String()
+	}
+	if t.ToOrganizationID.Valid {
+		org, err := s.queries.GetOrganizationByID(ctx, t.ToOrganizationID.Int32)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to load organization: %v", err)
+		}
+		protoTransfer.ToOrganizationUid = org.Uid.String()
+	}
+
+	switch t.Status {
+	case db.TransferStatusPending:
+		protoTransfer.Status = censysv1.TransferStatus_TRANSFER_STATUS_PENDING
+	case db.TransferStatusAccepted:
+		protoTransfer.Status = censysv1.TransferStatus_TRANSFER_STATUS_ACCEPTED
+	case db.TransferStatusDeclined:
+		protoTransfer.Status = censysv1.TransferStatus_TRANSFER_STATUS_DECLINED
+	}
+
+	if t.ResolvedAt.Valid {
+		protoTransfer.ResolvedAt = timestamppb.New(t.ResolvedAt.Time)
+	}
+
+	return protoTransfer, nil
+}
@@ -0,0 +1,119 @@
+package server
+
+import (
+	"context"
+	"testing"
+
+	censysv1 "github.com/ajscimone/censys-challenge/gen/proto"
+	"github.com/ajscimone/censys-challenge/internal/db"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestTransferCollection_RequiresOwnerOrOrganizationAdmin(t *testing.T) {
+	env := newTestEnv(t)
+	admin, adminCtx := env.newUser(t)
+	owner, ownerCtx := env.newUser(t)
+	member, memberCtx := env.newUser(t)
+	org := env.newOrganization(t, admin, owner, member)
+
+	collection, err := env.collections.CreateCollection(ownerCtx, &censysv1.CreateCollectionRequest{
+		Name:            "team notes",
+		AccessLevel:     censysv1.AccessLevel_ACCESS_LEVEL_ORGANIZATION,
+		OrganizationUid: org.Uid.String(),
+	})
+	if err != nil {
+		t.Fatalf("failed to create collection: %v", err)
+	}
+
+	// the member can see the collection but must not be able to take it out of the organization
+	_, err = env.collections.TransferCollection(memberCtx, &censysv1.TransferCollectionRequest{
+		CollectionUid: collection.Uid,
+		ToUserUid:     member.Uid.String(),
+	})
+	if status.Code(err) != codes.PermissionDenied {
+		t.Fatalf("expected PermissionDenied for a plain member, got %v", err)
+	}
+
+	transfer, err := env.collections.TransferCollection(adminCtx, &censysv1.TransferCollectionRequest{
+		CollectionUid: collection.Uid,
+		ToUserUid:     admin.Uid.String(),
+	})
+	if err != nil {
+		t.Fatalf("an organization admin should be able to transfer: %v", err)
+	}
+	if _, err := env.collections.DeclineCollectionTransfer(ownerCtx, &censysv1.DeclineCollectionTransferRequest{TransferUid: transfer.Uid}); status.Code(err) != codes.PermissionDenied {
+		t.Fatalf("only the requester or recipient can decline, got %v", err)
+	}
+	if _, err := env.collections.DeclineCollectionTransfer(adminCtx, &censysv1.DeclineCollectionTransferRequest{TransferUid: transfer.Uid}); err != nil {
+		t.Fatalf("failed to decline transfer: %v", err)
+	}
+
+	if _, err := env.collections.TransferCollection(ownerCtx, &censysv1.TransferCollectionRequest{
+		CollectionUid: collection.Uid,
+		ToUserUid:     member.Uid.String(),
+	}); err != nil {
+		t.Fatalf("the owner should be able to transfer: %v", err)
+	}
+}
+
+func TestAcceptCollectionTransfer_NeedsReceivingAdminAndARequesterWhoStillHasRights(t *testing.T) {
+	env := newTestEnv(t)
+	sender, senderCtx := env.newUser(t)
+	owner, ownerCtx := env.newUser(t)
+	receiver, receiverCtx := env.newUser(t)
+	member, memberCtx := env.newUser(t)
+	from := env.newOrganization(t, sender, owner)
+	to := env.newOrganization(t, receiver, member)
+
+	collection, err := env.collections.CreateCollection(ownerCtx, &censysv1.CreateCollectionRequest{
+		Name:            "team notes",
+		AccessLevel:     censysv1.AccessLevel_ACCESS_LEVEL_ORGANIZATION,
+		OrganizationUid: from.Uid.String(),
+	})
+	if err != nil {
+		t.Fatalf("failed to create collection: %v", err)
+	}
+	transfer, err := env.collections.TransferCollection(senderCtx, &censysv1.TransferCollectionRequest{
+		CollectionUid:     collection.Uid,
+		ToOrganizationUid: to.Uid.String(),
+	})
+	if err != nil {
+		t.Fatalf("failed to request transfer: %v", err)
+	}
+	accept := &censysv1.AcceptCollectionTransferRequest{TransferUid: transfer.Uid}
+
+	// a plain member of the receiving organization neither sees nor accepts it
+	pending, err := env.collections.ListCollectionTransfers(memberCtx, &censysv1.ListCollectionTransfersRequest{})
+	if err != nil {
+		t.Fatalf("failed to list transfers: %v", err)
+	}
+	if len(pending.Transfers) != 0 {
+		t.Fatalf("a plain member should not be offered the transfer, got %v", pending.Transfers)
+	}
+	if _, err := env.collections.AcceptCollectionTransfer(memberCtx, accept); status.Code(err) != codes.PermissionDenied {
+		t.Fatalf("expected PermissionDenied for a plain member, got %v", err)
+	}
+
+	setRole := func(role db.OrganizationRole) {
+		t.Helper()
+		if _, err := env.pool.Exec(context.Background(),
+			"UPDATE organization_members SET role = $1 WHERE user_id = $2 AND organization_id = $3",
+			role, sender.ID, from.ID); err != nil {
+			t.Fatalf("failed to change role: %v", err)
+		}
+	}
+	setRole(db.OrganizationRoleMember)
+	if _, err := env.collections.AcceptCollectionTransfer(receiverCtx, accept); status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("expected FailedPrecondition once the requester is no longer an admin, got %v", err)
+	}
+
+	setRole(db.OrganizationRoleAdmin)
+	accepted, err := env.collections.AcceptCollectionTransfer(receiverCtx, accept)
+	if err != nil {
+		t.Fatalf("an admin of the receiving organization should be able to accept: %v", err)
+	}
+	if accepted.Status != censysv1.TransferStatus_TRANSFER_STATUS_ACCEPTED {
+		t.Fatalf("expected the transfer to be accepted, got %v", accepted.Status)
+	}
+}
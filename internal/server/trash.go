@@ -0,0 +1,159 @@
+package server
+
+import (
+	"context"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/ajscimone/censys-challenge/gen/proto"
+	"github.com/ajscimone/censys-challenge/internal/db"
+	"github.com/ajscimone/censys-challenge/internal/middleware"
+	"github.com/jackc/pgx/v5/pgtype"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *CollectionServer) ListTrash(ctx context.Context, req *censysv1.ListTrashRequest) (*censysv1.ListTrashResponse, error) {
+	userID, err := middleware.UserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "authentication required")
+	}
+
+	pageSize := clampPageSize(req.PageSize)
+	before, err := parseIDPageToken(req.PageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	labelFilter, err := parseLabelSelector(req.LabelSelector)
+	if err != nil {
+		return nil, err
+	}
+
+	deleted, err := s.queries.ListDeletedCollectionsForUser(ctx, db.ListDeletedCollectionsForUserParams{
+		UserID:         pgtype.Int4{Int32: userID, Valid: true},
+		LabelEquals:    labelFilter.equals,
+		LabelNotEquals: labelFilter.notEquals,
+		LabelExists:    labelFilter.exists,
+		LabelMissing:   labelFilter.missing,
+		BeforeID:       before,
+		PageSize:       pageSize,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list trash: %v", err)
+	}
+
+	resp := &censysv1.ListTrashResponse{}
+	for _, c := range deleted {
+		protoCollection, err := dbCollectionToProto(c)
+		if err != nil {
+			return nil, err
+		}
+		resp.Collections = append(resp.Collections, protoCollection)
+	}
+	if len(deleted) == int(pageSize) {
+		resp.NextPageToken = strconv.FormatInt(int64(deleted[len(deleted)-1].ID), 10)
+	}
+
+	return resp, nil
+}
+
+func (s *CollectionServer) UndeleteCollection(ctx context.Context, req *censysv1.UndeleteCollectionRequest) (*censysv1.Collection, error) {
+	if req.Uid == "" {
+		return nil, status.Error(codes.InvalidArgument, "uid is required")
+	}
+
+	var collectionUUID pgtype.UUID
+	if err := collectionUUID.Scan(req.Uid); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid uid: %v", err)
+	}
+
+	userID, err := middleware.UserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "authentication required")
+	}
+
+	dbCollection, err := s.queries.GetDeletedCollectionByUID(ctx, collectionUUID)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "collection not found in trash: %v", err)
+	}
+
+	if !checkTrashAccess(ctx, s.queries, dbCollection, userID) {
+		return nil, status.Error(codes.PermissionDenied, "access denied")
+	}
+
+	// trashed collections do not count against quotas, so bringing one back has to fit again
+	added := quotaAmounts{collections: 1, storageBytes: int64(len(dbCollection.Data))}
+	if err := s.checkQuota(ctx, s.queries, dbCollection.OwnerID, added, dbCollection.OrganizationID, added); err != nil {
+		return nil, err
+	}
+
+	restored, err := s.queries.UndeleteCollection(ctx, dbCollection.ID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to undelete collection: %v", err)
+	}
+
+	return dbCollectionToProto(restored)
+}
+
+// checkTrashAccess is checkAccess for a collection in the trash, which the ownership queries skip.
+func checkTrashAccess(ctx context.Context, queries *db.Queries, dbCollection db.Collection, userID int32) bool {
+	if dbCollection.OwnerID.Valid && dbCollection.OwnerID.Int32 == userID {
+		return true
+	}
+	if dbCollection.AccessLevel != db.AccessLevelOrganization || !dbCollection.OrganizationID.Valid {
+		return false
+	}
+	_, err := queries.IsUserInOrganization(ctx, db.IsUserInOrganizationParams{
+		UserID:         userID,
+		OrganizationID: dbCollection.OrganizationID.Int32,
+	})
+	return err == nil
+}
+
+// stalePayloadUploadAge is how long an upload can run before the purger assumes its server is gone and stops
+// keeping its chunks.
+const stalePayloadUploadAge = 24 * time.Hour
+
+// RunTrashPurger hard deletes collections that have been in the trash longer than retention, checking
+// every interval until ctx is cancelled. Share links and versions go with them through ON DELETE CASCADE.
+func RunTrashPurger(ctx context.Context, queries *db.Queries, retention, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		cutoff := pgtype.Timestamptz{Time: time.Now().Add(-retention), Valid: true}
+		purged, err := queries.PurgeDeletedCollections(ctx, cutoff)
+		if err != nil {
+			if ctx.Err() == nil {
+				log.Printf("Failed to purge trash: %v", err)
+			}
+		} else if purged > 0 {
+			log.Printf("Purged %d collections from the trash", purged)
+		}
+
+		// uploads a server did not live to finish, their chunks are then removed with the unreferenced ones
+		staleCutoff := pgtype.Timestamptz{Time: time.Now().Add(-stalePayloadUploadAge), Valid: true}
+		if _, err := queries.DeleteStalePayloadUploads(ctx, staleCutoff); err != nil {
+			if ctx.Err() == nil {
+				log.Printf("Failed to remove stale payload uploads: %v", err)
+			}
+		}
+
+		// payload chunks are shared between collections so they are not removed by the cascade
+		if removed, err := queries.DeleteUnreferencedBlobChunks(ctx, nil); err != nil {
+			if ctx.Err() == nil {
+				log.Printf("Failed to remove unreferenced payload chunks: %v", err)
+			}
+		} else if removed > 0 {
+			log.Printf("Removed %d unreferenced payload chunks", removed)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
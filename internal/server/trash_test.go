@@ -0,0 +1,50 @@
+package server
+
+import (
+	"context"
+	"testing"
+
+	censysv1 "github.com/ajscimone/censys-challenge/gen/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestTrashedCollectionIsNotShared(t *testing.T) {
+	env := newTestEnv(t)
+	_, ctx := env.newUser(t)
+
+	collection, err := env.collections.CreateCollection(ctx, &censysv1.CreateCollectionRequest{
+		Name:        "trashed",
+		AccessLevel: censysv1.AccessLevel_ACCESS_LEVEL_SHARED,
+	})
+	if err != nil {
+		t.Fatalf("failed to create collection: %v", err)
+	}
+	shareToken, err := env.collections.CreateShareToken(ctx, &censysv1.CreateShareTokenRequest{CollectionUid: collection.Uid})
+	if err != nil {
+		t.Fatalf("failed to create share token: %v", err)
+	}
+	if _, err := env.collections.DeleteCollection(ctx, &censysv1.DeleteCollectionRequest{Uid: collection.Uid}); err != nil {
+		t.Fatalf("failed to delete collection: %v", err)
+	}
+
+	_, err = env.collections.GetSharedCollection(context.Background(), &censysv1.GetSharedCollectionRequest{Token: shareToken.Token})
+	if status.Code(err) != codes.NotFound {
+		t.Fatalf("expected NotFound for a trashed collection, got %v", err)
+	}
+	link, err := env.queries.GetShareLinkByToken(context.Background(), shareToken.Token)
+	if err != nil {
+		t.Fatalf("failed to read share link: %v", err)
+	}
+	if link.AccessCount != 0 {
+		t.Fatalf("hits on a trashed collection should not be counted, got %d", link.AccessCount)
+	}
+
+	// the owner can still bring it back, which makes the link work again
+	if _, err := env.collections.UndeleteCollection(ctx, &censysv1.UndeleteCollectionRequest{Uid: collection.Uid}); err != nil {
+		t.Fatalf("failed to undelete collection: %v", err)
+	}
+	if _, err := env.collections.GetSharedCollection(context.Background(), &censysv1.GetSharedCollectionRequest{Token: shareToken.Token}); err != nil {
+		t.Fatalf("the link should work after undelete: %v", err)
+	}
+}
@@ -0,0 +1,232 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"slices"
+	"strings"
+
+	"github.com/ajscimone/censys-challenge/gen/proto"
+	"github.com/ajscimone/censys-challenge/internal/db"
+	"github.com/jackc/pgx/v5/pgtype"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+var updatableCollectionFields = []string{"name", "data", "access_level", "organization_uid", "version_retention", "labels"}
+
+// maskedUpdateParams applies an AIP-134 style update: every path in the mask is written from the
+// request even when it holds the zero value, and fields outside the mask are left untouched.
+// "data.<key>" and "labels.<key>" paths replace or, when the key is missing from the request, remove a single key.
+// Data paths can go deeper following AIP-161, "data.a.b" sets b inside the object at a.
+func (s *CollectionServer) maskedUpdateParams(ctx context.Context, userID int32, dbCollection db.Collection, req *censysv1.UpdateCollectionRequest) (db.UpdateCollectionParams, error) {
+	params := db.UpdateCollectionParams{
+		ID:               dbCollection.ID,
+		Name:             dbCollection.Name,
+		Data:             dbCollection.Data,
+		AccessLevel:      dbCollection.AccessLevel,
+		OrganizationID:   dbCollection.OrganizationID,
+		VersionRetention: dbCollection.VersionRetention,
+		Labels:           dbCollection.Labels,
+	}
+
+	paths := req.UpdateMask.GetPaths()
+	if len(paths) == 0 {
+		return params, status.Error(codes.InvalidArgument, "update_mask must contain at least one path")
+	}
+	if len(paths) == 1 && paths[0] == "*" {
+		paths = updatableCollectionFields
+	}
+
+	var dataKeys, labelKeys []string
+	for _, path := range paths {
+		switch {
+		case path == "name":
+			if req.Name == "" {
+				return params, status.Error(codes.InvalidArgument, "name cannot be empty")
+			}
+			params.Name = req.Name
+
+		case path == "data":
+			data := req.Data
+			if data == nil {
+				data = &structpb.Struct{}
+			}
+			dataBytes, err := data.MarshalJSON()
+			if err != nil {
+				return params, status.Errorf(codes.InvalidArgument, "invalid data: %v", err)
+			}
+			params.Data = dataBytes
+
+		case strings.HasPrefix(path, "data.") && len(path) > len("data."):
+			dataKeys = append(dataKeys, strings.TrimPrefix(path, "data."))
+
+		case path == "access_level":
+			if req.AccessLevel == censysv1.AccessLevel_ACCESS_LEVEL_UNSPECIFIED {
+				return params, status.Error(codes.InvalidArgument, "access_level cannot be unspecified")
+			}
+			params.AccessLevel = protoAccessLevelToDB(req.AccessLevel)
+
+		case path == "organization_uid":
+			if req.OrganizationUid == "" {
+				params.OrganizationID = pgtype.Int4{Valid: false}
+				continue
+			}
+			org, err := s.memberOrganization(ctx, userID, req.OrganizationUid)
+			if err != nil {
+				return params, err
+			}
+			params.OrganizationID = pgtype.Int4{Int32: org.ID, Valid: true}
+
+		case path == "version_retention":
+			if req.VersionRetention < 0 {
+				return params, status.Error(codes.InvalidArgument, "version_retention must not be negative")
+			}
+			// zero goes back to the server default
+			params.VersionRetention = pgtype.Int4{Int32: req.VersionRetention, Valid: req.VersionRetention > 0}
+
+		case path == "labels":
+			labelBytes, err := labelsToJSON(req.Labels)
+			if err != nil {
+				return params, err
+			}
+			params.Labels = labelBytes
+
+		case strings.HasPrefix(path, "labels.") && len(path) > len("labels."):
+			labelKeys = append(labelKeys, strings.TrimPrefix(path, "labels."))
+
+		default:
+			return params, status.Errorf(codes.InvalidArgument, "unsupported update_mask path %q", path)
+		}
+	}
+
+	if len(dataKeys) > 0 {
+		dataBytes, err := patchDataKeys(params.Data, req.Data, dataKeys)
+		if err != nil {
+			return params, err
+		}
+		params.Data = dataBytes
+	}
+
+	if len(labelKeys) > 0 {
+		labelBytes, err := patchLabelKeys(params.Labels, req.Labels, labelKeys)
+		if err != nil {
+			return params, err
+		}
+		params.Labels = labelBytes
+	}
+
+	if params.AccessLevel == db.AccessLevelOrganization && !params.OrganizationID.Valid {
+		return params, status.Error(codes.InvalidArgument, "organization_uid required for organization-level access")
+	}
+
+	return params, nil
+}
+
+func patchDataKeys(current []byte, requested *structpb.Struct, paths []string) ([]byte, error) {
+	data := json.RawMessage(current)
+	for _, path := range paths {
+		keys := strings.Split(path, ".")
+		if slices.Contains(keys, "") {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid update_mask path %q", "data."+path)
+		}
+
+		var raw json.RawMessage
+		if value, ok := requestedDataValue(requested, keys); ok {
+			var err error
+			if raw, err = value.MarshalJSON(); err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, "invalid data.%s: %v", path, err)
+			}
+		}
+
+		var err error
+		if data, err = setDataPath(data, keys, raw, "data"); err != nil {
+			return nil, err
+		}
+	}
+	return data, nil
+}
+
+// requestedDataValue walks keys through nested objects of the request's data.
+func requestedDataValue(requested *structpb.Struct, keys []string) (*structpb.Value, bool) {
+	fields := requested.GetFields()
+	for i, key := range keys {
+		value, ok := fields[key]
+		if !ok {
+			return nil, false
+		}
+		if i == len(keys)-1 {
+			return value, true
+		}
+		fields = value.GetStructValue().GetFields()
+	}
+	return nil, false
+}
+
+// setDataPath sets the value at keys inside the JSON object current, creating objects along the way, or
+// removes it when value is nil. prefix is the path to current for error messages.
+func setDataPath(current json.RawMessage, keys []string, value json.RawMessage, prefix string) (json.RawMessage, error) {
+	fields := map[string]json.RawMessage{}
+	if len(current) > 0 && string(current) != "null" {
+		if err := json.Unmarshal(current, &fields); err != nil {
+			if value == nil {
+				// nothing below a scalar to remove
+				return current, nil
+			}
+			return nil, status.Errorf(codes.InvalidArgument, "%s is not an object", prefix)
+		}
+	}
+
+	key := keys[0]
+	if len(keys) == 1 {
+		if value == nil {
+			delete(fields, key)
+		} else {
+			fields[key] = value
+		}
+	} else {
+		child, exists := fields[key]
+		if !exists && value == nil {
+			return current, nil
+		}
+		child, err := setDataPath(child, keys[1:], value, prefix+"."+key)
+		if err != nil {
+			return nil, err
+		}
+		fields[key] = child
+	}
+
+	data, err := json.Marshal(fields)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to marshal data: %v", err)
+	}
+	return data, nil
+}
+
+// memberOrganization resolves an organization uid and makes sure the user belongs to it.
+func (s *CollectionServer) memberOrganization(ctx context.Context, userID int32, organizationUID string) (db.Organization, error) {
+	var orgUUID pgtype.UUID
+	if err := orgUUID.Scan(organizationUID); err != nil {
+		return db.Organization{}, status.Errorf(codes.InvalidArgument, "invalid organization_uid: %v", err)
+	}
+
+	org, err := s.queries.GetOrganizationByUID(ctx, orgUUID)
+	if err != nil {
+		return db.Organization{}, status.Errorf(codes.NotFound, "organization not found: %v", err)
+	}
+
+	_, err = s.queries.IsUserInOrganization(ctx, db.IsUserInOrganizationParams{
+		UserID:         userID,
+		OrganizationID: org.ID,
+	})
+	if err != nil {
+		return db.Organization{}, status.Error(codes.PermissionDenied, "user not in organization")
+	}
+
+	return org, nil
+}
+
+func protoAccessLevelToDB(level censysv1.AccessLevel) db.AccessLevel {
+	return db.AccessLevel(strings.ToLower(level.String()[len("ACCESS_LEVEL_"):]))
+}
@@ -0,0 +1,67 @@
+package server
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func mustStruct(t *testing.T, fields map[string]any) *structpb.Struct {
+	t.Helper()
+	s, err := structpb.NewStruct(fields)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return s
+}
+
+func TestPatchDataKeys_NestedPaths(t *testing.T) {
+	current := []byte(`{"a":{"b":1,"c":2},"d":3}`)
+	requested := mustStruct(t, map[string]any{"a": map[string]any{"b": 10}, "x": map[string]any{"y": "new"}})
+
+	patched, err := patchDataKeys(current, requested, []string{"a.b", "a.c", "x.y"})
+	if err != nil {
+		t.Fatalf("patch failed: %v", err)
+	}
+
+	var got map[string]any
+	if err := json.Unmarshal(patched, &got); err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]any{
+		// b replaced, c removed since the request leaves it out, d untouched
+		"a": map[string]any{"b": float64(10)},
+		"d": float64(3),
+		"x": map[string]any{"y": "new"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("expected %v, got %v", want, got)
+	}
+}
+
+func TestPatchDataKeys_RejectsInvalidPaths(t *testing.T) {
+	current := []byte(`{"a":1}`)
+	requested := mustStruct(t, map[string]any{"a": map[string]any{"b": 2}})
+
+	for _, path := range []string{"a.b", "a..b", "a."} {
+		if _, err := patchDataKeys(current, requested, []string{path}); status.Code(err) != codes.InvalidArgument {
+			t.Errorf("%s: expected InvalidArgument, got %v", path, err)
+		}
+	}
+}
+
+func TestPatchDataKeys_RemovingMissingPathIsNoop(t *testing.T) {
+	current := []byte(`{"a":1}`)
+
+	patched, err := patchDataKeys(current, &structpb.Struct{}, []string{"a.b", "x.y"})
+	if err != nil {
+		t.Fatalf("patch failed: %v", err)
+	}
+	if string(patched) != `{"a":1}` {
+		t.Fatalf("expected data unchanged, got %s", patched)
+	}
+}
@@ -0,0 +1,228 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/ajscimone/censys-challenge/gen/proto"
+	"github.com/ajscimone/censys-challenge/internal/db"
+	"github.com/ajscimone/censys-challenge/internal/middleware"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// writeCollection checks quotas and validates the new data, then snapshots the locked row into collection_versions,
+// applies the update and trims old versions down to the retention limit. It must be called inside the transaction that locked the row.
+func (s *CollectionServer) writeCollection(ctx context.Context, q *db.Queries, locked db.Collection, userID int32, params db.UpdateCollectionParams) (db.Collection, error) {
+	if err := s.checkWriteQuota(ctx, q, locked, params); err != nil {
+		return db.Collection{}, err
+	}
+
+	if err := validateData(ctx, q, params.OrganizationID, params.Data); err != nil {
+		return db.Collection{}, err
+	}
+
+	err := q.CreateCollectionVersion(ctx, db.CreateCollectionVersionParams{
+		CollectionID: locked.ID,
+		Revision:     locked.Revision,
+		Name:         locked.Name,
+		Data:         locked.Data,
+		SupersededBy: pgtype.Int4{Int32: userID, Valid: true},
+	})
+	if err != nil {
+		return db.Collection{}, status.Errorf(codes.Internal, "failed to save collection version: %v", err)
+	}
+
+	updated, err := q.UpdateCollection(ctx, params)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) && params.ExpectedRevision.Valid {
+			return db.Collection{}, status.Error(codes.Aborted, "collection was modified, etag does not match")
+		}
+		return db.Collection{}, status.Errorf(codes.Internal, "failed to update collection: %v", err)
+	}
+
+	if retention := s.versionRetention(updated); retention > 0 {
+		err = q.PruneCollectionVersions(ctx, db.PruneCollectionVersionsParams{
+			CollectionID: updated.ID,
+			Limit:        retention,
+		})
+		if err != nil {
+			return db.Collection{}, status.Errorf(codes.Internal, "failed to prune collection versions: %v", err)
+		}
+	}
+
+	return updated, nil
+}
+
+// versionRetention is how many versions of c are kept, its own limit or the server wide default.
+func (s *CollectionServer) versionRetention(c db.Collection) int32 {
+	if c.VersionRetention.Valid {
+		return c.VersionRetention.Int32
+	}
+	return s.config.VersionRetention
+}
+
+// accessibleCollection loads a collection by uid and checks the caller can see it.
+func (s *CollectionServer) accessibleCollection(ctx context.Context, uid string) (db.Collection, int32, error) {
+	if uid == "" {
+		return db.Collection{}, 0, status.Error(codes.InvalidArgument, "collection_uid is required")
+	}
+
+	var collectionUUID pgtype.UUID
+	if err := collectionUUID.Scan(uid); err != nil {
+		return db.Collection{}, 0, status.Errorf(codes.InvalidArgument, "invalid collection_uid: %v", err)
+	}
+
+	userID, err := middleware.UserIDFromContext(ctx)
+	if err != nil {
+		return db.Collection{}, 0, status.Error(codes.Unauthenticated, "authentication required")
+	}
+
+	dbCollection, err := s.queries.GetCollectionByUID(ctx, collectionUUID)
+	if err != nil {
+		return db.Collection{}, 0, status.Errorf(codes.NotFound, "collection not found: %v", err)
+	}
+
+	if !checkAccess(ctx, s.queries, dbCollection.ID, userID) {
+		return db.Collection{}, 0, status.Error(codes.PermissionDenied, "access denied")
+	}
+
+	return dbCollection, userID, nil
+}
+
+func (s *CollectionServer) ListCollectionVersions(ctx context.Context, req *censysv1.ListCollectionVersionsRequest) (*censysv1.ListCollectionVersionsResponse, error) {
+	dbCollection, _, err := s.accessibleCollection(ctx, req.CollectionUid)
+	if err != nil {
+		return nil, err
+	}
+
+	pageSize := clampPageSize(req.PageSize)
+
+	// the page token is the revision the previous page stopped at
+	var before pgtype.Int8
+	if req.PageToken != "" {
+		revision, err := strconv.ParseInt(req.PageToken, 10, 64)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid page_token: %v", err)
+		}
+		before = pgtype.Int8{Int64: revision, Valid: true}
+	}
+
+	versions, err := s.queries.ListCollectionVersions(ctx, db.ListCollectionVersionsParams{
+		CollectionID:   dbCollection.ID,
+		BeforeRevision: before,
+		Limit:          pageSize,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list collection versions: %v", err)
+	}
+
+	resp := &censysv1.ListCollectionVersionsResponse{}
+	for _, v := range versions {
+		protoVersion, err := dbCollectionVersionToProto(v, dbCollection)
+		if err != nil {
+			return nil, err
+		}
+		resp.Versions = append(resp.Versions, protoVersion)
+	}
+	if len(versions) == int(pageSize) {
+		resp.NextPageToken = strconv.FormatInt(versions[len(versions)-1].Revision, 10)
+	}
+
+	return resp, nil
+}
+
+func (s *CollectionServer) GetCollectionVersion(ctx context.Context, req *censysv1.GetCollectionVersionRequest) (*censysv1.CollectionVersion, error) {
+	dbCollection, _, err := s.accessibleCollection(ctx, req.CollectionUid)
+	if err != nil {
+		return nil, err
+	}
+
+	version, err := s.queries.GetCollectionVersion(ctx, db.GetCollectionVersionParams{
+		CollectionID: dbCollection.ID,
+		Revision:     req.Revision,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "collection version not found: %v", err)
+	}
+
+	return dbCollectionVersionToProto(version, dbCollection)
+}
+
+func (s *CollectionServer) RestoreCollectionVersion(ctx context.Context, req *censysv1.RestoreCollectionVersionRequest) (*censysv1.Collection, error) {
+	dbCollection, userID, err := s.accessibleCollection(ctx, req.CollectionUid)
+	if err != nil {
+		return nil, err
+	}
+
+	var updated db.Collection
+	err = withTx(ctx, s.pool, func(q *db.Queries) error {
+		locked, err := q.GetCollectionByIDForUpdate(ctx, dbCollection.ID)
+		if err != nil {
+			return status.Errorf(codes.NotFound, "collection not found: %v", err)
+		}
+
+		expectedRevision, err := checkEtag(req.Etag, locked)
+		if err != nil {
+			return err
+		}
+
+		version, err := q.GetCollectionVersion(ctx, db.GetCollectionVersionParams{
+			CollectionID: locked.ID,
+			Revision:     req.Revision,
+		})
+		if err != nil {
+			return status.Errorf(codes.NotFound, "collection version not found: %v", err)
+		}
+
+		updated, err = s.writeCollection(ctx, q, locked, userID, db.UpdateCollectionParams{
+			ID:               locked.ID,
+			Name:             version.Name,
+			Data:             version.Data,
+			AccessLevel:      locked.AccessLevel,
+			OrganizationID:   locked.OrganizationID,
+			VersionRetention: locked.VersionRetention,
+			Labels:           locked.Labels,
+			ExpectedRevision: expectedRevision,
+		})
+		return err
+	})
+	if err != nil {
+		return nil, txStatus(err, "failed to restore collection version")
+	}
+
+	return dbCollectionToProto(updated)
+}
+
+func dbCollectionVersionToProto(v db.CollectionVersion, c db.Collection) (*censysv1.CollectionVersion, error) {
+	uidBytes, err := c.Uid.MarshalJSON()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to marshal uid: %v", err)
+	}
+
+	var dataStruct structpb.Struct
+	if err := json.Unmarshal(v.Data, &dataStruct); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to unmarshal data: %v", err)
+	}
+
+	supersededBy := ""
+	if v.SupersededBy.Valid {
+		supersededBy = fmt.Sprintf("%d", v.SupersededBy.Int32)
+	}
+
+	return &censysv1.CollectionVersion{
+		CollectionUid: string(uidBytes[1 : len(uidBytes)-1]),
+		Revision:      v.Revision,
+		Name:          v.Name,
+		Data:          &dataStruct,
+		SupersededBy:  supersededBy,
+		CreatedAt:     timestamppb.New(v.CreatedAt.Time),
+	}, nil
+}
@@ -0,0 +1,74 @@
+package server
+
+import (
+	"fmt"
+	"testing"
+
+	censysv1 "github.com/ajscimone/censys-challenge/gen/proto"
+	"github.com/ajscimone/censys-challenge/internal/db"
+	"github.com/jackc/pgx/v5/pgtype"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+func TestVersionRetention(t *testing.T) {
+	s := &CollectionServer{config: CollectionServerConfig{VersionRetention: 50}}
+
+	for _, tc := range []struct {
+		name       string
+		collection db.Collection
+		want       int32
+	}{
+		{name: "server default", want: 50},
+		{name: "own limit", collection: db.Collection{VersionRetention: pgtype.Int4{Int32: 3, Valid: true}}, want: 3},
+		{name: "own limit above the default", collection: db.Collection{VersionRetention: pgtype.Int4{Int32: 100, Valid: true}}, want: 100},
+	} {
+		if got := s.versionRetention(tc.collection); got != tc.want {
+			t.Errorf("%s: expected %d, got %d", tc.name, tc.want, got)
+		}
+	}
+}
+
+func TestUpdateCollection_SnapshotsAndPrunesVersions(t *testing.T) {
+	env := newTestEnv(t)
+	user, ctx := env.newUser(t)
+
+	collection, err := env.collections.CreateCollection(ctx, &censysv1.CreateCollectionRequest{
+		Name:        "v0",
+		AccessLevel: censysv1.AccessLevel_ACCESS_LEVEL_PRIVATE,
+	})
+	if err != nil {
+		t.Fatalf("failed to create collection: %v", err)
+	}
+	if _, err := env.collections.UpdateCollection(ctx, &censysv1.UpdateCollectionRequest{
+		Uid:              collection.Uid,
+		VersionRetention: 2,
+		UpdateMask:       &fieldmaskpb.FieldMask{Paths: []string{"version_retention"}},
+	}); err != nil {
+		t.Fatalf("failed to set version retention: %v", err)
+	}
+	for i := 1; i <= 4; i++ {
+		if _, err := env.collections.UpdateCollection(ctx, &censysv1.UpdateCollectionRequest{
+			Uid:        collection.Uid,
+			Name:       fmt.Sprintf("v%d", i),
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"name"}},
+		}); err != nil {
+			t.Fatalf("update %d failed: %v", i, err)
+		}
+	}
+
+	resp, err := env.collections.ListCollectionVersions(ctx, &censysv1.ListCollectionVersionsRequest{CollectionUid: collection.Uid})
+	if err != nil {
+		t.Fatalf("failed to list versions: %v", err)
+	}
+	// each write snapshots the row it replaces, only the newest two are kept
+	var names []string
+	for _, version := range resp.Versions {
+		names = append(names, version.Name)
+		if version.SupersededBy != fmt.Sprint(user.ID) {
+			t.Errorf("version %d should record the writer that replaced it, got %q", version.Revision, version.SupersededBy)
+		}
+	}
+	if fmt.Sprint(names) != "[v3 v2]" {
+		t.Fatalf("expected the two newest versions, got %v", names)
+	}
+}
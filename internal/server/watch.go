@@ -0,0 +1,281 @@
+package server
+
+import (
+	"context"
+	"errors"
+
+	"github.com/ajscimone/censys-challenge/gen/proto"
+	"github.com/ajscimone/censys-challenge/internal/db"
+	"github.com/ajscimone/censys-challenge/internal/middleware"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var changeEventTypes = map[string]censysv1.CollectionEventType{
+	"created":        censysv1.CollectionEventType_COLLECTION_EVENT_TYPE_CREATED,
+	"updated":        censysv1.CollectionEventType_COLLECTION_EVENT_TYPE_UPDATED,
+	"deleted":        censysv1.CollectionEventType_COLLECTION_EVENT_TYPE_DELETED,
+	"access_changed": censysv1.CollectionEventType_COLLECTION_EVENT_TYPE_ACCESS_CHANGED,
+}
+
+var errChangeFeedInterrupted = status.Error(codes.Unavailable, "change feed interrupted, changes may have been missed, watch again")
+
+func (s *CollectionServer) WatchCollection(req *censysv1.WatchCollectionRequest, stream grpc.ServerStreamingServer[censysv1.CollectionEvent]) error {
+	ctx := stream.Context()
+
+	changes := s.changes.subscribe()
+	defer s.changes.unsubscribe(changes)
+
+	dbCollection, userID, err := s.accessibleCollection(ctx, req.Uid)
+	if err != nil {
+		return err
+	}
+	s.changes.narrow(changes, changeFilter{collectionID: dbCollection.ID, userID: userID})
+
+	for {
+		change, err := nextChange(ctx, changes)
+		if err != nil {
+			return err
+		}
+
+		switch change.Kind {
+		case changeKindMembership:
+			if change.UserID != userID || change.OrganizationID != dbCollection.OrganizationID {
+				continue
+			}
+			if !checkAccess(ctx, s.queries, dbCollection.ID, userID) {
+				return status.Error(codes.PermissionDenied, "access to the collection was revoked")
+			}
+
+		case changeKindCollection:
+			if change.CollectionID != dbCollection.ID {
+				continue
+			}
+
+			event := &censysv1.CollectionEvent{Type: changeEventTypes[change.Op], CollectionUid: req.Uid}
+			if change.Op != "deleted" {
+				current, err := s.queries.GetCollectionByID(ctx, dbCollection.ID)
+				if errors.Is(err, pgx.ErrNoRows) {
+					// deleted again before we got to it, the deleted change follows
+					continue
+				}
+				if err != nil {
+					return status.Errorf(codes.Internal, "failed to get collection: %v", err)
+				}
+				if !checkAccess(ctx, s.queries, current.ID, userID) {
+					return status.Error(codes.PermissionDenied, "access to the collection was revoked")
+				}
+
+				dbCollection = current
+				if event.Collection, err = dbCollectionToProto(current); err != nil {
+					return status.Errorf(codes.Internal, "failed to convert collection: %v", err)
+				}
+			}
+
+			if err := stream.Send(event); err != nil {
+				return err
+			}
+			if change.Op == "deleted" {
+				return nil
+			}
+		}
+	}
+}
+
+func (s *CollectionServer) WatchOrganizationCollections(req *censysv1.WatchOrganizationCollectionsRequest, stream grpc.ServerStreamingServer[censysv1.CollectionEvent]) error {
+	ctx := stream.Context()
+
+	userID, err := middleware.UserIDFromContext(ctx)
+	if err != nil {
+		return status.Error(codes.Unauthenticated, "authentication required")
+	}
+
+	changes := s.changes.subscribe()
+	defer s.changes.unsubscribe(changes)
+
+	org, err := s.memberOrganization(ctx, userID, req.OrganizationUid)
+	if err != nil {
+		return err
+	}
+	orgID := pgtype.Int4{Int32: org.ID, Valid: true}
+	s.changes.narrow(changes, changeFilter{organizationID: org.ID, userID: userID})
+
+	for {
+		change, err := nextChange(ctx, changes)
+		if err != nil {
+			return err
+		}
+
+		switch change.Kind {
+		case changeKindMembership:
+			if change.UserID != userID || change.OrganizationID != orgID {
+				continue
+			}
+			if _, err := s.queries.IsUserInOrganization(ctx, db.IsUserInOrganizationParams{
+				UserID:         userID,
+				OrganizationID: org.ID,
+			}); err != nil {
+				return status.Error(codes.PermissionDenied, "user not in organization")
+			}
+
+		case changeKindCollection:
+			if change.OrganizationID != orgID && change.OldOrganizationID != orgID {
+				continue
+			}
+
+			event, err := s.organizationEvent(ctx, change, orgID, userID)
+			if err != nil {
+				return err
+			}
+			if event == nil {
+				continue
+			}
+			if err := stream.Send(event); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// organizationEvent describes a change as seen by an organization watcher. Collections moving in or out of
+// the organization's view are access changes, and changes the watcher never could see return nil.
+func (s *CollectionServer) organizationEvent(ctx context.Context, change collectionChange, orgID pgtype.Int4, userID int32) (*censysv1.CollectionEvent, error) {
+	event := &censysv1.CollectionEvent{Type: changeEventTypes[change.Op], CollectionUid: change.CollectionUID}
+	wasVisible := change.OldOrganizationID == orgID && change.OldAccessLevel == db.AccessLevelOrganization
+
+	if change.Op == "deleted" {
+		if !wasVisible {
+			return nil, nil
+		}
+		return event, nil
+	}
+
+	current, err := s.queries.GetCollectionByID(ctx, change.CollectionID)
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return nil, status.Errorf(codes.Internal, "failed to get collection: %v", err)
+	}
+	visible := err == nil && current.OrganizationID == orgID && checkAccess(ctx, s.queries, current.ID, userID)
+
+	switch {
+	case visible:
+		if event.Collection, err = dbCollectionToProto(current); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to convert collection: %v", err)
+		}
+		if !wasVisible && change.Op == "updated" {
+			event.Type = censysv1.CollectionEventType_COLLECTION_EVENT_TYPE_ACCESS_CHANGED
+		}
+		return event, nil
+	case wasVisible:
+		event.Type = censysv1.CollectionEventType_COLLECTION_EVENT_TYPE_ACCESS_CHANGED
+		return event, nil
+	default:
+		return nil, nil
+	}
+}
+
+// WatchSharedCollection needs no authentication, opening it counts as one access on the token. The stream ends
+// when the token is revoked, suspended or expires, and when the collection is deleted or stops being shared,
+// by the same rule GetSharedCollection applies.
+func (s *CollectionServer) WatchSharedCollection(req *censysv1.WatchSharedCollectionRequest, stream grpc.ServerStreamingServer[censysv1.SharedCollectionResponse]) error {
+	if req.Token == "" {
+		return status.Error(codes.InvalidArgument, "token is required")
+	}
+	ctx := stream.Context()
+
+	changes := s.changes.subscribe()
+	defer s.changes.unsubscribe(changes)
+
+	shareLink, dbCollection, err := s.sharedCollection(ctx, req.Token)
+	if err != nil {
+		return err
+	}
+	s.changes.narrow(changes, changeFilter{collectionID: shareLink.CollectionID})
+	if err := sendSharedCollection(stream, shareLink, dbCollection); err != nil {
+		return err
+	}
+
+	// tokens cannot be extended so the expiry known now stays the deadline
+	watchCtx := ctx
+	if shareLink.ExpiresAt.Valid {
+		var cancel context.CancelFunc
+		watchCtx, cancel = context.WithDeadline(ctx, shareLink.ExpiresAt.Time)
+		defer cancel()
+	}
+
+	for {
+		change, err := nextChange(watchCtx, changes)
+		if err != nil {
+			if ctx.Err() == nil && watchCtx.Err() != nil {
+				return status.Error(codes.PermissionDenied, "share token expired")
+			}
+			return err
+		}
+
+		switch change.Kind {
+		case changeKindShareLink:
+			if change.ShareLinkID != shareLink.ID {
+				continue
+			}
+			current, err := s.queries.GetShareLinkByToken(ctx, req.Token)
+			if err != nil {
+				return status.Error(codes.NotFound, "share token revoked")
+			}
+			if current.SuspendedAt.Valid {
+				return status.Error(codes.PermissionDenied, "share token suspended")
+			}
+			shareLink = current
+
+		case changeKindCollection:
+			if change.CollectionID != shareLink.CollectionID {
+				continue
+			}
+			if change.Op == "deleted" {
+				return status.Error(codes.NotFound, "collection deleted")
+			}
+
+			current, err := s.queries.GetCollectionByID(ctx, shareLink.CollectionID)
+			if errors.Is(err, pgx.ErrNoRows) {
+				return status.Error(codes.NotFound, "collection deleted")
+			}
+			if err != nil {
+				return status.Errorf(codes.Internal, "failed to get collection: %v", err)
+			}
+			if err := checkShared(current); err != nil {
+				return err
+			}
+			if err := sendSharedCollection(stream, shareLink, current); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func sendSharedCollection(stream grpc.ServerStreamingServer[censysv1.SharedCollectionResponse], shareLink db.ShareLink, c db.Collection) error {
+	protoCollection, err := dbCollectionToProto(c)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to convert collection: %v", err)
+	}
+
+	return stream.Send(&censysv1.SharedCollectionResponse{
+		Collection:         protoCollection,
+		AccessCount:        shareLink.AccessCount,
+		Etag:               formatEtag(c.Revision),
+		CacheMaxAgeSeconds: shareLink.CacheMaxAgeSeconds.Int32,
+	})
+}
+
+// nextChange waits for the next change, ending the stream when the client goes away or the feed drops it.
+func nextChange(ctx context.Context, changes chan collectionChange) (collectionChange, error) {
+	select {
+	case <-ctx.Done():
+		return collectionChange{}, status.FromContextError(ctx.Err()).Err()
+	case change, ok := <-changes:
+		if !ok {
+			return collectionChange{}, errChangeFeedInterrupted
+		}
+		return change, nil
+	}
+}
@@ -0,0 +1,118 @@
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	censysv1 "github.com/ajscimone/censys-challenge/gen/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+// sharedWatchStream hands every response WatchSharedCollection sends to sent.
+type sharedWatchStream struct {
+	grpc.ServerStream
+	ctx  context.Context
+	sent chan *censysv1.SharedCollectionResponse
+}
+
+func (s *sharedWatchStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *sharedWatchStream) Send(resp *censysv1.SharedCollectionResponse) error {
+	s.sent <- resp
+	return nil
+}
+
+// watchShared starts WatchSharedCollection for token and waits for the initial snapshot. The returned channel
+// receives the error the stream ends with.
+func (e *testEnv) watchShared(t *testing.T, token string) <-chan error {
+	t.Helper()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	t.Cleanup(cancel)
+	stream := &sharedWatchStream{ctx: ctx, sent: make(chan *censysv1.SharedCollectionResponse, 16)}
+
+	done := make(chan error, 1)
+	go func() {
+		done <- e.collections.WatchSharedCollection(&censysv1.WatchSharedCollectionRequest{Token: token}, stream)
+	}()
+
+	select {
+	case <-stream.sent:
+	case err := <-done:
+		t.Fatalf("watch ended before the initial snapshot: %v", err)
+	}
+	return done
+}
+
+func TestWatchSharedCollection_EndsWhenNoLongerShared(t *testing.T) {
+	env := newTestEnv(t)
+	_, ctx := env.newUser(t)
+
+	collection, err := env.collections.CreateCollection(ctx, &censysv1.CreateCollectionRequest{
+		Name:        "shared",
+		AccessLevel: censysv1.AccessLevel_ACCESS_LEVEL_SHARED,
+	})
+	if err != nil {
+		t.Fatalf("failed to create collection: %v", err)
+	}
+	shareToken, err := env.collections.CreateShareToken(ctx, &censysv1.CreateShareTokenRequest{CollectionUid: collection.Uid})
+	if err != nil {
+		t.Fatalf("failed to create share token: %v", err)
+	}
+
+	done := env.watchShared(t, shareToken.Token)
+
+	if _, err := env.collections.UpdateCollection(ctx, &censysv1.UpdateCollectionRequest{
+		Uid:         collection.Uid,
+		AccessLevel: censysv1.AccessLevel_ACCESS_LEVEL_PRIVATE,
+		UpdateMask:  &fieldmaskpb.FieldMask{Paths: []string{"access_level"}},
+	}); err != nil {
+		t.Fatalf("failed to make collection private: %v", err)
+	}
+	// the change feed is not running in tests, publish what its trigger would have sent
+	env.collections.changes.publish(collectionChange{
+		Kind:         changeKindCollection,
+		Op:           "access_changed",
+		CollectionID: env.collectionByUID(t, collection.Uid).ID,
+	})
+
+	if err := <-done; status.Code(err) != codes.PermissionDenied {
+		t.Fatalf("expected PermissionDenied once the collection is private, got %v", err)
+	}
+}
+
+func TestWatchSharedCollection_EndsWhenTokenExpires(t *testing.T) {
+	env := newTestEnv(t)
+	_, ctx := env.newUser(t)
+
+	collection, err := env.collections.CreateCollection(ctx, &censysv1.CreateCollectionRequest{
+		Name:        "shared",
+		AccessLevel: censysv1.AccessLevel_ACCESS_LEVEL_SHARED,
+	})
+	if err != nil {
+		t.Fatalf("failed to create collection: %v", err)
+	}
+	shareToken, err := env.collections.CreateShareToken(ctx, &censysv1.CreateShareTokenRequest{
+		CollectionUid:    collection.Uid,
+		ExpiresInSeconds: 1,
+	})
+	if err != nil {
+		t.Fatalf("failed to create share token: %v", err)
+	}
+
+	done := env.watchShared(t, shareToken.Token)
+
+	if err := <-done; status.Code(err) != codes.PermissionDenied {
+		t.Fatalf("expected PermissionDenied once the token expires, got %v", err)
+	}
+	_, err = env.collections.GetSharedCollection(context.Background(), &censysv1.GetSharedCollectionRequest{Token: shareToken.Token})
+	if status.Code(err) != codes.PermissionDenied {
+		t.Fatalf("an expired token should be refused, got %v", err)
+	}
+}
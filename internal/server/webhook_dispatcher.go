@@ -0,0 +1,169 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/ajscimone/censys-challenge/internal/db"
+	"github.com/ajscimone/censys-challenge/internal/webhooks"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// webhookBatchSize is how many events are fanned out and how many deliveries are attempted at once.
+const webhookBatchSize = 32
+
+type WebhookDispatcherConfig struct {
+	Interval    time.Duration
+	Timeout     time.Duration
+	MaxAttempts int32
+	BackoffBase time.Duration
+	BackoffMax  time.Duration
+	// EventRetention is how long dispatched events are kept once every delivery of them succeeded.
+	EventRetention time.Duration
+}
+
+// webhookEvent is the JSON body POSTed to subscribers.
+type webhookEvent struct {
+	ID              string          `json:"id"`
+	Type            string          `json:"type"`
+	OrganizationUID string          `json:"organization_uid"`
+	CreatedAt       time.Time       `json:"created_at"`
+	Data            json.RawMessage `json:"data"`
+}
+
+// RunWebhookDispatcher fans outbox events out to webhook subscriptions and delivers them, checking every
+// interval until ctx is cancelled. Deliveries are claimed with SKIP LOCKED so several replicas can run it.
+func RunWebhookDispatcher(ctx context.Context, queries *db.Queries, config WebhookDispatcherConfig) {
+	client := webhooks.NewClient(config.Timeout)
+	ticker := time.NewTicker(config.Interval)
+	defer ticker.Stop()
+
+	for {
+		dispatchWebhooks(ctx, queries, client, config)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func dispatchWebhooks(ctx context.Context, queries *db.Queries, client *http.Client, config WebhookDispatcherConfig) {
+	for {
+		fannedOut, err := queries.FanOutOutboxEvents(ctx, webhookBatchSize)
+		if err != nil {
+			if ctx.Err() == nil {
+				log.Printf("Failed to fan out outbox events: %v", err)
+			}
+			return
+		}
+		if fannedOut < webhookBatchSize {
+			break
+		}
+	}
+
+	for {
+		deliveries, err := queries.ClaimDueWebhookDeliveries(ctx, db.ClaimDueWebhookDeliveriesParams{
+			// long enough that a delivery still in flight is not claimed again
+			LeaseSeconds: int32(2*config.Timeout/time.Second) + 1,
+			BatchSize:    webhookBatchSize,
+		})
+		if err != nil {
+			if ctx.Err() == nil {
+				log.Printf("Failed to claim webhook deliveries: %v", err)
+			}
+			return
+		}
+
+		var wg sync.WaitGroup
+		for _, delivery := range deliveries {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				deliverWebhook(ctx, queries, client, config, delivery)
+			}()
+		}
+		wg.Wait()
+
+		if len(deliveries) < webhookBatchSize {
+			break
+		}
+	}
+
+	cutoff := pgtype.Timestamptz{Time: time.Now().Add(-config.EventRetention), Valid: true}
+	if _, err := queries.DeleteDeliveredOutboxEvents(ctx, cutoff); err != nil && ctx.Err() == nil {
+		log.Printf("Failed to delete delivered outbox events: %v", err)
+	}
+}
+
+func deliverWebhook(ctx context.Context, queries *db.Queries, client *http.Client, config WebhookDispatcherConfig, delivery db.ClaimDueWebhookDeliveriesRow) {
+	sendErr := postWebhook(ctx, client, delivery)
+	if ctx.Err() != nil {
+		// shutting down, the lease runs out and another dispatcher picks it up
+		return
+	}
+
+	if sendErr == nil {
+		if err := queries.MarkWebhookDelivered(ctx, delivery.ID); err != nil {
+			log.Printf("Failed to mark webhook delivery %d delivered: %v", delivery.ID, err)
+		}
+		return
+	}
+
+	failures := int(delivery.Attempts) + 1
+	next := time.Now().Add(webhooks.Backoff(failures, config.BackoffBase, config.BackoffMax))
+	if err := queries.MarkWebhookDeliveryFailed(ctx, db.MarkWebhookDeliveryFailedParams{
+		LastError:     pgtype.Text{String: sendErr.Error(), Valid: true},
+		NextAttemptAt: pgtype.Timestamptz{Time: next, Valid: true},
+		MaxAttempts:   config.MaxAttempts,
+		ID:            delivery.ID,
+	}); err != nil {
+		log.Printf("Failed to record webhook delivery %d failure: %v", delivery.ID, err)
+	}
+}
+
+func postWebhook(ctx context.Context, client *http.Client, delivery db.ClaimDueWebhookDeliveriesRow) error {
+	body, err := json.Marshal(webhookEvent{
+		ID:              delivery.EventUid.String(),
+		Type:            delivery.EventType,
+		OrganizationUID: delivery.OrganizationUid.String(),
+		CreatedAt:       delivery.EventCreatedAt.Time,
+		Data:            delivery.Payload,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to encode event: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, delivery.Url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to build request: %w", err)
+	}
+
+	now := time.Now()
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(webhooks.EventHeader, delivery.EventType)
+	req.Header.Set(webhooks.DeliveryHeader, strconv.FormatInt(int64(delivery.ID), 10))
+	req.Header.Set(webhooks.TimestampHeader, strconv.FormatInt(now.Unix(), 10))
+	req.Header.Set(webhooks.SignatureHeader, webhooks.Sign(delivery.Secret, now, body))
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("unexpected status %s", resp.Status)
+	}
+	return nil
+}
@@ -0,0 +1,262 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"github.com/ajscimone/censys-challenge/gen/proto"
+	"github.com/ajscimone/censys-challenge/internal/db"
+	"github.com/ajscimone/censys-challenge/internal/middleware"
+	"github.com/ajscimone/censys-challenge/internal/webhooks"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *CollectionServer) CreateWebhookSubscription(ctx context.Context, req *censysv1.CreateWebhookSubscriptionRequest) (*censysv1.WebhookSubscription, error) {
+	userID, err := middleware.UserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "authentication required")
+	}
+
+	if req.OrganizationUid == "" {
+		return nil, status.Error(codes.InvalidArgument, "organization_uid is required")
+	}
+	if err := webhooks.ValidateURL(req.Url); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err := webhooks.ValidateEventTypes(req.EventTypes); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	org, err := s.memberOrganization(ctx, userID, req.OrganizationUid)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.requireOrganizationAdmin(ctx, userID, org.ID); err != nil {
+		return nil, err
+	}
+
+	secret, err := generateSecureToken()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to generate secret: %v", err)
+	}
+
+	eventTypes := req.EventTypes
+	if eventTypes == nil {
+		eventTypes = []string{}
+	}
+	subscription, err := s.queries.CreateWebhookSubscription(ctx, db.CreateWebhookSubscriptionParams{
+		OrganizationID: org.ID,
+		Url:            req.Url,
+		Secret:         secret,
+		EventTypes:     eventTypes,
+		CreatedBy:      pgtype.Int4{Int32: userID, Valid: true},
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create webhook subscription: %v", err)
+	}
+
+	resp := dbWebhookSubscriptionToProto(subscription, org)
+	resp.Secret = subscription.Secret
+	return resp, nil
+}
+
+func (s *CollectionServer) ListWebhookSubscriptions(ctx context.Context, req *censysv1.ListWebhookSubscriptionsRequest) (*censysv1.ListWebhookSubscriptionsResponse, error) {
+	userID, err := middleware.UserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "authentication required")
+	}
+
+	if req.OrganizationUid == "" {
+		return nil, status.Error(codes.InvalidArgument, "organization_uid is required")
+	}
+
+	org, err := s.memberOrganization(ctx, userID, req.OrganizationUid)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.requireOrganizationAdmin(ctx, userID, org.ID); err != nil {
+		return nil, err
+	}
+
+	subscriptions, err := s.queries.ListWebhookSubscriptions(ctx, org.ID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list webhook subscriptions: %v", err)
+	}
+
+	resp := &censysv1.ListWebhookSubscriptionsResponse{}
+	for _, subscription := range subscriptions {
+		resp.Subscriptions = append(resp.Subscriptions, dbWebhookSubscriptionToProto(subscription, org))
+	}
+	return resp, nil
+}
+
+func (s *CollectionServer) DeleteWebhookSubscription(ctx context.Context, req *censysv1.DeleteWebhookSubscriptionRequest) (*emptypb.Empty, error) {
+	subscription, err := s.adminWebhookSubscription(ctx, req.Uid)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.queries.DeleteWebhookSubscription(ctx, subscription.ID); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete webhook subscription: %v", err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *CollectionServer) ListWebhookDeliveries(ctx context.Context, req *censysv1.ListWebhookDeliveriesRequest) (*censysv1.ListWebhookDeliveriesResponse, error) {
+	subscription, err := s.adminWebhookSubscription(ctx, req.SubscriptionUid)
+	if err != nil {
+		return nil, err
+	}
+
+	pageSize := clampPageSize(req.PageSize)
+	before, err := parseIDPageToken(req.PageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	var deliveryStatus db.NullWebhookDeliveryStatus
+	if req.Status != censysv1.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_UNSPECIFIED {
+		deliveryStatus = db.NullWebhookDeliveryStatus{WebhookDeliveryStatus: protoDeliveryStatusToDB(req.Status), Valid: true}
+	}
+
+	deliveries, err := s.queries.ListWebhookDeliveries(ctx, db.ListWebhookDeliveriesParams{
+		SubscriptionID: subscription.ID,
+		Status:         deliveryStatus,
+		BeforeID:       before,
+		PageSize:       pageSize,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list webhook deliveries: %v", err)
+	}
+
+	resp := &censysv1.ListWebhookDeliveriesResponse{}
+	for _, d := range deliveries {
+		resp.Deliveries = append(resp.Deliveries, dbWebhookDeliveryToProto(d))
+	}
+	if len(deliveries) == int(pageSize) {
+		resp.NextPageToken = strconv.FormatInt(int64(deliveries[len(deliveries)-1].ID), 10)
+	}
+
+	return resp, nil
+}
+
+func (s *CollectionServer) RetryWebhookDelivery(ctx context.Context, req *censysv1.RetryWebhookDeliveryRequest) (*emptypb.Empty, error) {
+	subscription, err := s.adminWebhookSubscription(ctx, req.SubscriptionUid)
+	if err != nil {
+		return nil, err
+	}
+
+	retried, err := s.queries.RetryWebhookDelivery(ctx, db.RetryWebhookDeliveryParams{
+		ID:             req.DeliveryId,
+		SubscriptionID: subscription.ID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to retry webhook delivery: %v", err)
+	}
+	if retried == 0 {
+		return nil, status.Error(codes.FailedPrecondition, "no dead delivery with that id")
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// adminWebhookSubscription loads a subscription by uid for a caller with the admin role in its organization.
+func (s *CollectionServer) adminWebhookSubscription(ctx context.Context, uid string) (db.WebhookSubscription, error) {
+	userID, err := middleware.UserIDFromContext(ctx)
+	if err != nil {
+		return db.WebhookSubscription{}, status.Error(codes.Unauthenticated, "authentication required")
+	}
+
+	if uid == "" {
+		return db.WebhookSubscription{}, status.Error(codes.InvalidArgument, "subscription uid is required")
+	}
+
+	var subscriptionUUID pgtype.UUID
+	if err := subscriptionUUID.Scan(uid); err != nil {
+		return db.WebhookSubscription{}, status.Errorf(codes.InvalidArgument, "invalid subscription uid: %v", err)
+	}
+
+	subscription, err := s.queries.GetWebhookSubscriptionByUID(ctx, subscriptionUUID)
+	if err != nil {
+		return db.WebhookSubscription{}, status.Errorf(codes.NotFound, "webhook subscription not found: %v", err)
+	}
+
+	if err := s.requireOrganizationAdmin(ctx, userID, subscription.OrganizationID); err != nil {
+		return db.WebhookSubscription{}, err
+	}
+
+	return subscription, nil
+}
+
+func (s *CollectionServer) requireOrganizationAdmin(ctx context.Context, userID, organizationID int32) error {
+	role, err := s.queries.GetOrganizationMemberRole(ctx, db.GetOrganizationMemberRoleParams{
+		UserID:         userID,
+		OrganizationID: organizationID,
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return status.Error(codes.PermissionDenied, "user not in organization")
+	}
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to check organization role: %v", err)
+	}
+	if role != db.OrganizationRoleAdmin {
+		return status.Error(codes.PermissionDenied, "organization admin role required")
+	}
+	return nil
+}
+
+func dbWebhookSubscriptionToProto(subscription db.WebhookSubscription, org db.Organization) *censysv1.WebhookSubscription {
+	return &censysv1.WebhookSubscription{
+		Uid:             subscription.Uid.String(),
+		OrganizationUid: org.Uid.String(),
+		Url:             subscription.Url,
+		EventTypes:      subscription.EventTypes,
+		CreatedAt:       timestamppb.New(subscription.CreatedAt.Time),
+	}
+}
+
+func dbWebhookDeliveryToProto(d db.ListWebhookDeliveriesRow) *censysv1.WebhookDelivery {
+	delivery := &censysv1.WebhookDelivery{
+		Id:            d.ID,
+		EventUid:      d.EventUid.String(),
+		EventType:     d.EventType,
+		Status:        dbDeliveryStatusToProto(d.Status),
+		Attempts:      d.Attempts,
+		LastError:     d.LastError.String,
+		NextAttemptAt: timestamppb.New(d.NextAttemptAt.Time),
+		CreatedAt:     timestamppb.New(d.CreatedAt.Time),
+	}
+	if d.DeliveredAt.Valid {
+		delivery.DeliveredAt = timestamppb.New(d.DeliveredAt.Time)
+	}
+	return delivery
+}
+
+func protoDeliveryStatusToDB(s censysv1.WebhookDeliveryStatus) db.WebhookDeliveryStatus {
+	switch s {
+	case censysv1.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_DELIVERED:
+		return db.WebhookDeliveryStatusDelivered
+	case censysv1.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_DEAD:
+		return db.WebhookDeliveryStatusDead
+	default:
+		return db.WebhookDeliveryStatusPending
+	}
+}
+
+func dbDeliveryStatusToProto(s db.WebhookDeliveryStatus) censysv1.WebhookDeliveryStatus {
+	switch s {
+	case db.WebhookDeliveryStatusDelivered:
+		return censysv1.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_DELIVERED
+	case db.WebhookDeliveryStatusDead:
+		return censysv1.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_DEAD
+	default:
+		return censysv1.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_PENDING
+	}
+}
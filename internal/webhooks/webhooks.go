@@ -0,0 +1,172 @@
+// Package webhooks signs webhook requests and holds the rules for subscriptions and retries.
+//
+// Receivers verify a request by computing HMAC-SHA256 over the timestamp header, a '.', and the raw body
+// with the subscription secret, and comparing it to the signature header.
+package webhooks
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+)
+
+const (
+	SignatureHeader = "X-Webhook-Signature"
+	TimestampHeader = "X-Webhook-Timestamp"
+	EventHeader     = "X-Webhook-Event"
+	DeliveryHeader  = "X-Webhook-Delivery"
+)
+
+// EventTypes are the events the outbox triggers record.
+var EventTypes = []string{
+	"collection.created",
+	"collection.updated",
+	"collection.deleted",
+	"collection.access_changed",
+	"share_link.created",
+	"share_link.revoked",
+}
+
+// Sign returns the signature header value for body sent at timestamp.
+func Sign(secret string, timestamp time.Time, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp.Unix(), 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verify checks a signature header value in constant time.
+func Verify(secret string, timestamp time.Time, body []byte, signature string) bool {
+	return hmac.Equal([]byte(Sign(secret, timestamp, body)), []byte(signature))
+}
+
+// Backoff is the delay before retrying after the given number of failed attempts, doubling from base up to max.
+func Backoff(failures int, base, max time.Duration) time.Duration {
+	delay := base
+	for i := 1; i < failures; i++ {
+		delay *= 2
+		if delay >= max {
+			return max
+		}
+	}
+	return min(delay, max)
+}
+
+// ValidateURL accepts absolute http and https URLs. Hosts that are obviously not public are rejected here,
+// names that resolve to private addresses are caught by NewClient when delivering.
+func ValidateURL(raw string) error {
+	u, err := url.Parse(raw)
+	if err != nil {
+		return fmt.Errorf("invalid url: %w", err)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return fmt.Errorf("url %q must use http or https", raw)
+	}
+	host := u.Hostname()
+	if host == "" {
+		return fmt.Errorf("url %q has no host", raw)
+	}
+	if host == "localhost" || strings.HasSuffix(host, ".localhost") {
+		return fmt.Errorf("url %q must not point at localhost", raw)
+	}
+	if addr, err := netip.ParseAddr(host); err == nil && !PublicAddress(addr) {
+		return fmt.Errorf("url %q must not point at a private address", raw)
+	}
+	return nil
+}
+
+var errPrivateAddress = errors.New("webhook address is not public")
+
+// nonPublicPrefixes are ranges PublicAddress rejects on top of what netip classifies as private or local.
+var nonPublicPrefixes = []netip.Prefix{
+	netip.MustParsePrefix("0.0.0.0/8"),
+	netip.MustParsePrefix("100.64.0.0/10"), // carrier grade NAT
+	netip.MustParsePrefix("192.0.0.0/24"),
+	netip.MustParsePrefix("198.18.0.0/15"), // benchmarking
+	netip.MustParsePrefix("240.0.0.0/4"),
+	netip.MustParsePrefix("64:ff9b::/96"), // NAT64 can reach any IPv4 address
+}
+
+// PublicAddress reports whether addr can be reached on the internet, webhooks are never delivered to
+// loopback, private, link local or otherwise internal addresses.
+func PublicAddress(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	if !addr.IsGlobalUnicast() || addr.IsPrivate() {
+		return false
+	}
+	for _, prefix := range nonPublicPrefixes {
+		if prefix.Contains(addr) {
+			return false
+		}
+	}
+	return true
+}
+
+// NewClient returns the HTTP client deliveries go through. Every address it connects to is checked after
+// DNS resolution so a subscription cannot reach internal services, and redirects are not followed since a
+// redirect is a request to a URL nobody validated.
+func NewClient(timeout time.Duration) *http.Client {
+	return newClient(timeout, dialControl)
+}
+
+func newClient(timeout time.Duration, control func(network, address string, c syscall.RawConn) error) *http.Client {
+	dialer := &net.Dialer{Timeout: timeout, Control: control}
+	return &http.Client{
+		Timeout: timeout,
+		Transport: &http.Transport{
+			// a proxy would make the dial checks see the proxy's address instead of the receiver's
+			Proxy:               nil,
+			DialContext:         dialer.DialContext,
+			TLSHandshakeTimeout: timeout,
+			MaxIdleConns:        100,
+			IdleConnTimeout:     90 * time.Second,
+		},
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+}
+
+func dialControl(network, address string, c syscall.RawConn) error {
+	addrPort, err := netip.ParseAddrPort(address)
+	if err != nil {
+		return fmt.Errorf("%w: %v", errPrivateAddress, err)
+	}
+	if !PublicAddress(addrPort.Addr()) {
+		return fmt.Errorf("%w: %s", errPrivateAddress, addrPort.Addr())
+	}
+	return nil
+}
+
+// ValidateEventTypes rejects unknown and duplicate event types. No event types means every event.
+func ValidateEventTypes(eventTypes []string) error {
+	seen := make(map[string]bool, len(eventTypes))
+	for _, eventType := range eventTypes {
+		known := false
+		for _, t := range EventTypes {
+			if t == eventType {
+				known = true
+				break
+			}
+		}
+		if !known {
+			return fmt.Errorf("unknown event type %q", eventType)
+		}
+		if seen[eventType] {
+			return fmt.Errorf("event type %q is listed twice", eventType)
+		}
+		seen[eventType] = true
+	}
+	return nil
+}
@@ -0,0 +1,140 @@
+package webhooks
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"testing"
+	"time"
+)
+
+func TestSignAndVerify(t *testing.T) {
+	at := time.Unix(1700000000, 0)
+	body := []byte(`{"type":"collection.updated"}`)
+
+	signature := Sign("secret", at, body)
+	// echo -n '1700000000.{"type":"collection.updated"}' | openssl dgst -sha256 -hmac secret
+	if want := "sha256=1ce5afdac5b2e24a57d164010ae82046be107f6bfecb0b74c28b7062a9540679"; signature != want {
+		t.Fatalf("Sign() = %q, want %q", signature, want)
+	}
+	if !Verify("secret", at, body, signature) {
+		t.Fatal("signature should verify")
+	}
+
+	if Verify("other", at, body, signature) {
+		t.Fatal("signature should not verify with another secret")
+	}
+	if Verify("secret", at.Add(time.Second), body, signature) {
+		t.Fatal("signature should not verify with another timestamp")
+	}
+	if Verify("secret", at, []byte(`{}`), signature) {
+		t.Fatal("signature should not verify with another body")
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	base, max := 30*time.Second, 10*time.Minute
+	for failures, want := range map[int]time.Duration{
+		0:  base,
+		1:  base,
+		2:  time.Minute,
+		3:  2 * time.Minute,
+		5:  8 * time.Minute,
+		6:  max,
+		40: max,
+	} {
+		if got := Backoff(failures, base, max); got != want {
+			t.Fatalf("Backoff(%d) = %v, want %v", failures, got, want)
+		}
+	}
+}
+
+func TestValidateURL(t *testing.T) {
+	for _, valid := range []string{"https://example.com/hooks", "http://93.184.216.34:8080", "https://[2606:4700::1111]/hooks"} {
+		if err := ValidateURL(valid); err != nil {
+			t.Fatalf("%q should be valid: %v", valid, err)
+		}
+	}
+	for _, invalid := range []string{
+		"", "example.com/hooks", "ftp://example.com", "https://",
+		"http://localhost:8080", "http://api.localhost", "http://127.0.0.1", "http://10.0.0.5/hooks",
+		"http://169.254.169.254/latest/meta-data", "http://[::1]:8080", "http://[::ffff:192.168.1.1]",
+	} {
+		if err := ValidateURL(invalid); err == nil {
+			t.Fatalf("%q should be rejected", invalid)
+		}
+	}
+}
+
+func TestPublicAddress(t *testing.T) {
+	for addr, want := range map[string]bool{
+		"93.184.216.34":   true,
+		"2606:4700::1111": true,
+		"127.0.0.1":       false,
+		"10.1.2.3":        false,
+		"172.16.0.1":      false,
+		"192.168.0.1":     false,
+		"169.254.169.254": false,
+		"100.64.0.1":      false,
+		"0.0.0.0":         false,
+		"224.0.0.1":       false,
+		"::1":             false,
+		"fd00::1":         false,
+		"fe80::1":         false,
+		"::ffff:10.0.0.1": false,
+		"64:ff9b::a00:1":  false,
+	} {
+		if got := PublicAddress(netip.MustParseAddr(addr)); got != want {
+			t.Fatalf("PublicAddress(%s) = %v, want %v", addr, got, want)
+		}
+	}
+}
+
+func TestNewClient_RefusesPrivateAddresses(t *testing.T) {
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Error("the receiver on loopback should not be reached")
+	}))
+	defer receiver.Close()
+
+	_, err := NewClient(time.Second).Post(receiver.URL, "application/json", nil)
+	if !errors.Is(err, errPrivateAddress) {
+		t.Fatalf("expected the dial to be refused, got %v", err)
+	}
+}
+
+func TestNewClient_DoesNotFollowRedirects(t *testing.T) {
+	internal := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Error("the redirect should not be followed")
+	}))
+	defer internal.Close()
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, internal.URL, http.StatusTemporaryRedirect)
+	}))
+	defer receiver.Close()
+
+	// both servers are on loopback, so only the redirect policy is under test here
+	resp, err := newClient(time.Second, nil).Post(receiver.URL, "application/json", nil)
+	if err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusTemporaryRedirect {
+		t.Fatalf("expected the redirect itself, got %s", resp.Status)
+	}
+}
+
+func TestValidateEventTypes(t *testing.T) {
+	if err := ValidateEventTypes(nil); err != nil {
+		t.Fatalf("no event types should be valid: %v", err)
+	}
+	if err := ValidateEventTypes([]string{"collection.created", "share_link.revoked"}); err != nil {
+		t.Fatalf("known event types should be valid: %v", err)
+	}
+	if err := ValidateEventTypes([]string{"collection.exploded"}); err == nil {
+		t.Fatal("unknown event type should be rejected")
+	}
+	if err := ValidateEventTypes([]string{"collection.created", "collection.created"}); err == nil {
+		t.Fatal("duplicate event type should be rejected")
+	}
+}
@@ -0,0 +1,98 @@
+package webrpc
+
+import (
+	"bytes"
+	"fmt"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// frame is one message in protobuf binary encoding, the form it is forwarded to the gRPC server in.
+type frame struct {
+	data []byte
+}
+
+// protoCodec passes protobuf messages through as they are.
+type protoCodec struct{}
+
+func (protoCodec) Name() string { return "proto" }
+
+func (protoCodec) Marshal(v any) ([]byte, error) {
+	f, ok := v.(*frame)
+	if !ok {
+		return nil, fmt.Errorf("unexpected message type %T", v)
+	}
+	return f.data, nil
+}
+
+func (protoCodec) Unmarshal(data []byte, v any) error {
+	f, ok := v.(*frame)
+	if !ok {
+		return fmt.Errorf("unexpected message type %T", v)
+	}
+	// connect reuses its read buffers
+	f.data = bytes.Clone(data)
+	return nil
+}
+
+// jsonCodec converts between a method's protobuf messages and their JSON mapping, the same way Connect's
+// own JSON codec does.
+type jsonCodec struct {
+	input  protoreflect.MessageType
+	output protoreflect.MessageType
+}
+
+func (jsonCodec) Name() string { return "json" }
+
+func (c jsonCodec) Marshal(v any) ([]byte, error) {
+	f, ok := v.(*frame)
+	if !ok {
+		return nil, fmt.Errorf("unexpected message type %T", v)
+	}
+	msg := c.output.New().Interface()
+	if err := proto.Unmarshal(f.data, msg); err != nil {
+		return nil, err
+	}
+	return protojson.Marshal(msg)
+}
+
+func (c jsonCodec) Unmarshal(data []byte, v any) error {
+	f, ok := v.(*frame)
+	if !ok {
+		return fmt.Errorf("unexpected message type %T", v)
+	}
+	msg := c.input.New().Interface()
+	if len(data) > 0 {
+		if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(data, msg); err != nil {
+			return err
+		}
+	}
+	var err error
+	f.data, err = proto.Marshal(msg)
+	return err
+}
+
+// rawCodec sends frames to the gRPC server without encoding them again.
+type rawCodec struct{}
+
+func (rawCodec) Name() string { return "proto" }
+
+func (rawCodec) Marshal(v any) ([]byte, error) {
+	f, ok := v.(*frame)
+	if !ok {
+		return nil, fmt.Errorf("unexpected message type %T", v)
+	}
+	return f.data, nil
+}
+
+func (rawCodec) Unmarshal(data []byte, v any) error {
+	f, ok := v.(*frame)
+	if !ok {
+		return fmt.Errorf("unexpected message type %T", v)
+	}
+	// gRPC frees the buffer once this returns
+	f.data = bytes.Clone(data)
+	return nil
+}
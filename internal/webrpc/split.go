@@ -0,0 +1,274 @@
+package webrpc
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"errors"
+	"io"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/net/http2"
+	"golang.org/x/net/http2/hpack"
+	"google.golang.org/grpc/credentials"
+)
+
+// sniffTimeout bounds the TLS handshake and the wait for a connection's first request.
+const sniffTimeout = 10 * time.Second
+
+// Split shares one listener between a gRPC server and an HTTP server so that gRPC is served by its own
+// transport, which GracefulStop and keepalive enforcement need, rather than through ServeHTTP.
+//
+// TLS is terminated here and every connection goes to Native when its first request is native gRPC and to
+// Web otherwise: HTTP/1.1, Connect and gRPC-Web. A client that mixes both on one HTTP/2 connection only
+// reaches the side its first request picked. The gRPC server needs Credentials to see the client's
+// certificate, and the HTTP server has to accept HTTP/2 without TLS since it only sees the decrypted stream.
+type Split struct {
+	lis       net.Listener
+	tlsConfig *tls.Config
+	native    *connListener
+	web       *connListener
+}
+
+// NewSplit splits lis, terminating TLS with tlsConfig unless it is nil.
+func NewSplit(lis net.Listener, tlsConfig *tls.Config) *Split {
+	if tlsConfig != nil {
+		tlsConfig = tlsConfig.Clone()
+		if len(tlsConfig.NextProtos) == 0 {
+			tlsConfig.NextProtos = []string{"h2", "http/1.1"}
+		}
+	}
+	return &Split{
+		lis:       lis,
+		tlsConfig: tlsConfig,
+		native:    newConnListener(lis.Addr()),
+		web:       newConnListener(lis.Addr()),
+	}
+}
+
+// Native is the listener for grpc.Server.Serve.
+func (s *Split) Native() net.Listener {
+	return s.native
+}
+
+// Web is the listener for http.Server.Serve.
+func (s *Split) Web() net.Listener {
+	return s.web
+}
+
+// Serve accepts connections until the listener is closed.
+func (s *Split) Serve() error {
+	for {
+		conn, err := s.lis.Accept()
+		if err != nil {
+			s.native.Close()
+			s.web.Close()
+			return err
+		}
+		go s.route(conn)
+	}
+}
+
+// Close stops accepting connections, ones already handed out are left to their servers.
+func (s *Split) Close() error {
+	return s.lis.Close()
+}
+
+func (s *Split) route(raw net.Conn) {
+	raw.SetDeadline(time.Now().Add(sniffTimeout))
+
+	conn := &splitConn{Conn: raw}
+	if s.tlsConfig != nil {
+		tlsConn := tls.Server(raw, s.tlsConfig)
+		if err := tlsConn.HandshakeContext(context.Background()); err != nil {
+			raw.Close()
+			return
+		}
+		conn.Conn, conn.tls = tlsConn, tlsConn
+	}
+
+	var sniffed bytes.Buffer
+	native, sentSettings := sniff(conn.Conn, io.TeeReader(conn.Conn, &sniffed))
+	conn.reader = io.MultiReader(&sniffed, conn.Conn)
+	if sentSettings {
+		preface := sniffed.Next(len(http2.ClientPreface))
+		conn.reader = io.MultiReader(bytes.NewReader(preface), &settingsAckFilter{r: conn.reader})
+	}
+	raw.SetDeadline(time.Time{})
+
+	if native {
+		s.native.hand(conn)
+	} else {
+		s.web.hand(conn)
+	}
+}
+
+// sniff reads up to the first request's headers from r and reports whether it is HTTP/2 with a gRPC content
+// type. Anything else, including connections that fail before getting there, goes to the web side.
+//
+// gRPC clients wait for the server's SETTINGS before sending a request, so an empty one is written to w for
+// HTTP/2 connections. sentSettings reports that, the client's acknowledgement then has to be kept from the
+// server that gets the connection.
+func sniff(w io.Writer, r io.Reader) (native, sentSettings bool) {
+	preface := make([]byte, len(http2.ClientPreface))
+	for n := 0; n < len(preface); {
+		read, err := r.Read(preface[n:])
+		n += read
+		if !strings.HasPrefix(http2.ClientPreface, string(preface[:n])) {
+			return false, false
+		}
+		if err != nil && n < len(preface) {
+			return false, false
+		}
+	}
+
+	framer := http2.NewFramer(w, r)
+	framer.ReadMetaHeaders = hpack.NewDecoder(4096, nil)
+	if err := framer.WriteSettings(); err != nil {
+		return false, false
+	}
+	for {
+		frame, err := framer.ReadFrame()
+		if err != nil {
+			return false, true
+		}
+		if headers, ok := frame.(*http2.MetaHeadersFrame); ok {
+			return isGRPCContentType(contentType(headers)), true
+		}
+	}
+}
+
+func contentType(headers *http2.MetaHeadersFrame) string {
+	for _, field := range headers.RegularFields() {
+		if field.Name == "content-type" {
+			return field.Value
+		}
+	}
+	return ""
+}
+
+func isGRPCContentType(contentType string) bool {
+	return contentType == "application/grpc" || strings.HasPrefix(contentType, "application/grpc+")
+}
+
+// splitConn replays the bytes read while sniffing before reading on from the connection.
+type splitConn struct {
+	net.Conn
+	reader io.Reader
+	tls    *tls.Conn
+}
+
+func (c *splitConn) Read(p []byte) (int, error) {
+	return c.reader.Read(p)
+}
+
+// settingsAckFilter passes HTTP/2 frames through except for the first SETTINGS acknowledgement, which
+// answers the SETTINGS sniff sent and would be a protocol error to the server that gets the connection.
+type settingsAckFilter struct {
+	r       io.Reader
+	dropped bool
+	pending []byte
+}
+
+func (f *settingsAckFilter) Read(p []byte) (int, error) {
+	for !f.dropped && len(f.pending) == 0 {
+		header := make([]byte, 9)
+		if _, err := io.ReadFull(f.r, header); err != nil {
+			return 0, err
+		}
+		length := int(header[0])<<16 | int(header[1])<<8 | int(header[2])
+		if http2.FrameType(header[3]) == http2.FrameSettings && http2.Flags(header[4]).Has(http2.FlagSettingsAck) {
+			f.dropped = true
+			break
+		}
+		frame := make([]byte, len(header)+length)
+		copy(frame, header)
+		if _, err := io.ReadFull(f.r, frame[len(header):]); err != nil {
+			return 0, err
+		}
+		f.pending = frame
+	}
+
+	if len(f.pending) > 0 {
+		n := copy(p, f.pending)
+		f.pending = f.pending[n:]
+		return n, nil
+	}
+	return f.r.Read(p)
+}
+
+// connListener hands out connections routed to one side of a Split.
+type connListener struct {
+	addr  net.Addr
+	conns chan net.Conn
+	done  chan struct{}
+	once  sync.Once
+}
+
+func newConnListener(addr net.Addr) *connListener {
+	return &connListener{addr: addr, conns: make(chan net.Conn), done: make(chan struct{})}
+}
+
+func (l *connListener) hand(conn net.Conn) {
+	select {
+	case l.conns <- conn:
+	case <-l.done:
+		conn.Close()
+	}
+}
+
+func (l *connListener) Accept() (net.Conn, error) {
+	select {
+	case conn := <-l.conns:
+		return conn, nil
+	case <-l.done:
+		return nil, net.ErrClosed
+	}
+}
+
+func (l *connListener) Close() error {
+	l.once.Do(func() { close(l.done) })
+	return nil
+}
+
+func (l *connListener) Addr() net.Addr {
+	return l.addr
+}
+
+// Credentials are the gRPC server's transport credentials behind a Split with TLS. The handshake already
+// happened, they only report the connection's TLS state so peer certificates reach the interceptors.
+func Credentials() credentials.TransportCredentials {
+	return splitCredentials{}
+}
+
+type splitCredentials struct{}
+
+func (splitCredentials) ClientHandshake(ctx context.Context, authority string, conn net.Conn) (net.Conn, credentials.AuthInfo, error) {
+	return nil, nil, errors.New("split credentials are for servers only")
+}
+
+func (splitCredentials) ServerHandshake(conn net.Conn) (net.Conn, credentials.AuthInfo, error) {
+	split, ok := conn.(*splitConn)
+	if !ok || split.tls == nil {
+		return nil, nil, errors.New("connection did not come through a Split with TLS")
+	}
+	return conn, credentials.TLSInfo{
+		State:          split.tls.ConnectionState(),
+		CommonAuthInfo: credentials.CommonAuthInfo{SecurityLevel: credentials.PrivacyAndIntegrity},
+	}, nil
+}
+
+func (splitCredentials) Info() credentials.ProtocolInfo {
+	return credentials.ProtocolInfo{SecurityProtocol: "tls"}
+}
+
+func (splitCredentials) Clone() credentials.TransportCredentials {
+	return splitCredentials{}
+}
+
+func (splitCredentials) OverrideServerName(string) error {
+	return nil
+}
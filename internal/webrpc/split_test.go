@@ -0,0 +1,154 @@
+package webrpc
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"math/big"
+	"net"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/peer"
+)
+
+func TestSplit_ServesConnectOverHTTP2(t *testing.T) {
+	srv, _ := newServer(t)
+
+	transport := &http.Transport{Protocols: new(http.Protocols)}
+	transport.Protocols.SetUnencryptedHTTP2(true)
+	client := &http.Client{Transport: transport}
+
+	for i := 0; i < 2; i++ {
+		req, _ := http.NewRequest(http.MethodPost, srv.URL+checkProcedure, strings.NewReader(`{"service":"stopped"}`))
+		req.Header.Set("Content-Type", "application/json")
+		resp, err := client.Do(req)
+		if err != nil {
+			t.Fatalf("request failed: %v", err)
+		}
+		resp.Body.Close()
+		if resp.ProtoMajor != 2 || resp.StatusCode != http.StatusOK {
+			t.Fatalf("expected 200 over HTTP/2, got %s %s", resp.Proto, resp.Status)
+		}
+	}
+}
+
+func TestSplit_GracefulStop(t *testing.T) {
+	srv, _ := newServer(t)
+
+	conn, err := grpc.NewClient(srv.addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatalf("failed to connect: %v", err)
+	}
+	defer conn.Close()
+	if _, err := healthpb.NewHealthClient(conn).Check(context.Background(), &healthpb.HealthCheckRequest{}); err != nil {
+		t.Fatalf("native gRPC call failed: %v", err)
+	}
+
+	stopped := make(chan struct{})
+	go func() {
+		srv.grpcServer.GracefulStop()
+		close(stopped)
+	}()
+	select {
+	case <-stopped:
+	case <-time.After(5 * time.Second):
+		t.Fatal("GracefulStop should return once no calls are in flight")
+	}
+}
+
+// selfSigned returns a certificate for 127.0.0.1 usable by both ends of a connection.
+func selfSigned(t *testing.T, name string) tls.Certificate {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: name},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return tls.Certificate{Certificate: [][]byte{der}, PrivateKey: key}
+}
+
+func TestSplit_TLSReportsPeerCertificate(t *testing.T) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to listen: %v", err)
+	}
+	split := NewSplit(lis, &tls.Config{
+		Certificates: []tls.Certificate{selfSigned(t, "server")},
+		ClientAuth:   tls.RequireAnyClientCert,
+	})
+
+	peers := make(chan *peer.Peer, 1)
+	grpcServer := grpc.NewServer(grpc.Creds(Credentials()), grpc.UnaryInterceptor(
+		func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+			p, _ := peer.FromContext(ctx)
+			peers <- p
+			return handler(ctx, req)
+		}))
+	healthpb.RegisterHealthServer(grpcServer, health.NewServer())
+	httpServer := &http.Server{Handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("web"))
+	}), Protocols: new(http.Protocols)}
+	httpServer.Protocols.SetHTTP1(true)
+	httpServer.Protocols.SetUnencryptedHTTP2(true)
+
+	go split.Serve()
+	go grpcServer.Serve(split.Native())
+	go httpServer.Serve(split.Web())
+	defer func() {
+		split.Close()
+		httpServer.Close()
+		grpcServer.Stop()
+	}()
+
+	clientConfig := &tls.Config{InsecureSkipVerify: true, Certificates: []tls.Certificate{selfSigned(t, "client")}}
+	conn, err := grpc.NewClient(lis.Addr().String(), grpc.WithTransportCredentials(credentials.NewTLS(clientConfig)))
+	if err != nil {
+		t.Fatalf("failed to connect: %v", err)
+	}
+	defer conn.Close()
+	if _, err := healthpb.NewHealthClient(conn).Check(context.Background(), &healthpb.HealthCheckRequest{}); err != nil {
+		t.Fatalf("native gRPC call over TLS failed: %v", err)
+	}
+
+	tlsInfo, ok := (<-peers).AuthInfo.(credentials.TLSInfo)
+	if !ok {
+		t.Fatal("the peer should carry TLS info")
+	}
+	if len(tlsInfo.State.PeerCertificates) != 1 || tlsInfo.State.PeerCertificates[0].Subject.CommonName != "client" {
+		t.Fatalf("the client certificate should reach the interceptors, got %v", tlsInfo.State.PeerCertificates)
+	}
+
+	client := &http.Client{Transport: &http.Transport{TLSClientConfig: clientConfig, ForceAttemptHTTP2: true}}
+	resp, err := client.Get("https://" + lis.Addr().String() + "/")
+	if err != nil {
+		t.Fatalf("web request over TLS failed: %v", err)
+	}
+	resp.Body.Close()
+	if resp.ProtoMajor != 2 || resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected 200 over HTTP/2, got %s %s", resp.Proto, resp.Status)
+	}
+}
@@ -0,0 +1,209 @@
+// Package webrpc serves gRPC services to browsers over the Connect protocol and gRPC-Web, on the same port
+// as native gRPC through a Split.
+//
+// Calls are forwarded to the gRPC server over a client connection as encoded protobuf, without decoding
+// them into their Go types, so they reach the same service implementations through the same interceptors
+// as native gRPC calls. Browsers cannot stream request bodies, client streaming methods only work from
+// clients that can and bidirectional methods are not served.
+package webrpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+
+	"connectrpc.com/connect"
+	connectcors "connectrpc.com/cors"
+	"github.com/rs/cors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+type Config struct {
+	// AllowedOrigins are the origins browsers may call from, empty only allows same origin calls.
+	AllowedOrigins []string
+}
+
+// New returns the handler for every method of the named services. conn should point at this process's
+// gRPC server.
+func New(conn *grpc.ClientConn, config Config, serviceNames ...string) (http.Handler, error) {
+	mux := http.NewServeMux()
+	for _, name := range serviceNames {
+		descriptor, err := protoregistry.GlobalFiles.FindDescriptorByName(protoreflect.FullName(name))
+		if err != nil {
+			return nil, fmt.Errorf("failed to find service %s: %w", name, err)
+		}
+		service, ok := descriptor.(protoreflect.ServiceDescriptor)
+		if !ok {
+			return nil, fmt.Errorf("%s is not a service", name)
+		}
+
+		methods := service.Methods()
+		for i := 0; i < methods.Len(); i++ {
+			procedure := "/" + name + "/" + string(methods.Get(i).Name())
+			handler, err := methodHandler(conn, procedure, methods.Get(i))
+			if err != nil {
+				return nil, err
+			}
+			mux.Handle(procedure, handler)
+		}
+	}
+
+	if len(config.AllowedOrigins) == 0 {
+		return mux, nil
+	}
+	return cors.New(cors.Options{
+		AllowedOrigins: config.AllowedOrigins,
+		AllowedMethods: connectcors.AllowedMethods(),
+		AllowedHeaders: append(connectcors.AllowedHeaders(), "Authorization"),
+		ExposedHeaders: connectcors.ExposedHeaders(),
+		MaxAge:         7200,
+	}).Handler(mux), nil
+}
+
+func methodHandler(conn *grpc.ClientConn, procedure string, method protoreflect.MethodDescriptor) (http.Handler, error) {
+	input, err := protoregistry.GlobalTypes.FindMessageByName(method.Input().FullName())
+	if err != nil {
+		return nil, fmt.Errorf("failed to find input of %s: %w", procedure, err)
+	}
+	output, err := protoregistry.GlobalTypes.FindMessageByName(method.Output().FullName())
+	if err != nil {
+		return nil, fmt.Errorf("failed to find output of %s: %w", procedure, err)
+	}
+
+	options := connect.WithHandlerOptions(
+		connect.WithCodec(protoCodec{}),
+		connect.WithCodec(jsonCodec{input: input, output: output}),
+	)
+	f := forwarder{conn: conn, procedure: procedure}
+
+	switch {
+	case method.IsStreamingClient() && method.IsStreamingServer():
+		return nil, fmt.Errorf("%s is bidirectional, which is not supported", procedure)
+	case method.IsStreamingClient():
+		return connect.NewClientStreamHandler(procedure, f.clientStream, options), nil
+	case method.IsStreamingServer():
+		return connect.NewServerStreamHandler(procedure, f.serverStream, options), nil
+	default:
+		return connect.NewUnaryHandler(procedure, f.unary, options), nil
+	}
+}
+
+// forwarder makes the gRPC call for one method.
+type forwarder struct {
+	conn      *grpc.ClientConn
+	procedure string
+}
+
+func (f forwarder) unary(ctx context.Context, req *connect.Request[frame]) (*connect.Response[frame], error) {
+	var resp frame
+	if err := f.conn.Invoke(outgoingContext(ctx, req.Header(), req.Peer()), f.procedure, req.Msg, &resp, grpc.ForceCodec(rawCodec{})); err != nil {
+		return nil, connectError(err)
+	}
+	return connect.NewResponse(&resp), nil
+}
+
+func (f forwarder) serverStream(ctx context.Context, req *connect.Request[frame], stream *connect.ServerStream[frame]) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	clientStream, err := f.conn.NewStream(outgoingContext(ctx, req.Header(), req.Peer()),
+		&grpc.StreamDesc{ServerStreams: true}, f.procedure, grpc.ForceCodec(rawCodec{}))
+	if err != nil {
+		return connectError(err)
+	}
+	// an io.EOF here means the call already ended, RecvMsg returns its status
+	if err := clientStream.SendMsg(req.Msg); err != nil && !errors.Is(err, io.EOF) {
+		return connectError(err)
+	}
+	if err := clientStream.CloseSend(); err != nil {
+		return connectError(err)
+	}
+
+	for {
+		var msg frame
+		if err := clientStream.RecvMsg(&msg); err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return connectError(err)
+		}
+		if err := stream.Send(&msg); err != nil {
+			return err
+		}
+	}
+}
+
+func (f forwarder) clientStream(ctx context.Context, stream *connect.ClientStream[frame]) (*connect.Response[frame], error) {
+	// cancelling tells the server the upload was abandoned if the browser goes away mid stream
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	clientStream, err := f.conn.NewStream(outgoingContext(ctx, stream.RequestHeader(), stream.Peer()),
+		&grpc.StreamDesc{ClientStreams: true}, f.procedure, grpc.ForceCodec(rawCodec{}))
+	if err != nil {
+		return nil, connectError(err)
+	}
+
+	for stream.Receive() {
+		if err := clientStream.SendMsg(stream.Msg()); err != nil {
+			// the server ended the call early, RecvMsg returns why
+			break
+		}
+	}
+	if err := stream.Err(); err != nil {
+		return nil, err
+	}
+	if err := clientStream.CloseSend(); err != nil {
+		return nil, connectError(err)
+	}
+
+	var resp frame
+	if err := clientStream.RecvMsg(&resp); err != nil {
+		return nil, connectError(err)
+	}
+	return connect.NewResponse(&resp), nil
+}
+
+// outgoingContext passes the caller's credentials on and records its address in x-forwarded-for, which
+// the abuse detector trusts from loopback peers.
+func outgoingContext(ctx context.Context, header http.Header, peer connect.Peer) context.Context {
+	md := metadata.MD{}
+	if authorization := header.Get("Authorization"); authorization != "" {
+		md.Set("authorization", authorization)
+	}
+
+	forwarded := header.Values("X-Forwarded-For")
+	if host, _, err := net.SplitHostPort(peer.Addr); err == nil {
+		forwarded = append(forwarded, host)
+	}
+	if len(forwarded) > 0 {
+		md.Set("x-forwarded-for", strings.Join(forwarded, ", "))
+	}
+
+	return metadata.NewOutgoingContext(ctx, md)
+}
+
+// connectError keeps the code, message and details of a gRPC status, without it every error would be
+// reported as unknown.
+func connectError(err error) error {
+	st, ok := status.FromError(err)
+	if !ok {
+		return err
+	}
+
+	connectErr := connect.NewError(connect.Code(st.Code()), errors.New(st.Message()))
+	for _, detail := range st.Proto().GetDetails() {
+		if errorDetail, err := connect.NewErrorDetail(detail); err == nil {
+			connectErr.AddDetail(errorDetail)
+		}
+	}
+	return connectErr
+}
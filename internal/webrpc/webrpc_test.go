@@ -0,0 +1,194 @@
+package webrpc
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+
+	"connectrpc.com/connect"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	checkProcedure = "/grpc.health.v1.Health/Check"
+	watchProcedure = "/grpc.health.v1.Health/Watch"
+)
+
+// recorder keeps the metadata the last call arrived with, standing in for the real interceptor chain.
+type recorder struct {
+	mu sync.Mutex
+	md metadata.MD
+}
+
+func (r *recorder) unary(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	r.mu.Lock()
+	r.md, _ = metadata.FromIncomingContext(ctx)
+	r.mu.Unlock()
+	return handler(ctx, req)
+}
+
+func (r *recorder) get(key string) string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if values := r.md.Get(key); len(values) > 0 {
+		return values[0]
+	}
+	return ""
+}
+
+// testServer is a gRPC server and the web handler sharing one port through a Split, the way main runs them.
+type testServer struct {
+	URL        string
+	addr       string
+	grpcServer *grpc.Server
+	httpServer *http.Server
+}
+
+func newServer(t *testing.T, options ...grpc.ServerOption) (*testServer, *recorder) {
+	t.Helper()
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to listen: %v", err)
+	}
+	split := NewSplit(lis, nil)
+
+	rec := &recorder{}
+	grpcServer := grpc.NewServer(append(options, grpc.UnaryInterceptor(rec.unary))...)
+	healthServer := health.NewServer()
+	healthServer.SetServingStatus("stopped", healthpb.HealthCheckResponse_NOT_SERVING)
+	healthpb.RegisterHealthServer(grpcServer, healthServer)
+
+	conn, err := grpc.NewClient(lis.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatalf("failed to connect: %v", err)
+	}
+	t.Cleanup(func() { conn.Close() })
+
+	web, err := New(conn, Config{}, "grpc.health.v1.Health")
+	if err != nil {
+		t.Fatalf("failed to create handler: %v", err)
+	}
+	protocols := new(http.Protocols)
+	protocols.SetHTTP1(true)
+	protocols.SetUnencryptedHTTP2(true)
+	httpServer := &http.Server{Handler: web, Protocols: protocols}
+
+	go split.Serve()
+	go grpcServer.Serve(split.Native())
+	go httpServer.Serve(split.Web())
+	t.Cleanup(func() {
+		split.Close()
+		httpServer.Close()
+		grpcServer.Stop()
+	})
+
+	return &testServer{
+		URL:        "http://" + lis.Addr().String(),
+		addr:       lis.Addr().String(),
+		grpcServer: grpcServer,
+		httpServer: httpServer,
+	}, rec
+}
+
+func TestConnectUnaryJSON(t *testing.T) {
+	srv, rec := newServer(t)
+
+	req, _ := http.NewRequest(http.MethodPost, srv.URL+checkProcedure, strings.NewReader(`{"service":"stopped"}`))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer abc")
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected 200, got %s", resp.Status)
+	}
+	var body map[string]string
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		t.Fatalf("response should be JSON: %v", err)
+	}
+	if body["status"] != "NOT_SERVING" {
+		t.Fatalf("unexpected response %v", body)
+	}
+
+	if got := rec.get("authorization"); got != "Bearer abc" {
+		t.Fatalf("authorization should reach the interceptors, got %q", got)
+	}
+	if got := rec.get("x-forwarded-for"); got != "127.0.0.1" {
+		t.Fatalf("the caller's address should be forwarded, got %q", got)
+	}
+}
+
+func TestConnectUnaryError(t *testing.T) {
+	srv, _ := newServer(t)
+
+	req, _ := http.NewRequest(http.MethodPost, srv.URL+checkProcedure, strings.NewReader(`{"service":"missing"}`))
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusNotFound {
+		t.Fatalf("expected 404, got %s", resp.Status)
+	}
+	var body struct{ Code string }
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		t.Fatalf("error should be JSON: %v", err)
+	}
+	if body.Code != "not_found" {
+		t.Fatalf("expected not_found, got %q", body.Code)
+	}
+}
+
+func TestGRPCWebServerStream(t *testing.T) {
+	srv, _ := newServer(t)
+
+	client := connect.NewClient[healthpb.HealthCheckRequest, healthpb.HealthCheckResponse](
+		http.DefaultClient, srv.URL+watchProcedure, connect.WithGRPCWeb())
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	stream, err := client.CallServerStream(ctx, connect.NewRequest(&healthpb.HealthCheckRequest{Service: "stopped"}))
+	if err != nil {
+		t.Fatalf("failed to open stream: %v", err)
+	}
+	defer stream.Close()
+
+	if !stream.Receive() {
+		t.Fatalf("expected a message, got %v", stream.Err())
+	}
+	if got := stream.Msg().Status; got != healthpb.HealthCheckResponse_NOT_SERVING {
+		t.Fatalf("unexpected status %v", got)
+	}
+}
+
+func TestSplit_ServesNativeGRPC(t *testing.T) {
+	srv, _ := newServer(t)
+
+	conn, err := grpc.NewClient(srv.addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatalf("failed to connect: %v", err)
+	}
+	defer conn.Close()
+
+	resp, err := healthpb.NewHealthClient(conn).Check(context.Background(), &healthpb.HealthCheckRequest{Service: "stopped"})
+	if err != nil {
+		t.Fatalf("native gRPC call failed: %v", err)
+	}
+	if resp.Status != healthpb.HealthCheckResponse_NOT_SERVING {
+		t.Fatalf("unexpected status %v", resp.Status)
+	}
+}